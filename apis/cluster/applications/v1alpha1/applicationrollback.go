@@ -0,0 +1,106 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationRollbackParameters define the rollback operation that is
+// triggered on an ArgoCD Application. The rollback is triggered once, changes
+// to the parameters afterwards have no effect.
+type ApplicationRollbackParameters struct {
+	// Application is the name of the application to roll back
+	// +crossplane:generate:reference:type=Application
+	// +crossplane:generate:reference:refFieldName=ApplicationRef
+	// +crossplane:generate:reference:selectorFieldName=ApplicationSelector
+	// +optional
+	Application *string `json:"application,omitempty"`
+
+	// ApplicationRef is a reference to an Application used to set Application
+	// +optional
+	ApplicationRef *xpv1.Reference `json:"applicationRef,omitempty"`
+
+	// ApplicationSelector selects reference to an Application used to set Application
+	// +optional
+	ApplicationSelector *xpv1.Selector `json:"applicationSelector,omitempty"`
+
+	// AppNamespace is the namespace of the application in the ArgoCD server
+	// +optional
+	AppNamespace *string `json:"appNamespace,omitempty"`
+
+	// Project is the project of the application
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// HistoryID is the ID of the history entry to roll back to, or "previous"
+	// to roll back to the deployment before the current one.
+	// +kubebuilder:validation:Pattern=`^([0-9]+|previous)$`
+	HistoryID string `json:"historyID"`
+
+	// Prune specifies to delete resources that are no longer tracked
+	// +optional
+	Prune *bool `json:"prune,omitempty"`
+
+	// DryRun specifies to perform a `kubectl apply --dry-run` without
+	// actually performing the rollback
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// ApplicationRollbackObservation represents the observed state of a rollback operation
+type ApplicationRollbackObservation struct {
+	// HistoryID is the resolved ID of the history entry rolled back to
+	// +optional
+	HistoryID *int64 `json:"historyID,omitempty"`
+
+	// Revision is the revision of the history entry rolled back to
+	// +optional
+	Revision *string `json:"revision,omitempty"`
+
+	// OperationState contains information about the triggered rollback operation
+	// +optional
+	OperationState *OperationState `json:"operationState,omitempty"`
+}
+
+// An ApplicationRollbackSpec defines the desired state of an ArgoCD rollback operation.
+type ApplicationRollbackSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApplicationRollbackParameters `json:"forProvider"`
+}
+
+// An ApplicationRollbackStatus represents the observed state of an ArgoCD rollback operation.
+type ApplicationRollbackStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApplicationRollbackObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApplicationRollback is a managed resource that rolls an ArgoCD
+// Application back to an entry of its history and tracks the resulting
+// operation. The external name is the resolved history ID. It becomes ready
+// once the rollback succeeded. Deleting it terminates a still running rollback.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPLICATION",type="string",JSONPath=".spec.forProvider.application"
+// +kubebuilder:printcolumn:name="HISTORY-ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.atProvider.operationState.phase"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type ApplicationRollback struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationRollbackSpec   `json:"spec"`
+	Status ApplicationRollbackStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationRollbackList contains a list of ApplicationRollback items
+type ApplicationRollbackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationRollback `json:"items"`
+}
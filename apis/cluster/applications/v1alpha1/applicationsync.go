@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationSyncParameters define the sync operation that is triggered on
+// an ArgoCD Application. The sync is triggered once, changes to the
+// parameters afterwards have no effect.
+type ApplicationSyncParameters struct {
+	// Application is the name of the application to sync
+	// +crossplane:generate:reference:type=Application
+	// +crossplane:generate:reference:refFieldName=ApplicationRef
+	// +crossplane:generate:reference:selectorFieldName=ApplicationSelector
+	// +optional
+	Application *string `json:"application,omitempty"`
+
+	// ApplicationRef is a reference to an Application used to set Application
+	// +optional
+	ApplicationRef *xpv1.Reference `json:"applicationRef,omitempty"`
+
+	// ApplicationSelector selects reference to an Application used to set Application
+	// +optional
+	ApplicationSelector *xpv1.Selector `json:"applicationSelector,omitempty"`
+
+	// AppNamespace is the namespace of the application in the ArgoCD server
+	// +optional
+	AppNamespace *string `json:"appNamespace,omitempty"`
+
+	// Project is the project of the application
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// Revision is the revision (Git) or chart version (Helm) to sync the
+	// application to. If omitted, the revision of the application spec is used.
+	// +optional
+	Revision *string `json:"revision,omitempty"`
+
+	// Prune specifies to delete resources that are no longer tracked in git
+	// +optional
+	Prune *bool `json:"prune,omitempty"`
+
+	// DryRun specifies to perform a `kubectl apply --dry-run` without
+	// actually performing the sync
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Force specifies to use a force apply
+	// +optional
+	Force *bool `json:"force,omitempty"`
+
+	// Resources limits the sync to the given resources
+	// +optional
+	Resources []SyncOperationResource `json:"resources,omitempty"`
+
+	// SyncOptions provide per-sync sync-options, e.g. Validate=false
+	// +optional
+	SyncOptions SyncOptions `json:"syncOptions,omitempty"`
+}
+
+// ApplicationSyncObservation represents the observed state of a sync operation
+type ApplicationSyncObservation struct {
+	// OperationState contains information about the triggered sync operation
+	// +optional
+	OperationState *OperationState `json:"operationState,omitempty"`
+}
+
+// An ApplicationSyncSpec defines the desired state of an ArgoCD sync operation.
+type ApplicationSyncSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApplicationSyncParameters `json:"forProvider"`
+}
+
+// An ApplicationSyncStatus represents the observed state of an ArgoCD sync operation.
+type ApplicationSyncStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApplicationSyncObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApplicationSync is a managed resource that triggers a sync of an ArgoCD
+// Application and tracks the resulting operation. It becomes ready once the
+// sync succeeded. Deleting it terminates a still running sync.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPLICATION",type="string",JSONPath=".spec.forProvider.application"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.atProvider.operationState.phase"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type ApplicationSync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSyncSpec   `json:"spec"`
+	Status ApplicationSyncStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationSyncList contains a list of ApplicationSync items
+type ApplicationSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationSync `json:"items"`
+}
@@ -45,6 +45,33 @@ var (
 	ApplicationGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationKind)
 )
 
+// ApplicationSync type metadata
+var (
+	ApplicationSyncKind             = reflect.TypeOf(ApplicationSync{}).Name()
+	ApplicationSyncGroupKind        = schema.GroupKind{Group: Group, Kind: ApplicationSyncKind}.String()
+	ApplicationSyncKindAPIVersion   = ApplicationSyncKind + "." + SchemeGroupVersion.String()
+	ApplicationSyncGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationSyncKind)
+)
+
+// ApplicationRollback type metadata
+var (
+	ApplicationRollbackKind             = reflect.TypeOf(ApplicationRollback{}).Name()
+	ApplicationRollbackGroupKind        = schema.GroupKind{Group: Group, Kind: ApplicationRollbackKind}.String()
+	ApplicationRollbackKindAPIVersion   = ApplicationRollbackKind + "." + SchemeGroupVersion.String()
+	ApplicationRollbackGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationRollbackKind)
+)
+
+// ResourceAction type metadata
+var (
+	ResourceActionKind             = reflect.TypeOf(ResourceAction{}).Name()
+	ResourceActionGroupKind        = schema.GroupKind{Group: Group, Kind: ResourceActionKind}.String()
+	ResourceActionKindAPIVersion   = ResourceActionKind + "." + SchemeGroupVersion.String()
+	ResourceActionGroupVersionKind = SchemeGroupVersion.WithKind(ResourceActionKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Application{}, &ApplicationList{})
+	SchemeBuilder.Register(&ApplicationSync{}, &ApplicationSyncList{})
+	SchemeBuilder.Register(&ApplicationRollback{}, &ApplicationRollbackList{})
+	SchemeBuilder.Register(&ResourceAction{}, &ResourceActionList{})
 }
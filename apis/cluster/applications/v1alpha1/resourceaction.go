@@ -0,0 +1,122 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceActionParameters define the resource action that is run on a
+// resource of an ArgoCD Application.
+type ResourceActionParameters struct {
+	// Application is the name of the application the resource belongs to
+	// +crossplane:generate:reference:type=Application
+	// +crossplane:generate:reference:refFieldName=ApplicationRef
+	// +crossplane:generate:reference:selectorFieldName=ApplicationSelector
+	// +optional
+	Application *string `json:"application,omitempty"`
+
+	// ApplicationRef is a reference to an Application used to set Application
+	// +optional
+	ApplicationRef *xpv1.Reference `json:"applicationRef,omitempty"`
+
+	// ApplicationSelector selects reference to an Application used to set Application
+	// +optional
+	ApplicationSelector *xpv1.Selector `json:"applicationSelector,omitempty"`
+
+	// AppNamespace is the namespace of the application in the ArgoCD server
+	// +optional
+	AppNamespace *string `json:"appNamespace,omitempty"`
+
+	// Project is the project of the application
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// Group is the API group of the resource, empty for the core group
+	// +optional
+	Group *string `json:"group,omitempty"`
+
+	// Version is the API version of the resource
+	Version string `json:"version"`
+
+	// Kind is the kind of the resource
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the resource, empty for cluster scoped resources
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource
+	Name string `json:"name"`
+
+	// Action is the name of the action to run, e.g. restart
+	Action string `json:"action"`
+
+	// Trigger is an arbitrary value. The action is run again whenever the
+	// value changes.
+	// +optional
+	Trigger *string `json:"trigger,omitempty"`
+}
+
+// ResourceActionObservation represents the observed state of a resource action
+type ResourceActionObservation struct {
+	// LastTrigger is the trigger value the action was last run for
+	// +optional
+	LastTrigger *string `json:"lastTrigger,omitempty"`
+
+	// LastRunTime is the time the action was last run
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// Result is the result of the last run, either Succeeded or Failed
+	// +optional
+	Result *string `json:"result,omitempty"`
+
+	// Message contains the error of the last run if it failed
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// A ResourceActionSpec defines the desired state of an ArgoCD resource action.
+type ResourceActionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ResourceActionParameters `json:"forProvider"`
+}
+
+// A ResourceActionStatus represents the observed state of an ArgoCD resource action.
+type ResourceActionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ResourceActionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourceAction is a managed resource that runs an ArgoCD resource action,
+// like restarting a Deployment, on a resource of an Application. The action is
+// run once and again whenever the trigger changes. Deleting it has no effect
+// on the resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPLICATION",type="string",JSONPath=".spec.forProvider.application"
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.forProvider.kind"
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.forProvider.action"
+// +kubebuilder:printcolumn:name="LAST-RUN",type="date",JSONPath=".status.atProvider.lastRunTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type ResourceAction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceActionSpec   `json:"spec"`
+	Status ResourceActionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceActionList contains a list of ResourceAction items
+type ResourceActionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceAction `json:"items"`
+}
@@ -31,6 +31,73 @@ type ArgoApplicationStatus struct {
 	ResourceHealthSource string `json:"resourceHealthSource,omitempty" protobuf:"bytes,11,opt,name=resourceHealthSource"`
 	// SourceTypes specifies the type of the sources included in the application
 	SourceTypes []ApplicationSourceType `json:"sourceTypes,omitempty" protobuf:"bytes,12,opt,name=sourceTypes"`
+
+	// LastRefreshRequestedAt is the RefreshRequestedAt the last refresh was issued for
+	LastRefreshRequestedAt *metav1.Time `json:"lastRefreshRequestedAt,omitempty"`
+	// LastRefreshTime indicates when the last refresh was issued
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+	// ResourceTree summarizes the live resource tree of the application
+	ResourceTree *ResourceTreeSummary `json:"resourceTree,omitempty"`
+	// ManagedResources summarizes the managed resources of the application
+	ManagedResources *ManagedResourcesSummary `json:"managedResources,omitempty"`
+	// ExportedManifests describes the last export of the rendered manifests
+	ExportedManifests *ManifestsExportStatus `json:"exportedManifests,omitempty"`
+}
+
+// ManifestsExportStatus describes an export of the rendered manifests
+type ManifestsExportStatus struct {
+	// Kind of the object the manifests were exported to
+	Kind string `json:"kind,omitempty"`
+	// Name of the object the manifests were exported to
+	Name string `json:"name,omitempty"`
+	// Namespace of the object the manifests were exported to
+	Namespace string `json:"namespace,omitempty"`
+	// Revision is the revision the manifests were rendered for
+	Revision string `json:"revision"`
+	// Manifests is the number of exported manifests
+	Manifests int64 `json:"manifests"`
+	// Chunks is the number of objects the manifests are split across
+	Chunks int64 `json:"chunks"`
+	// ExportedAt indicates when the manifests were exported
+	ExportedAt *metav1.Time `json:"exportedAt,omitempty"`
+}
+
+// ResourceTreeSummary summarizes the live resource tree of an application.
+// Only a bounded number of resources is listed to keep the status small.
+type ResourceTreeSummary struct {
+	// Nodes is the number of resources in the tree
+	Nodes int64 `json:"nodes"`
+	// Health is the number of resources by health status
+	Health map[string]int64 `json:"health,omitempty"`
+	// Unhealthy lists resources that are degraded, missing or of unknown health
+	Unhealthy []ResourceSummary `json:"unhealthy,omitempty"`
+	// Orphaned is the number of orphaned resources in the application namespace
+	Orphaned int64 `json:"orphaned,omitempty"`
+	// OrphanedResources lists the orphaned resources
+	OrphanedResources []ResourceSummary `json:"orphanedResources,omitempty"`
+}
+
+// ManagedResourcesSummary summarizes the resources managed by an application.
+// Only a bounded number of resources is listed to keep the status small.
+type ManagedResourcesSummary struct {
+	// Resources is the number of managed resources
+	Resources int64 `json:"resources"`
+	// Modified is the number of resources whose live state differs from the target state
+	Modified int64 `json:"modified,omitempty"`
+	// ModifiedResources lists the resources whose live state differs from the target state
+	ModifiedResources []ResourceSummary `json:"modifiedResources,omitempty"`
+}
+
+// ResourceSummary identifies a resource of an application and its health
+type ResourceSummary struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      string  `json:"kind"`
+	Namespace *string `json:"namespace,omitempty"`
+	Name      string  `json:"name"`
+	// Health is the health status of the resource
+	Health *string `json:"health,omitempty"`
+	// Message describes the health status of the resource
+	Message *string `json:"message,omitempty"`
 }
 
 // RevisionHistories contains information about the application's sync history
@@ -45,11 +112,11 @@ type RevisionHistory struct {
 	// ID is an auto incrementing identifier of the RevisionHistory
 	ID *int64 `json:"id" protobuf:"bytes,5,opt,name=id"`
 	// Source is a reference to the application source used for the sync operation
-	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
+	Source ApplicationSourceObservation `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
 	// DeployStartedAt holds the time the sync operation started
 	DeployStartedAt *metav1.Time `json:"deployStartedAt,omitempty" protobuf:"bytes,7,opt,name=deployStartedAt"`
 	// Sources is a reference to the application sources used for the sync operation
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	Sources ApplicationSourceObservations `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
 	// Revisions holds the revision of each source in sources field the sync was performed against
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 }
@@ -102,11 +169,11 @@ type InfoItem struct {
 // ComparedTo contains application source and target which was used for resources comparison
 type ComparedTo struct {
 	// Source is a reference to the application's source used for comparison
-	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
+	Source ApplicationSourceObservation `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	// Destination is a reference to the application's destination used for comparison
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,opt,name=destination"`
 	// Sources is a reference to the application's multiple sources used for comparison
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,3,opt,name=sources"`
+	Sources ApplicationSourceObservations `json:"sources,omitempty" protobuf:"bytes,3,opt,name=sources"`
 }
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -178,9 +245,9 @@ type SyncOperationResult struct {
 	// Revision holds the revision this sync operation was performed to
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Source records the application source information of the sync, used for comparing auto-sync
-	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
+	Source ApplicationSourceObservation `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
 	// Source records the application source information of the sync, used for comparing auto-sync
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,4,opt,name=sources"`
+	Sources ApplicationSourceObservations `json:"sources,omitempty" protobuf:"bytes,4,opt,name=sources"`
 	// Revisions holds the revision this sync operation was performed for respective indexed source in sources field
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 }
@@ -228,14 +295,14 @@ type SyncOperation struct {
 	Resources []SyncOperationResource `json:"resources,omitempty" protobuf:"bytes,6,opt,name=resources"`
 	// Source overrides the source definition set in the application.
 	// This is typically set in a Rollback operation and is nil during a Sync operation
-	Source *ApplicationSource `json:"source,omitempty" protobuf:"bytes,7,opt,name=source"`
+	Source *ApplicationSourceObservation `json:"source,omitempty" protobuf:"bytes,7,opt,name=source"`
 	// Manifests is an optional field that overrides sync source with a local directory for development
 	Manifests []string `json:"manifests,omitempty" protobuf:"bytes,8,opt,name=manifests"`
 	// SyncOptions provide per-sync sync-options, e.g. Validate=false
 	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,9,opt,name=syncOptions"`
 	// Sources overrides the source definition set in the application.
 	// This is typically set in a Rollback operation and is nil during a Sync operation
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,10,opt,name=sources"`
+	Sources ApplicationSourceObservations `json:"sources,omitempty" protobuf:"bytes,10,opt,name=sources"`
 	// Revisions is the list of revision (Git) or chart version (Helm) which to sync each source in sources field for the application to
 	// If omitted, will use the revision specified in app spec.
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
@@ -30,7 +30,17 @@ type ApplicationParameters struct {
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
 	// Project is a reference to the project this application belongs to.
 	// The empty string means that application belongs to the 'default' project.
-	Project string `json:"project" protobuf:"bytes,3,name=project"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/cluster/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	// +optional
+	Project string `json:"project,omitempty" protobuf:"bytes,3,name=project"`
+	// ProjectRef is a reference to a Project used to set Project
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+	// ProjectSelector selects reference to a Project used to set Project
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
 	// SyncPolicy controls when and how a sync will be performed
 	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,name=syncPolicy"`
 	// IgnoreDifferences is a list of resources and their fields which should be ignored during comparison
@@ -45,7 +55,7 @@ type ApplicationParameters struct {
 	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,7,name=revisionHistoryLimit"`
 
 	// Sources is a reference to the location of the application's manifests or chart
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
 
 	// Annotations that will be applied to the ArgoCD Application
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,12,opt,name=annotations"`
@@ -63,6 +73,53 @@ type ApplicationParameters struct {
 	DeleteCascade *bool `json:"deleteCascade,omitempty"`
 	// DeletePropagationPolicy defines the policy for propagating deletions to the app's resources
 	DeletePropagationPolicy *string `json:"deletePropagationPolicy,omitempty"`
+
+	// Refresh is the type of refresh requested by RefreshRequestedAt. A hard
+	// refresh also invalidates the cached manifests. Defaults to normal.
+	// +kubebuilder:validation:Enum=normal;hard
+	// +optional
+	Refresh *string `json:"refresh,omitempty"`
+	// RefreshRequestedAt requests a refresh of the application whenever it
+	// changes, e.g. to pick up new commits without waiting for the next poll.
+	// +optional
+	RefreshRequestedAt *metav1.Time `json:"refreshRequestedAt,omitempty"`
+
+	// ObserveResourceTree enables observing the live resource tree of the
+	// application, summarized in status.atProvider.resourceTree.
+	// +optional
+	ObserveResourceTree *bool `json:"observeResourceTree,omitempty"`
+	// ObserveManagedResources enables observing the managed resources of the
+	// application, summarized in status.atProvider.managedResources.
+	// +optional
+	ObserveManagedResources *bool `json:"observeManagedResources,omitempty"`
+
+	// ExportManifests writes the manifests rendered for the revision ArgoCD
+	// last compared the application to into a ConfigMap or Secret whenever
+	// the revision or the target changes, or the exported object was
+	// deleted. Existing objects that were not exported by the application
+	// are never overwritten. Failures are reported by the ManifestsExported
+	// condition.
+	// +optional
+	ExportManifests *ManifestsExport `json:"exportManifests,omitempty"`
+}
+
+// ManifestsExport references the ConfigMap or Secret the rendered manifests
+// are written to. The manifests are stored gzip compressed under the key
+// manifests.yaml.gz and split across additional objects suffixed with -1,
+// -2, ... if they exceed the size limit of a single object.
+type ManifestsExport struct {
+	// Kind of the object the manifests are written to
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	// +kubebuilder:default=ConfigMap
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+	// Name of the object the manifests are written to
+	Name string `json:"name"`
+	// Namespace of the object the manifests are written to. Defaults to the
+	// namespace of a namespaced Application, which cannot export into other
+	// namespaces.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
 }
 
 // ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.
@@ -87,9 +144,21 @@ type EnvEntry struct {
 }
 
 // ApplicationSource contains all required information about the source of an application
+// in its spec. Unlike ApplicationSourceObservation, the repoURL can be resolved from a
+// Repository.
 type ApplicationSource struct {
 	// RepoURL is the URL to the repository (Git or Helm) that contains the application manifests
-	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1.Repository
+	// +crossplane:generate:reference:refFieldName=RepoURLRef
+	// +crossplane:generate:reference:selectorFieldName=RepoURLSelector
+	// +optional
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// RepoURLRef is a reference to a Repository used to set RepoURL
+	// +optional
+	RepoURLRef *xpv1.Reference `json:"repoURLRef,omitempty"`
+	// RepoURLSelector selects reference to a Repository used to set RepoURL
+	// +optional
+	RepoURLSelector *xpv1.Selector `json:"repoURLSelector,omitempty"`
 	// Path is a directory path within the Git repository, and is only valid for applications sourced from Git.
 	Path *string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
 	// TargetRevision defines the revision of the source to sync the application to.
@@ -112,9 +181,42 @@ type ApplicationSource struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,14,opt,name=name"`
 }
 
-// ApplicationSources contains list of required information about the sources of an application
+// ApplicationSources contains list of required information about the sources of an application.
+// ApplicationParameters.Sources is declared as a plain slice, so that references
+// of the sources are resolved, but remains assignable to ApplicationSources.
 type ApplicationSources []ApplicationSource
 
+// ApplicationSourceObservation contains all required information about the source of an application
+// as reported in the status
+type ApplicationSourceObservation struct {
+	// RepoURL is the URL to the repository (Git or Helm) that contains the application manifests
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Path is a directory path within the Git repository, and is only valid for applications sourced from Git.
+	Path *string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+	// TargetRevision defines the revision of the source to sync the application to.
+	// In case of Git, this can be commit, tag, or branch. If omitted, will equal to HEAD.
+	// In case of Helm, this is a semver tag for the Chart's version.
+	TargetRevision *string `json:"targetRevision,omitempty" protobuf:"bytes,4,opt,name=targetRevision"`
+	// Helm holds helm specific options
+	Helm *ApplicationSourceHelm `json:"helm,omitempty" protobuf:"bytes,7,opt,name=helm"`
+	// Kustomize holds kustomize specific options
+	Kustomize *ApplicationSourceKustomize `json:"kustomize,omitempty" protobuf:"bytes,8,opt,name=kustomize"`
+	// Directory holds path/directory specific options
+	Directory *ApplicationSourceDirectory `json:"directory,omitempty" protobuf:"bytes,10,opt,name=directory"`
+	// Plugin holds config management plugin specific options
+	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
+	// Chart is a Helm chart name, and must be specified for applications sourced from a Helm repo.
+	Chart *string `json:"chart,omitempty" protobuf:"bytes,12,opt,name=chart"`
+	// Ref is reference to another source within sources field. This field will not be used if used with a `source` tag.
+	Ref *string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
+	// Name is the name of the application source
+	Name string `json:"name,omitempty" protobuf:"bytes,14,opt,name=name"`
+}
+
+// ApplicationSourceObservations contains list of required information about the sources of an application
+// as reported in the status
+type ApplicationSourceObservations []ApplicationSourceObservation
+
 // SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
 // hydrated manifests, and an optional hydrateTo location to act as a "staging" aread for hydrated manifests.
 type SourceHydrator struct {
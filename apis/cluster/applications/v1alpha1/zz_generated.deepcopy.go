@@ -162,6 +162,16 @@ func (in *ApplicationParameters) DeepCopyInto(out *ApplicationParameters) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Destination.DeepCopyInto(&out.Destination)
+	if in.ProjectRef != nil {
+		in, out := &in.ProjectRef, &out.ProjectRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SyncPolicy != nil {
 		in, out := &in.SyncPolicy, &out.SyncPolicy
 		*out = new(SyncPolicy)
@@ -186,7 +196,7 @@ func (in *ApplicationParameters) DeepCopyInto(out *ApplicationParameters) {
 	}
 	if in.Sources != nil {
 		in, out := &in.Sources, &out.Sources
-		*out = make(ApplicationSources, len(*in))
+		*out = make([]ApplicationSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -223,6 +233,30 @@ func (in *ApplicationParameters) DeepCopyInto(out *ApplicationParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Refresh != nil {
+		in, out := &in.Refresh, &out.Refresh
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshRequestedAt != nil {
+		in, out := &in.RefreshRequestedAt, &out.RefreshRequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ObserveResourceTree != nil {
+		in, out := &in.ObserveResourceTree, &out.ObserveResourceTree
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ObserveManagedResources != nil {
+		in, out := &in.ObserveManagedResources, &out.ObserveManagedResources
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExportManifests != nil {
+		in, out := &in.ExportManifests, &out.ExportManifests
+		*out = new(ManifestsExport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationParameters.
@@ -235,9 +269,192 @@ func (in *ApplicationParameters) DeepCopy() *ApplicationParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationRollback) DeepCopyInto(out *ApplicationRollback) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationRollback.
+func (in *ApplicationRollback) DeepCopy() *ApplicationRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationRollback) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationRollbackList) DeepCopyInto(out *ApplicationRollbackList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationRollback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationRollbackList.
+func (in *ApplicationRollbackList) DeepCopy() *ApplicationRollbackList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationRollbackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationRollbackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationRollbackObservation) DeepCopyInto(out *ApplicationRollbackObservation) {
+	*out = *in
+	if in.HistoryID != nil {
+		in, out := &in.HistoryID, &out.HistoryID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(string)
+		**out = **in
+	}
+	if in.OperationState != nil {
+		in, out := &in.OperationState, &out.OperationState
+		*out = new(OperationState)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationRollbackObservation.
+func (in *ApplicationRollbackObservation) DeepCopy() *ApplicationRollbackObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationRollbackObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationRollbackParameters) DeepCopyInto(out *ApplicationRollbackParameters) {
+	*out = *in
+	if in.Application != nil {
+		in, out := &in.Application, &out.Application
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplicationRef != nil {
+		in, out := &in.ApplicationRef, &out.ApplicationRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplicationSelector != nil {
+		in, out := &in.ApplicationSelector, &out.ApplicationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppNamespace != nil {
+		in, out := &in.AppNamespace, &out.AppNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationRollbackParameters.
+func (in *ApplicationRollbackParameters) DeepCopy() *ApplicationRollbackParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationRollbackParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationRollbackSpec) DeepCopyInto(out *ApplicationRollbackSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationRollbackSpec.
+func (in *ApplicationRollbackSpec) DeepCopy() *ApplicationRollbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationRollbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationRollbackStatus) DeepCopyInto(out *ApplicationRollbackStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationRollbackStatus.
+func (in *ApplicationRollbackStatus) DeepCopy() *ApplicationRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 	*out = *in
+	if in.RepoURLRef != nil {
+		in, out := &in.RepoURLRef, &out.RepoURLRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoURLSelector != nil {
+		in, out := &in.RepoURLSelector, &out.RepoURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Path != nil {
 		in, out := &in.Path, &out.Path
 		*out = new(string)
@@ -536,6 +753,82 @@ func (in *ApplicationSourceKustomize) DeepCopy() *ApplicationSourceKustomize {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourceObservation) DeepCopyInto(out *ApplicationSourceObservation) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetRevision != nil {
+		in, out := &in.TargetRevision, &out.TargetRevision
+		*out = new(string)
+		**out = **in
+	}
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(ApplicationSourceHelm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kustomize != nil {
+		in, out := &in.Kustomize, &out.Kustomize
+		*out = new(ApplicationSourceKustomize)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(ApplicationSourceDirectory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(ApplicationSourcePlugin)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourceObservation.
+func (in *ApplicationSourceObservation) DeepCopy() *ApplicationSourceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ApplicationSourceObservations) DeepCopyInto(out *ApplicationSourceObservations) {
+	{
+		in := &in
+		*out = make(ApplicationSourceObservations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourceObservations.
+func (in ApplicationSourceObservations) DeepCopy() ApplicationSourceObservations {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourceObservations)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSourcePlugin) DeepCopyInto(out *ApplicationSourcePlugin) {
 	*out = *in
@@ -710,6 +1003,191 @@ func (in *ApplicationSummary) DeepCopy() *ApplicationSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSync) DeepCopyInto(out *ApplicationSync) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSync.
+func (in *ApplicationSync) DeepCopy() *ApplicationSync {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationSync) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSyncList) DeepCopyInto(out *ApplicationSyncList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationSync, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSyncList.
+func (in *ApplicationSyncList) DeepCopy() *ApplicationSyncList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSyncList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationSyncList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSyncObservation) DeepCopyInto(out *ApplicationSyncObservation) {
+	*out = *in
+	if in.OperationState != nil {
+		in, out := &in.OperationState, &out.OperationState
+		*out = new(OperationState)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSyncObservation.
+func (in *ApplicationSyncObservation) DeepCopy() *ApplicationSyncObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSyncObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSyncParameters) DeepCopyInto(out *ApplicationSyncParameters) {
+	*out = *in
+	if in.Application != nil {
+		in, out := &in.Application, &out.Application
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplicationRef != nil {
+		in, out := &in.ApplicationRef, &out.ApplicationRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplicationSelector != nil {
+		in, out := &in.ApplicationSelector, &out.ApplicationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppNamespace != nil {
+		in, out := &in.AppNamespace, &out.AppNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Force != nil {
+		in, out := &in.Force, &out.Force
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]SyncOperationResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SyncOptions != nil {
+		in, out := &in.SyncOptions, &out.SyncOptions
+		*out = make(SyncOptions, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSyncParameters.
+func (in *ApplicationSyncParameters) DeepCopy() *ApplicationSyncParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSyncParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSyncSpec) DeepCopyInto(out *ApplicationSyncSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSyncSpec.
+func (in *ApplicationSyncSpec) DeepCopy() *ApplicationSyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSyncStatus) DeepCopyInto(out *ApplicationSyncStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSyncStatus.
+func (in *ApplicationSyncStatus) DeepCopy() *ApplicationSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoApplicationStatus) DeepCopyInto(out *ArgoApplicationStatus) {
 	*out = *in
@@ -749,11 +1227,34 @@ func (in *ArgoApplicationStatus) DeepCopyInto(out *ArgoApplicationStatus) {
 		in, out := &in.ObservedAt, &out.ObservedAt
 		*out = (*in).DeepCopy()
 	}
-	in.Summary.DeepCopyInto(&out.Summary)
-	if in.SourceTypes != nil {
-		in, out := &in.SourceTypes, &out.SourceTypes
-		*out = make([]ApplicationSourceType, len(*in))
-		copy(*out, *in)
+	in.Summary.DeepCopyInto(&out.Summary)
+	if in.SourceTypes != nil {
+		in, out := &in.SourceTypes, &out.SourceTypes
+		*out = make([]ApplicationSourceType, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastRefreshRequestedAt != nil {
+		in, out := &in.LastRefreshRequestedAt, &out.LastRefreshRequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ResourceTree != nil {
+		in, out := &in.ResourceTree, &out.ResourceTree
+		*out = new(ResourceTreeSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedResources != nil {
+		in, out := &in.ManagedResources, &out.ManagedResources
+		*out = new(ManagedResourcesSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportedManifests != nil {
+		in, out := &in.ExportedManifests, &out.ExportedManifests
+		*out = new(ManifestsExportStatus)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -804,7 +1305,7 @@ func (in *ComparedTo) DeepCopyInto(out *ComparedTo) {
 	in.Destination.DeepCopyInto(&out.Destination)
 	if in.Sources != nil {
 		in, out := &in.Sources, &out.Sources
-		*out = make(ApplicationSources, len(*in))
+		*out = make(ApplicationSourceObservations, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1227,6 +1728,72 @@ func (in *ManagedNamespaceMetadata) DeepCopy() *ManagedNamespaceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedResourcesSummary) DeepCopyInto(out *ManagedResourcesSummary) {
+	*out = *in
+	if in.ModifiedResources != nil {
+		in, out := &in.ModifiedResources, &out.ModifiedResources
+		*out = make([]ResourceSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResourcesSummary.
+func (in *ManagedResourcesSummary) DeepCopy() *ManagedResourcesSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedResourcesSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestsExport) DeepCopyInto(out *ManifestsExport) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestsExport.
+func (in *ManifestsExport) DeepCopy() *ManifestsExport {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestsExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestsExportStatus) DeepCopyInto(out *ManifestsExportStatus) {
+	*out = *in
+	if in.ExportedAt != nil {
+		in, out := &in.ExportedAt, &out.ExportedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestsExportStatus.
+func (in *ManifestsExportStatus) DeepCopy() *ManifestsExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestsExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -1366,6 +1933,188 @@ func (in *OptionalMap) DeepCopy() *OptionalMap {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAction) DeepCopyInto(out *ResourceAction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAction.
+func (in *ResourceAction) DeepCopy() *ResourceAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceAction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceActionList) DeepCopyInto(out *ResourceActionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceActionList.
+func (in *ResourceActionList) DeepCopy() *ResourceActionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceActionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceActionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceActionObservation) DeepCopyInto(out *ResourceActionObservation) {
+	*out = *in
+	if in.LastTrigger != nil {
+		in, out := &in.LastTrigger, &out.LastTrigger
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Result != nil {
+		in, out := &in.Result, &out.Result
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceActionObservation.
+func (in *ResourceActionObservation) DeepCopy() *ResourceActionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceActionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceActionParameters) DeepCopyInto(out *ResourceActionParameters) {
+	*out = *in
+	if in.Application != nil {
+		in, out := &in.Application, &out.Application
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplicationRef != nil {
+		in, out := &in.ApplicationRef, &out.ApplicationRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplicationSelector != nil {
+		in, out := &in.ApplicationSelector, &out.ApplicationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppNamespace != nil {
+		in, out := &in.AppNamespace, &out.AppNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceActionParameters.
+func (in *ResourceActionParameters) DeepCopy() *ResourceActionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceActionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceActionSpec) DeepCopyInto(out *ResourceActionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceActionSpec.
+func (in *ResourceActionSpec) DeepCopy() *ResourceActionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceActionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceActionStatus) DeepCopyInto(out *ResourceActionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceActionStatus.
+func (in *ResourceActionStatus) DeepCopy() *ResourceActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -1526,6 +2275,77 @@ func (in *ResourceStatus) DeepCopy() *ResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSummary) DeepCopyInto(out *ResourceSummary) {
+	*out = *in
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSummary.
+func (in *ResourceSummary) DeepCopy() *ResourceSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceTreeSummary) DeepCopyInto(out *ResourceTreeSummary) {
+	*out = *in
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Unhealthy != nil {
+		in, out := &in.Unhealthy, &out.Unhealthy
+		*out = make([]ResourceSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrphanedResources != nil {
+		in, out := &in.OrphanedResources, &out.OrphanedResources
+		*out = make([]ResourceSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTreeSummary.
+func (in *ResourceTreeSummary) DeepCopy() *ResourceTreeSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTreeSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 	*out = *in
@@ -1596,7 +2416,7 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 	}
 	if in.Sources != nil {
 		in, out := &in.Sources, &out.Sources
-		*out = make(ApplicationSources, len(*in))
+		*out = make(ApplicationSourceObservations, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1672,7 +2492,7 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	}
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
-		*out = new(ApplicationSource)
+		*out = new(ApplicationSourceObservation)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Manifests != nil {
@@ -1687,7 +2507,7 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	}
 	if in.Sources != nil {
 		in, out := &in.Sources, &out.Sources
-		*out = make(ApplicationSources, len(*in))
+		*out = make(ApplicationSourceObservations, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1751,7 +2571,7 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 	in.Source.DeepCopyInto(&out.Source)
 	if in.Sources != nil {
 		in, out := &in.Sources, &out.Sources
-		*out = make(ApplicationSources, len(*in))
+		*out = make(ApplicationSourceObservations, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -28,3 +28,30 @@ func (l *ApplicationList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this ApplicationRollbackList.
+func (l *ApplicationRollbackList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ApplicationSyncList.
+func (l *ApplicationSyncList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ResourceActionList.
+func (l *ResourceActionList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
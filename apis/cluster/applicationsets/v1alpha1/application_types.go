@@ -30,7 +30,17 @@ type ApplicationSpec struct {
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
 	// Project is a reference to the project this application belongs to.
 	// The empty string means that application belongs to the 'default' project.
-	Project string `json:"project" protobuf:"bytes,3,name=project"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/cluster/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	// +optional
+	Project string `json:"project,omitempty" protobuf:"bytes,3,name=project"`
+	// ProjectRef is a reference to a Project used to set Project
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+	// ProjectSelector selects reference to a Project used to set Project
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
 	// SyncPolicy controls when and how a sync will be performed
 	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,name=syncPolicy"`
 	// IgnoreDifferences is a list of resources and their fields which should be ignored during comparison
@@ -45,7 +55,7 @@ type ApplicationSpec struct {
 	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,7,name=revisionHistoryLimit"`
 
 	// Sources is a reference to the location of the application's manifests or chart
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
@@ -75,7 +85,17 @@ type EnvEntry struct {
 // ApplicationSource contains all required information about the source of an application
 type ApplicationSource struct {
 	// RepoURL is the URL to the repository (Git or Helm) that contains the application manifests
-	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1.Repository
+	// +crossplane:generate:reference:refFieldName=RepoURLRef
+	// +crossplane:generate:reference:selectorFieldName=RepoURLSelector
+	// +optional
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// RepoURLRef is a reference to a Repository used to set RepoURL
+	// +optional
+	RepoURLRef *xpv1.Reference `json:"repoURLRef,omitempty"`
+	// RepoURLSelector selects reference to a Repository used to set RepoURL
+	// +optional
+	RepoURLSelector *xpv1.Selector `json:"repoURLSelector,omitempty"`
 	// Path is a directory path within the Git repository, and is only valid for applications sourced from Git.
 	Path *string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
 	// TargetRevision defines the revision of the source to sync the application to.
@@ -98,7 +118,9 @@ type ApplicationSource struct {
 	Name *string `json:"name,omitempty" protobuf:"bytes,14,opt,name=name"`
 }
 
-// ApplicationSources contains list of required information about the sources of an application
+// ApplicationSources contains list of required information about the sources of an application.
+// ApplicationSpec.Sources is declared as a plain slice, so that references
+// of the sources are resolved, but remains assignable to ApplicationSources.
 type ApplicationSources []ApplicationSource
 
 // SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
@@ -144,11 +166,14 @@ type HydrateTo struct {
 type ApplicationDestination struct {
 	// Server specifies the URL of the target cluster and must be set to the Kubernetes control plane API
 	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/cluster/cluster/v1alpha1.Cluster
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-argocd/apis/cluster/cluster/v1alpha1.ServerAddress()
 	// +crossplane:generate:reference:refFieldName=ServerRef
 	// +crossplane:generate:reference:selectorFieldName=ServerSelector
 	// +optional
 	Server *string `json:"server,omitempty"`
-	// ServerRef is a reference to Cluster used to set Server
+	// ServerRef is a reference to Cluster used to set Server. It resolves
+	// to the server address of the Cluster, like the destination of
+	// Applications.
 	// +optional
 	ServerRef *xpv1.Reference `json:"serverRef,omitempty"`
 	// ServerSelector selects references to Cluster used to set Server
@@ -0,0 +1,114 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationSetDiscoveryParameters define which application sets an
+// ApplicationSetDiscovery resource lists.
+type ApplicationSetDiscoveryParameters struct {
+	// AppsetNamespace is the namespace the application sets are listed in.
+	// Defaults to the namespace of the ArgoCD control plane.
+	// +optional
+	AppsetNamespace *string `json:"appsetNamespace,omitempty"`
+
+	// Projects restricts the discovery to application sets of these projects
+	// +optional
+	Projects []string `json:"projects,omitempty"`
+
+	// Selector is a label selector the application sets have to match
+	// +optional
+	Selector *string `json:"selector,omitempty"`
+
+	// RefreshInterval is the minimum time between two listings of the
+	// application sets. By default they are listed on every poll.
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// DiscoveredApplicationSet identifies an application set found in ArgoCD
+type DiscoveredApplicationSet struct {
+	// Name of the application set
+	Name string `json:"name"`
+
+	// Namespace of the application set
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Project of the applications generated by the application set
+	// +optional
+	Project *string `json:"project,omitempty"`
+}
+
+// ApplicationSetDiscoveryObservation represents the discovered application sets
+type ApplicationSetDiscoveryObservation struct {
+	// ApplicationSets is the number of application sets found in ArgoCD
+	// +optional
+	ApplicationSets int64 `json:"applicationSets,omitempty"`
+
+	// Managed is the number of application sets managed by an ApplicationSet
+	// managed resource using the same provider config. Namespaced
+	// ApplicationSets are counted by a cluster scoped discovery if their
+	// provider config points to the same ArgoCD server.
+	// +optional
+	Managed int64 `json:"managed,omitempty"`
+
+	// Unmanaged is the number of application sets not managed by an
+	// ApplicationSet managed resource
+	// +optional
+	Unmanaged int64 `json:"unmanaged,omitempty"`
+
+	// UnmanagedApplicationSets lists the application sets not managed by an
+	// ApplicationSet managed resource, sorted by namespace and name. At most
+	// 100 entries are listed.
+	// +optional
+	UnmanagedApplicationSets []DiscoveredApplicationSet `json:"unmanagedApplicationSets,omitempty"`
+
+	// LastRefreshTime is the time the application sets were last listed
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+}
+
+// An ApplicationSetDiscoverySpec defines the application sets listed by an
+// ApplicationSetDiscovery resource.
+type ApplicationSetDiscoverySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApplicationSetDiscoveryParameters `json:"forProvider"`
+}
+
+// An ApplicationSetDiscoveryStatus represents the discovered application sets.
+type ApplicationSetDiscoveryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApplicationSetDiscoveryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApplicationSetDiscovery is an observe-only managed resource that lists
+// the application sets of an ArgoCD instance and reports the ones that are
+// not managed by an ApplicationSet managed resource yet. It never creates,
+// updates or deletes anything in ArgoCD.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MANAGED",type="integer",JSONPath=".status.atProvider.managed"
+// +kubebuilder:printcolumn:name="UNMANAGED",type="integer",JSONPath=".status.atProvider.unmanaged"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type ApplicationSetDiscovery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSetDiscoverySpec   `json:"spec"`
+	Status ApplicationSetDiscoveryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationSetDiscoveryList contains a list of ApplicationSetDiscovery items
+type ApplicationSetDiscoveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationSetDiscovery `json:"items"`
+}
@@ -45,6 +45,15 @@ var (
 	ApplicationSetGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationSetKind)
 )
 
+// ApplicationSetDiscovery type metadata.
+var (
+	ApplicationSetDiscoveryKind             = reflect.TypeOf(ApplicationSetDiscovery{}).Name()
+	ApplicationSetDiscoveryGroupKind        = schema.GroupKind{Group: Group, Kind: ApplicationSetDiscoveryKind}.String()
+	ApplicationSetDiscoveryKindAPIVersion   = ApplicationSetDiscoveryKind + "." + SchemeGroupVersion.String()
+	ApplicationSetDiscoveryGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationSetDiscoveryKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ApplicationSet{}, &ApplicationSetList{})
+	SchemeBuilder.Register(&ApplicationSetDiscovery{}, &ApplicationSetDiscoveryList{})
 }
@@ -10,6 +10,80 @@ type ArgoApplicationSetStatus struct {
 	ApplicationStatus []ApplicationSetApplicationStatus `json:"applicationStatus,omitempty" protobuf:"bytes,2,name=applicationStatus"`
 	// Resources is a list of Applications resources managed by this application set.
 	Resources []ResourceStatus `json:"resources,omitempty" protobuf:"bytes,3,opt,name=resources"`
+
+	// Preview lists the applications the application set would generate. It
+	// is only set in preview mode.
+	Preview *ApplicationSetPreview `json:"preview,omitempty"`
+
+	// GeneratedApplications summarizes the health and sync status of the
+	// generated applications. It is only set if ObserveApplications or
+	// RequireHealthyApplications is enabled.
+	GeneratedApplications *GeneratedApplicationsSummary `json:"generatedApplications,omitempty"`
+
+	// GeneratorSecrets are the names of the secrets mirrored into ArgoCD
+	GeneratorSecrets []string `json:"generatorSecrets,omitempty"`
+}
+
+// GeneratedApplicationsSummary summarizes the applications generated by an
+// application set. Only a bounded number of applications is listed to keep
+// the status small.
+type GeneratedApplicationsSummary struct {
+	// Applications is the number of generated applications
+	Applications int64 `json:"applications"`
+	// Health is the number of applications by health status
+	Health map[string]int64 `json:"health,omitempty"`
+	// Sync is the number of applications by sync status
+	Sync map[string]int64 `json:"sync,omitempty"`
+	// Unhealthy lists the applications that are not healthy
+	Unhealthy []GeneratedApplicationSummary `json:"unhealthy,omitempty"`
+}
+
+// GeneratedApplicationSummary identifies a generated application and its status
+type GeneratedApplicationSummary struct {
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	// Health is the health status of the application
+	Health *string `json:"health,omitempty"`
+	// Sync is the sync status of the application
+	Sync *string `json:"sync,omitempty"`
+	// Message describes the health status of the application
+	Message *string `json:"message,omitempty"`
+}
+
+// ApplicationSetPreview lists the applications an application set would
+// generate. Only a bounded number of applications is listed to keep the
+// status small.
+type ApplicationSetPreview struct {
+	// Applications is the number of applications that would be generated
+	Applications int64 `json:"applications"`
+	// Items lists the applications that would be generated
+	Items []PreviewApplication `json:"items,omitempty"`
+}
+
+// PreviewApplication describes an application an application set would generate
+type PreviewApplication struct {
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Project   *string `json:"project,omitempty"`
+	// Destination is the target cluster and namespace of the application
+	Destination PreviewDestination `json:"destination"`
+	// Sources are the sources of the application
+	Sources []PreviewSource `json:"sources,omitempty"`
+}
+
+// PreviewDestination is the destination of a previewed application
+type PreviewDestination struct {
+	Server    *string `json:"server,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// PreviewSource is a source of a previewed application
+type PreviewSource struct {
+	RepoURL        string  `json:"repoURL"`
+	Path           *string `json:"path,omitempty"`
+	Chart          *string `json:"chart,omitempty"`
+	TargetRevision *string `json:"targetRevision,omitempty"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
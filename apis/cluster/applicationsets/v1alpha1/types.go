@@ -39,6 +39,59 @@ type ApplicationSetParameters struct {
 
 	// AppsetNamespace is the namespace of the application set in the ArgoCD server
 	AppsetNamespace *string `json:"appsetNamespace,omitempty"`
+
+	// Preview enables a dry-run mode. The applications the application set
+	// would generate are listed in status.atProvider.preview, but the
+	// application set in ArgoCD is neither created nor updated. An
+	// application set that was created before is still deleted with the
+	// managed resource.
+	// +optional
+	Preview *bool `json:"preview,omitempty"`
+
+	// ObserveApplications aggregates the health and sync status of the
+	// applications generated by the application set into
+	// status.atProvider.generatedApplications. The status is taken from the
+	// resources in the status of the application set if ArgoCD reports them,
+	// otherwise the applications owned by the application set are listed.
+	// +optional
+	ObserveApplications *bool `json:"observeApplications,omitempty"`
+
+	// RequireHealthyApplications reports the application set as ready only
+	// if all generated applications are healthy. Implies ObserveApplications.
+	// +optional
+	RequireHealthyApplications *bool `json:"requireHealthyApplications,omitempty"`
+
+	// GeneratorSecrets are mirrored from secrets local to the provider into
+	// the namespace of the application set in ArgoCD, so SCM provider and
+	// pull request generators can reference them by name. The mirrored
+	// secrets are kept in sync and deleted together with the application
+	// set. Mirroring requires the kubernetes configuration of the
+	// ProviderConfig.
+	// +optional
+	GeneratorSecrets []GeneratorSecret `json:"generatorSecrets,omitempty"`
+}
+
+// GeneratorSecret mirrors a secret local to the provider into ArgoCD
+type GeneratorSecret struct {
+	// Name of the mirrored secret in ArgoCD, as referenced by the
+	// secretName of a generator
+	Name string `json:"name"`
+
+	// SecretRef references the secret local to the provider. All its keys
+	// are mirrored.
+	SecretRef GeneratorSecretSource `json:"secretRef"`
+}
+
+// GeneratorSecretSource references a secret local to the provider
+type GeneratorSecretSource struct {
+	// Name of the secret
+	Name string `json:"name"`
+
+	// Namespace of the secret. Required for cluster scoped ApplicationSets,
+	// namespaced ApplicationSets may only reference secrets of their own
+	// namespace.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
 }
 
 type ApplicationSetIgnoreDifferences []ApplicationSetResourceIgnoreDifferences
@@ -210,6 +263,9 @@ type ClusterGenerator struct {
 	// Selector defines a label selector to match against all clusters registered with ArgoCD.
 	// Clusters today are stored as Kubernetes Secrets, thus the Secret labels will be used
 	// for matching the selector.
+	// The selector is evaluated by ArgoCD and not resolved by the provider, so
+	// it cannot reference Cluster managed resources. Set the labels to match
+	// in spec.forProvider.labels of the Cluster instead.
 	Selector metav1.LabelSelector   `json:"selector,omitempty" protobuf:"bytes,1,name=selector"`
 	Template ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,2,name=template"`
 
@@ -46,6 +46,40 @@ type ClusterParameters struct {
 	// Annotations for cluster secret metadata
 	// +optional
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// ClusterResources indicates if cluster level resources should be managed. This setting is used only if cluster is connected in a namespaced mode.
+	// +optional
+	ClusterResources *bool `json:"clusterResources,omitempty"`
+	// RefreshRequestedAt invalidates the cache of the cluster in ArgoCD if
+	// the cache was last invalidated before that time. Set it to the current
+	// time to trigger a refresh of the cluster cache.
+	// +optional
+	RefreshRequestedAt *metav1.Time `json:"refreshRequestedAt,omitempty"`
+	// ManagerServiceAccount bootstraps the credentials ArgoCD uses to connect
+	// to the cluster like `argocd cluster add` does. The
+	// crossplane-argocd-manager service account, its RBAC and a token are
+	// created in the cluster, and the cluster is registered with the token of
+	// the service account.
+	// +optional
+	ManagerServiceAccount *ManagerServiceAccount `json:"managerServiceAccount,omitempty"`
+}
+
+// ManagerServiceAccount configures the manager service account that is
+// created in the cluster to connect ArgoCD to it
+type ManagerServiceAccount struct {
+	// AdminKubeconfigSecretRef contains a reference to a Kubernetes secret
+	// entry that contains a kubeconfig with permissions to create the
+	// service account and its RBAC in the cluster. The server address and
+	// certificate authority of the cluster are taken from the kubeconfig.
+	AdminKubeconfigSecretRef SecretReference `json:"adminKubeconfigSecretRef"`
+	// Namespace of the service account in the cluster
+	// +kubebuilder:default=kube-system
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// RotateTokenRequestedAt requests a new token for the service account
+	// whenever it is set to a later time than the last handled request. The
+	// previous tokens are deleted when ArgoCD is updated with the new token.
+	// +optional
+	RotateTokenRequestedAt *metav1.Time `json:"rotateTokenRequestedAt,omitempty"`
 }
 
 // ClusterConfig holds cluster information for connecting to a cluster
@@ -68,12 +102,54 @@ type ClusterConfig struct {
 	// ExecProviderConfig contains configuration for an exec provider
 	// +optional
 	ExecProviderConfig *ExecProviderConfig `json:"execProviderConfig,omitempty"`
+	// DisableCompression bypasses automatic GZip compression requests to the server.
+	// +optional
+	DisableCompression *bool `json:"disableCompression,omitempty"`
+	// ProxyURL is the URL to the proxy to be used for all requests send to the server
+	// +optional
+	ProxyURL *string `json:"proxyUrl,omitempty"`
 	// KubeconfigSecretRef contains a reference to a Kubernetes secret entry that
 	// contains a raw kubeconfig in YAML or JSON.
 	// See https://kubernetes.io/docs/reference/config-api/kubeconfig.v1/ for more
 	// info about Kubeconfigs
 	// +optional
 	KubeconfigSecretRef *SecretReference `json:"kubeconfigSecretRef,omitempty"`
+	// KubeconfigFrom sources the kubeconfig from the connection secret of
+	// another managed or composite resource, e.g. the Kubernetes cluster
+	// created by another provider. The cluster is registered again whenever
+	// the connection secret changes. Takes precedence over
+	// KubeconfigSecretRef. The provider needs permissions to get and list
+	// the referenced kind.
+	// +optional
+	KubeconfigFrom *KubeconfigSource `json:"kubeconfigFrom,omitempty"`
+}
+
+// KubeconfigSource selects a managed or composite resource that writes a
+// kubeconfig to its connection secret
+type KubeconfigSource struct {
+	// APIVersion of the referenced resource
+	APIVersion string `json:"apiVersion"`
+	// Kind of the referenced resource
+	Kind string `json:"kind"`
+	// Name of the referenced resource. Either name or matchLabels is required.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Namespace of the referenced resource, if it is namespaced. Namespaced
+	// clusters can only reference resources in their own namespace.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// MatchLabels selects the referenced resource by its labels. The first
+	// matching resource by name is used.
+	// +optional
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+	// Keys of the connection secret that may contain the kubeconfig. The
+	// first key present in the secret is used.
+	// +kubebuilder:default={"kubeconfig","value"}
+	// +optional
+	Keys []string `json:"keys,omitempty"`
+	// Context of the kubeconfig to use. Defaults to the current context.
+	// +optional
+	Context *string `json:"context,omitempty"`
 }
 
 // SecretReference holds the reference to a Kubernetes secret
@@ -195,9 +271,36 @@ type ClusterObservation struct {
 	// ClusterInfo holds information about cluster cache and state
 	// +optional
 	ClusterInfo ClusterInfo `json:"connectionState,omitempty"`
+	// RefreshRequestedAt holds the time the cache of the cluster was last
+	// requested to be refreshed
+	// +optional
+	RefreshRequestedAt *metav1.Time `json:"refreshRequestedAt,omitempty"`
 	// Kubeconfig tracks changes to a Kubeconfig secret
 	// +optional
 	Kubeconfig *KubeconfigObservation `json:"kubeconfig,omitempty"`
+	// ManagerServiceAccount holds the state of the manager service
+	// account in the cluster
+	// +optional
+	ManagerServiceAccount *ManagerServiceAccountObservation `json:"managerServiceAccount,omitempty"`
+}
+
+// ManagerServiceAccountObservation holds the state of the manager service
+// account in the cluster
+type ManagerServiceAccountObservation struct {
+	// Namespace of the service account
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the service account
+	Name string `json:"name,omitempty"`
+	// TokenSecretName is the name of the secret holding the current token
+	// +optional
+	TokenSecretName *string `json:"tokenSecretName,omitempty"`
+	// TokenCreatedAt is the time the current token was created
+	// +optional
+	TokenCreatedAt *metav1.Time `json:"tokenCreatedAt,omitempty"`
+	// LastTokenRotationRequestedAt is the last rotateTokenRequestedAt a new
+	// token was created for
+	// +optional
+	LastTokenRotationRequestedAt *metav1.Time `json:"lastTokenRotationRequestedAt,omitempty"`
 }
 
 // A ClusterSpec defines the desired state of an ArgoCD Cluster.
@@ -217,6 +320,8 @@ type ClusterStatus struct {
 // A Cluster is a managed resource that represents an ArgoCD Git Cluster
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.connectionState.serverVersion"
+// +kubebuilder:printcolumn:name="CONNECTION",type="string",JSONPath=".status.atProvider.connectionState.connectionState.status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
@@ -138,11 +138,26 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(ExecProviderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DisableCompression != nil {
+		in, out := &in.DisableCompression, &out.DisableCompression
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProxyURL != nil {
+		in, out := &in.ProxyURL, &out.ProxyURL
+		*out = new(string)
+		**out = **in
+	}
 	if in.KubeconfigSecretRef != nil {
 		in, out := &in.KubeconfigSecretRef, &out.KubeconfigSecretRef
 		*out = new(SecretReference)
 		**out = **in
 	}
+	if in.KubeconfigFrom != nil {
+		in, out := &in.KubeconfigFrom, &out.KubeconfigFrom
+		*out = new(KubeconfigSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfig.
@@ -221,11 +236,20 @@ func (in *ClusterList) DeepCopyObject() runtime.Object {
 func (in *ClusterObservation) DeepCopyInto(out *ClusterObservation) {
 	*out = *in
 	in.ClusterInfo.DeepCopyInto(&out.ClusterInfo)
+	if in.RefreshRequestedAt != nil {
+		in, out := &in.RefreshRequestedAt, &out.RefreshRequestedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.Kubeconfig != nil {
 		in, out := &in.Kubeconfig, &out.Kubeconfig
 		*out = new(KubeconfigObservation)
 		**out = **in
 	}
+	if in.ManagerServiceAccount != nil {
+		in, out := &in.ManagerServiceAccount, &out.ManagerServiceAccount
+		*out = new(ManagerServiceAccountObservation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservation.
@@ -281,6 +305,20 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 			(*out)[key] = val
 		}
 	}
+	if in.ClusterResources != nil {
+		in, out := &in.ClusterResources, &out.ClusterResources
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RefreshRequestedAt != nil {
+		in, out := &in.RefreshRequestedAt, &out.RefreshRequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ManagerServiceAccount != nil {
+		in, out := &in.ManagerServiceAccount, &out.ManagerServiceAccount
+		*out = new(ManagerServiceAccount)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterParameters.
@@ -404,6 +442,101 @@ func (in *KubeconfigObservation) DeepCopy() *KubeconfigObservation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigSource) DeepCopyInto(out *KubeconfigSource) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.MatchLabels != nil {
+		in, out := &in.MatchLabels, &out.MatchLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Context != nil {
+		in, out := &in.Context, &out.Context
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigSource.
+func (in *KubeconfigSource) DeepCopy() *KubeconfigSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagerServiceAccount) DeepCopyInto(out *ManagerServiceAccount) {
+	*out = *in
+	out.AdminKubeconfigSecretRef = in.AdminKubeconfigSecretRef
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotateTokenRequestedAt != nil {
+		in, out := &in.RotateTokenRequestedAt, &out.RotateTokenRequestedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerServiceAccount.
+func (in *ManagerServiceAccount) DeepCopy() *ManagerServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagerServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagerServiceAccountObservation) DeepCopyInto(out *ManagerServiceAccountObservation) {
+	*out = *in
+	if in.TokenSecretName != nil {
+		in, out := &in.TokenSecretName, &out.TokenSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenCreatedAt != nil {
+		in, out := &in.TokenCreatedAt, &out.TokenCreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTokenRotationRequestedAt != nil {
+		in, out := &in.LastTokenRotationRequestedAt, &out.LastTokenRotationRequestedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerServiceAccountObservation.
+func (in *ManagerServiceAccountObservation) DeepCopy() *ManagerServiceAccountObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagerServiceAccountObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretObservation) DeepCopyInto(out *SecretObservation) {
 	*out = *in
@@ -0,0 +1,84 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	projectsv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/projects/v1alpha1"
+)
+
+// ProjectRoleParameters define the desired state of a single role within an ArgoCD Project
+type ProjectRoleParameters struct {
+	// Project is the project the role belongs to
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/cluster/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	Project *string `json:"project"`
+
+	// ProjectRef is a reference to a Project used to set Project
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects reference to a Project used to ProjectRef
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Description is a description of the role
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Policies Stores a list of casbin formated strings that define access policies for the role in the project
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+
+	// Groups are a list of OIDC group claims bound to this role
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ProjectRoleObservation represents the observed state of a role within an ArgoCD Project
+type ProjectRoleObservation struct {
+	// JWTTokens are a list of JWT tokens issued for this role
+	// +optional
+	JWTTokens []projectsv1alpha1.JWTToken `json:"jwtTokens,omitempty"`
+}
+
+// A ProjectRoleSpec defines the desired state of an ArgoCD Project Role.
+type ProjectRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectRoleParameters `json:"forProvider"`
+}
+
+// A ProjectRoleStatus represents the observed state of an ArgoCD Project Role.
+type ProjectRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectRole is a managed resource that represents a single role of an ArgoCD Project.
+// The external name is used as the role name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.project"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type ProjectRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectRoleSpec   `json:"spec"`
+	Status ProjectRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectRoleList contains a list of ProjectRole items
+type ProjectRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectRole `json:"items"`
+}
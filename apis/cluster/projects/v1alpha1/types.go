@@ -41,6 +41,12 @@ type ProjectParameters struct {
 	// SourceNamespaces contains list of namespaces which are authorized in the project
 	// +optional
 	SourceNamespaces []string `json:"sourceNamespaces,omitempty"`
+	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination
+	// +optional
+	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty"`
+	// PermitOnlyProjectScopedClusters determines whether destinations can only reference clusters which are project-scoped
+	// +optional
+	PermitOnlyProjectScopedClusters *bool `json:"permitOnlyProjectScopedClusters,omitempty"`
 	// Description contains optional project description
 	// +optional
 	Description *string `json:"description,omitempty"`
@@ -71,6 +77,17 @@ type ProjectParameters struct {
 	// ProjectLabels labels that will be applied to the AppProject
 	// +optional
 	ProjectLabels map[string]string `json:"projectLabels,omitempty"`
+	// IgnoreUnmanagedRoles makes the controller ignore roles that exist on the
+	// AppProject but are not listed in Roles, e.g. roles managed by ProjectRole
+	// resources. Such roles are neither late-initialized nor removed on update.
+	// +optional
+	IgnoreUnmanagedRoles *bool `json:"ignoreUnmanagedRoles,omitempty"`
+	// IgnoreUnmanagedSyncWindows makes the controller ignore sync windows that
+	// exist on the AppProject but do not match one of SyncWindows, e.g. windows
+	// managed by SyncWindow resources. Such windows are neither late-initialized
+	// nor removed on update.
+	// +optional
+	IgnoreUnmanagedSyncWindows *bool `json:"ignoreUnmanagedSyncWindows,omitempty"`
 }
 
 // ApplicationDestination holds information about the application's destination
@@ -97,6 +114,17 @@ type ApplicationDestination struct {
 	// contains filtered or unexported fields
 }
 
+// ApplicationDestinationServiceAccount holds information about the service account to be impersonated for the application sync operation
+type ApplicationDestinationServiceAccount struct {
+	// Server specifies the URL of the target cluster's Kubernetes control plane API
+	Server string `json:"server"`
+	// Namespace specifies the target namespace for the application's resources
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// DefaultServiceAccount to be used for impersonation during the sync operation
+	DefaultServiceAccount string `json:"defaultServiceAccount"`
+}
+
 // ProjectRole represents a role that has access to a project
 type ProjectRole struct {
 	// Name is a name for this role
@@ -107,9 +135,6 @@ type ProjectRole struct {
 	// Policies Stores a list of casbin formated strings that define access policies for the role in the project
 	// +optional
 	Policies []string `json:"policies,omitempty"`
-	// JWTTokens are a list of generated JWT tokens bound to this role
-	// +optional
-	JWTTokens []JWTToken `json:"jwtTokens,omitempty"`
 	// Groups are a list of OIDC group claims bound to this role
 	// +optional
 	Groups []string `json:"groups,omitempty"`
@@ -181,12 +206,25 @@ type SyncWindow struct {
 // SignatureKey is the specification of a key required to verify commit signatures with
 type SignatureKey struct {
 	// The ID of the key in hexadecimal notation
-	KeyID string `json:"keyID"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1.GPGKey
+	// +crossplane:generate:reference:refFieldName=KeyIDRef
+	// +crossplane:generate:reference:selectorFieldName=KeyIDSelector
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// KeyIDRef is a reference to a GPGKey used to set KeyID
+	// +optional
+	KeyIDRef *xpv1.Reference `json:"keyIDRef,omitempty"`
+
+	// KeyIDSelector selects a GPGKey used to set KeyID
+	// +optional
+	KeyIDSelector *xpv1.Selector `json:"keyIDSelector,omitempty"`
 }
 
 // ProjectObservation represents an argocd Project.
 type ProjectObservation struct {
-	// JWTTokensByRole contains a list of JWT tokens issued for a given role
+	// JWTTokensByRole contains a list of JWT tokens issued for a given role.
+	// Tokens are owned by ArgoCD and preserved when the project is updated.
 	// +optional
 	JWTTokensByRole map[string]JWTTokens `json:"jwtTokensByRole,omitempty"`
 }
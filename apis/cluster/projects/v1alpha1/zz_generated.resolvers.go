@@ -70,6 +70,25 @@ func (mg *Project) ResolveReferences(ctx context.Context, c client.Reader) error
 		mg.Spec.ForProvider.Destinations[i3].Server = reference.ToPtrValue(rsp.ResolvedValue)
 		mg.Spec.ForProvider.Destinations[i3].ServerRef = rsp.ResolvedReference
 
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.SignatureKeys); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.SignatureKeys[i3].KeyID,
+			Extract:      reference.ExternalName(),
+			Namespace:    mg.GetNamespace(),
+			Reference:    mg.Spec.ForProvider.SignatureKeys[i3].KeyIDRef,
+			Selector:     mg.Spec.ForProvider.SignatureKeys[i3].KeyIDSelector,
+			To: reference.To{
+				List:    &v1alpha1.GPGKeyList{},
+				Managed: &v1alpha1.GPGKey{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.SignatureKeys[i3].KeyID")
+		}
+		mg.Spec.ForProvider.SignatureKeys[i3].KeyID = rsp.ResolvedValue
+		mg.Spec.ForProvider.SignatureKeys[i3].KeyIDRef = rsp.ResolvedReference
+
 	}
 
 	return nil
@@ -23,8 +23,10 @@ import (
 	applicationv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
 	applicationsetsv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/applicationsets/v1alpha1"
 	clusterv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/cluster/v1alpha1"
+	projectrolesv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/projectroles/v1alpha1"
 	projectsv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/projects/v1alpha1"
 	repositoriesv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	syncwindowsv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/syncwindows/v1alpha1"
 	"github.com/crossplane-contrib/provider-argocd/apis/cluster/v1alpha1"
 )
 
@@ -34,6 +36,8 @@ func init() {
 		v1alpha1.SchemeBuilder.AddToScheme,
 		repositoriesv1alpha1.SchemeBuilder.AddToScheme,
 		projectsv1alpha1.SchemeBuilder.AddToScheme,
+		projectrolesv1alpha1.SchemeBuilder.AddToScheme,
+		syncwindowsv1alpha1.SchemeBuilder.AddToScheme,
 		clusterv1alpha1.SchemeBuilder.AddToScheme,
 		applicationv1alpha1.SchemeBuilder.AddToScheme,
 		applicationsetsv1alpha1.SchemeBuilder.AddToScheme,
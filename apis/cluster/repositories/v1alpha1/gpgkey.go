@@ -0,0 +1,84 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GPGKeyParameters define the desired state of an ArgoCD GnuPG public key.
+// Exactly one of PublicKeyData and PublicKeyDataRef must be set. The key is
+// immutable, create a new GPGKey to rotate it.
+type GPGKeyParameters struct {
+	// PublicKeyData is the ASCII-armored public key
+	// +optional
+	PublicKeyData *string `json:"publicKeyData,omitempty"`
+
+	// PublicKeyDataRef references a secret key holding the ASCII-armored public key
+	// +optional
+	PublicKeyDataRef *SecretReference `json:"publicKeyDataRef,omitempty"`
+}
+
+// GPGKeyObservation represents the observed state of an ArgoCD GnuPG public key
+type GPGKeyObservation struct {
+	// KeyID specifies the key ID, in hexadecimal string format
+	// +optional
+	KeyID *string `json:"keyID,omitempty"`
+
+	// Fingerprint is the fingerprint of the key
+	// +optional
+	Fingerprint *string `json:"fingerprint,omitempty"`
+
+	// Owner holds the owner identification, e.g. a name and e-mail address
+	// +optional
+	Owner *string `json:"owner,omitempty"`
+
+	// Trust holds the level of trust assigned to this key
+	// +optional
+	Trust *string `json:"trust,omitempty"`
+
+	// SubType holds the key's sub type (e.g. rsa4096)
+	// +optional
+	SubType *string `json:"subType,omitempty"`
+}
+
+// A GPGKeySpec defines the desired state of an ArgoCD GnuPG public key.
+type GPGKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GPGKeyParameters `json:"forProvider"`
+}
+
+// A GPGKeyStatus represents the observed state of an ArgoCD GnuPG public key.
+type GPGKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GPGKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GPGKey is a managed resource that represents a GnuPG public key used by ArgoCD to verify commit signatures.
+// The external name is the key ID. A key that already exists in ArgoCD is only managed
+// if its key ID is set as external name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KEY-ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="OWNER",type="string",JSONPath=".status.atProvider.owner"
+// +kubebuilder:printcolumn:name="TRUST",type="string",JSONPath=".status.atProvider.trust"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type GPGKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GPGKeySpec   `json:"spec"`
+	Status GPGKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GPGKeyList contains a list of GPGKey items
+type GPGKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GPGKey `json:"items"`
+}
@@ -43,8 +43,38 @@ var (
 	RepositoryGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryKind}.String()
 	RepositoryKindAPIVersion   = RepositoryKind + "." + SchemeGroupVersion.String()
 	RepositoryGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryKind)
+
+	GPGKeyKind             = reflect.TypeOf(GPGKey{}).Name()
+	GPGKeyGroupKind        = schema.GroupKind{Group: Group, Kind: GPGKeyKind}.String()
+	GPGKeyKindAPIVersion   = GPGKeyKind + "." + SchemeGroupVersion.String()
+	GPGKeyGroupVersionKind = SchemeGroupVersion.WithKind(GPGKeyKind)
+
+	RepositoryCertificateKind             = reflect.TypeOf(RepositoryCertificate{}).Name()
+	RepositoryCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryCertificateKind}.String()
+	RepositoryCertificateKindAPIVersion   = RepositoryCertificateKind + "." + SchemeGroupVersion.String()
+	RepositoryCertificateGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryCertificateKind)
+
+	WriteRepositoryKind             = reflect.TypeOf(WriteRepository{}).Name()
+	WriteRepositoryGroupKind        = schema.GroupKind{Group: Group, Kind: WriteRepositoryKind}.String()
+	WriteRepositoryKindAPIVersion   = WriteRepositoryKind + "." + SchemeGroupVersion.String()
+	WriteRepositoryGroupVersionKind = SchemeGroupVersion.WithKind(WriteRepositoryKind)
+
+	WriteRepositoryCredentialsKind             = reflect.TypeOf(WriteRepositoryCredentials{}).Name()
+	WriteRepositoryCredentialsGroupKind        = schema.GroupKind{Group: Group, Kind: WriteRepositoryCredentialsKind}.String()
+	WriteRepositoryCredentialsKindAPIVersion   = WriteRepositoryCredentialsKind + "." + SchemeGroupVersion.String()
+	WriteRepositoryCredentialsGroupVersionKind = SchemeGroupVersion.WithKind(WriteRepositoryCredentialsKind)
+
+	RepositoryRefsKind             = reflect.TypeOf(RepositoryRefs{}).Name()
+	RepositoryRefsGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryRefsKind}.String()
+	RepositoryRefsKindAPIVersion   = RepositoryRefsKind + "." + SchemeGroupVersion.String()
+	RepositoryRefsGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryRefsKind)
 )
 
 func init() {
 	SchemeBuilder.Register(&Repository{}, &RepositoryList{})
+	SchemeBuilder.Register(&GPGKey{}, &GPGKeyList{})
+	SchemeBuilder.Register(&RepositoryCertificate{}, &RepositoryCertificateList{})
+	SchemeBuilder.Register(&WriteRepository{}, &WriteRepositoryList{})
+	SchemeBuilder.Register(&WriteRepositoryCredentials{}, &WriteRepositoryCredentialsList{})
+	SchemeBuilder.Register(&RepositoryRefs{}, &RepositoryRefsList{})
 }
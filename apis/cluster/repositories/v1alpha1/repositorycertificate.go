@@ -0,0 +1,95 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RepositoryCertificateParameters define the desired state of an ArgoCD repository certificate.
+// Exactly one of CertData and CertDataRef must be set.
+type RepositoryCertificateParameters struct {
+	// ServerName is the DNS name of the repository server the certificate is intended for.
+	// It must not contain the glob characters *, ?, [, ] or \.
+	// +immutable
+	ServerName string `json:"serverName"`
+
+	// CertType is the type of the certificate, "https" for TLS certificates
+	// or "ssh" for SSH known host entries
+	// +immutable
+	// +kubebuilder:validation:Enum=https;ssh
+	CertType string `json:"certType"`
+
+	// CertSubType is the type of the SSH host key, e.g. "ssh-ed25519".
+	// Required for SSH known host entries, ignored for TLS certificates.
+	// +immutable
+	// +optional
+	CertSubType *string `json:"certSubType,omitempty"`
+
+	// CertData contains the PEM encoded TLS certificate(s) or the base64
+	// encoded SSH public host key
+	// +optional
+	CertData *string `json:"certData,omitempty"`
+
+	// CertDataRef references a secret key holding the certificate data
+	// +optional
+	CertDataRef *SecretReference `json:"certDataRef,omitempty"`
+}
+
+// CertificateInfo holds the information ArgoCD reports for a single certificate
+type CertificateInfo struct {
+	// CertSubType is the SSH key type or the public key algorithm of the TLS certificate
+	// +optional
+	CertSubType string `json:"certSubType,omitempty"`
+
+	// CertInfo holds the SHA256 fingerprint of SSH host keys or the subject of TLS certificates
+	// +optional
+	CertInfo string `json:"certInfo,omitempty"`
+}
+
+// RepositoryCertificateObservation represents the observed state of an ArgoCD repository certificate
+type RepositoryCertificateObservation struct {
+	// Certificates lists the certificates ArgoCD holds for the server name and type
+	// +optional
+	Certificates []CertificateInfo `json:"certificates,omitempty"`
+}
+
+// A RepositoryCertificateSpec defines the desired state of an ArgoCD repository certificate.
+type RepositoryCertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryCertificateParameters `json:"forProvider"`
+}
+
+// A RepositoryCertificateStatus represents the observed state of an ArgoCD repository certificate.
+type RepositoryCertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryCertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryCertificate is a managed resource that represents a TLS certificate or an SSH
+// known host entry ArgoCD uses to verify repository servers.
+// The external name is the server name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVER",type="string",JSONPath=".spec.forProvider.serverName"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.certType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type RepositoryCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryCertificateSpec   `json:"spec"`
+	Status RepositoryCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryCertificateList contains a list of RepositoryCertificate items
+type RepositoryCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryCertificate `json:"items"`
+}
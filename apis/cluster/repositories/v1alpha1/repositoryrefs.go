@@ -0,0 +1,155 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RepositoryRefsParameters define the repository and path a RepositoryRefs
+// resource observes.
+type RepositoryRefsParameters struct {
+	// Repo is the URL of the repository to observe
+	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:refFieldName=RepoRef
+	// +crossplane:generate:reference:selectorFieldName=RepoSelector
+	// +optional
+	Repo *string `json:"repo,omitempty"`
+
+	// RepoRef is a reference to a Repository used to set Repo
+	// +optional
+	RepoRef *xpv1.Reference `json:"repoRef,omitempty"`
+
+	// RepoSelector selects reference to a Repository used to set Repo
+	// +optional
+	RepoSelector *xpv1.Selector `json:"repoSelector,omitempty"`
+
+	// Type of the repository. Branches and tags are listed for git
+	// repositories, charts and their versions for helm repositories.
+	// +kubebuilder:validation:Enum=git;helm
+	// +kubebuilder:default=git
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Project is the ArgoCD project used to access the repository
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// Path within a git repository, or the chart name of a helm repository,
+	// to detect the application source type for
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// TargetRevision used to detect the application source type. Defaults to
+	// HEAD for git repositories and the latest version for helm charts.
+	// +optional
+	TargetRevision *string `json:"targetRevision,omitempty"`
+
+	// RefreshInterval is the minimum time between two queries of the
+	// repository. By default the repository is queried on every poll. Changes
+	// of the other parameters always trigger a query.
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// RepositoryRefsQuery holds the parameters a repository was queried with
+type RepositoryRefsQuery struct {
+	// Repo is the URL of the queried repository
+	// +optional
+	Repo *string `json:"repo,omitempty"`
+
+	// Type of the queried repository
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Project used to access the repository
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// Path the application source type was detected for
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// TargetRevision the application source type was detected for
+	// +optional
+	TargetRevision *string `json:"targetRevision,omitempty"`
+}
+
+// HelmChartVersions holds the versions of a helm chart
+type HelmChartVersions struct {
+	// Name of the chart
+	Name string `json:"name"`
+
+	// Versions of the chart
+	// +optional
+	Versions []string `json:"versions,omitempty"`
+}
+
+// RepositoryRefsObservation represents the observed refs of a repository
+type RepositoryRefsObservation struct {
+	// Branches of a git repository
+	// +optional
+	Branches []string `json:"branches,omitempty"`
+
+	// Tags of a git repository
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// HelmCharts of a helm repository
+	// +optional
+	HelmCharts []HelmChartVersions `json:"helmCharts,omitempty"`
+
+	// SourceType is the application source type detected for Path, e.g. Helm,
+	// Kustomize, Directory or Plugin
+	// +optional
+	SourceType *string `json:"sourceType,omitempty"`
+
+	// LastRefreshTime is the time the repository was last queried
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+
+	// ObservedQuery holds the parameters the repository was last queried
+	// with. The repository is queried again as soon as they change.
+	// +optional
+	ObservedQuery *RepositoryRefsQuery `json:"observedQuery,omitempty"`
+}
+
+// A RepositoryRefsSpec defines the repository observed by a RepositoryRefs resource.
+type RepositoryRefsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryRefsParameters `json:"forProvider"`
+}
+
+// A RepositoryRefsStatus represents the observed refs of a repository.
+type RepositoryRefsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryRefsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryRefs is an observe-only managed resource that lists the branches
+// and tags, or the helm charts, of an ArgoCD repository. It never creates,
+// updates or deletes anything in ArgoCD.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REPO",type="string",JSONPath=".spec.forProvider.repo"
+// +kubebuilder:printcolumn:name="SOURCE-TYPE",type="string",JSONPath=".status.atProvider.sourceType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type RepositoryRefs struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryRefsSpec   `json:"spec"`
+	Status RepositoryRefsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryRefsList contains a list of RepositoryRefs items
+type RepositoryRefsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryRefs `json:"items"`
+}
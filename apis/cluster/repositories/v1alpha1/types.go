@@ -77,6 +77,38 @@ type RepositoryParameters struct {
 	// Github App Enterprise base url if empty will default to https://api.github.com
 	// +optional
 	GitHubAppEnterpriseBaseURL *string `json:"githubAppEnterpriseBaseUrl,omitempty"`
+	// Proxy is the HTTP/HTTPS proxy used to access the repo
+	// +optional
+	Proxy *string `json:"proxy,omitempty"`
+	// NoProxy is a comma separated list of hosts that are not accessed through the proxy
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+	// ForceHTTPBasicAuth enforces HTTP basic auth instead of negotiating the authentication scheme
+	// +optional
+	ForceHTTPBasicAuth *bool `json:"forceHttpBasicAuth,omitempty"`
+	// GCPServiceAccountKey for authenticating at Google Cloud Source repositories
+	// +optional
+	GCPServiceAccountKeyRef *SecretReference `json:"gcpServiceAccountKeyRef,omitempty"`
+	// BearerToken for authenticating at the repo server, only for Bitbucket Data Center
+	// +optional
+	BearerTokenRef *SecretReference `json:"bearerTokenRef,omitempty"`
+	// UseAzureWorkloadIdentity specifies whether Azure Workload Identity is used to authenticate at the repo server
+	// +optional
+	UseAzureWorkloadIdentity *bool `json:"useAzureWorkloadIdentity,omitempty"`
+	// Certificates contains the server names of RepositoryCertificates the
+	// repo server is verified with. They are not sent to ArgoCD but make sure
+	// the certificates exist before the repository is created.
+	// +crossplane:generate:reference:type=RepositoryCertificate
+	// +crossplane:generate:reference:refFieldName=CertificatesRefs
+	// +crossplane:generate:reference:selectorFieldName=CertificatesSelector
+	// +optional
+	Certificates []string `json:"certificates,omitempty"`
+	// CertificatesRefs is a reference to an array of RepositoryCertificate used to set Certificates
+	// +optional
+	CertificatesRefs []xpv1.Reference `json:"certificatesRefs,omitempty"`
+	// CertificatesSelector selects references to RepositoryCertificates used to set Certificates
+	// +optional
+	CertificatesSelector *xpv1.Selector `json:"certificatesSelector,omitempty"`
 }
 
 // SecretReference holds the reference to a Kubernetes secret
@@ -126,6 +158,14 @@ type RepositoryObservation struct {
 	// GithubAppPrivateKey tracks changes to a GithubAppPrivateKey secret
 	// +optional
 	GithubAppPrivateKey *PasswordObservation `json:"githubAppPrivateKey,omitempty"`
+
+	// GCPServiceAccountKey tracks changes to a GCPServiceAccountKey secret
+	// +optional
+	GCPServiceAccountKey *PasswordObservation `json:"gcpServiceAccountKey,omitempty"`
+
+	// BearerToken tracks changes to a BearerToken secret
+	// +optional
+	BearerToken *PasswordObservation `json:"bearerToken,omitempty"`
 }
 
 // ConnectionState is the observed state of the argocd repository
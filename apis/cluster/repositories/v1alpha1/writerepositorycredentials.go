@@ -0,0 +1,142 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RepositoryCredentialsParameters define the desired state of an ArgoCD repository credential set
+type RepositoryCredentialsParameters struct {
+	// URL is the URL prefix of the repositories the credentials are used for
+	// +immutable
+	URL string `json:"url"`
+	// Username for authenticating at the repo server
+	// +optional
+	Username *string `json:"username,omitempty"`
+	// Password for authenticating at the repo server
+	// +optional
+	PasswordRef *SecretReference `json:"passwordRef,omitempty"`
+	// SSH private key data for authenticating at the repo server
+	// +optional
+	SSHPrivateKeyRef *SecretReference `json:"sshPrivateKeyRef,omitempty"`
+	// TLS client cert data for authenticating at the repo server
+	// +optional
+	TLSClientCertDataRef *SecretReference `json:"tlsClientCertDataRef,omitempty"`
+	// TLS client cert key for authenticating at the repo server
+	// +optional
+	TLSClientCertKeyRef *SecretReference `json:"tlsClientCertKeyRef,omitempty"`
+	// Github App Private Key PEM data
+	// +optional
+	GithubAppPrivateKeyRef *SecretReference `json:"githubAppPrivateKeyRef,omitempty"`
+	// Github App ID of the app used to access the repo
+	// +optional
+	GithubAppID *int64 `json:"githubAppID,omitempty"`
+	// Github App Installation ID of the installed GitHub App
+	// +optional
+	GithubAppInstallationID *int64 `json:"githubAppInstallationID,omitempty"`
+	// Github App Enterprise base url if empty will default to https://api.github.com
+	// +optional
+	GitHubAppEnterpriseBaseURL *string `json:"githubAppEnterpriseBaseUrl,omitempty"`
+	// Whether helm-oci support should be enabled for the repos
+	// +optional
+	EnableOCI *bool `json:"enableOCI,omitempty"`
+	// type of the repos, maybe "git or "helm, "git" is assumed if empty or absent
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// GCPServiceAccountKey for authenticating at Google Cloud Source repositories
+	// +optional
+	GCPServiceAccountKeyRef *SecretReference `json:"gcpServiceAccountKeyRef,omitempty"`
+	// Proxy is the HTTP/HTTPS proxy used to access the repos
+	// +optional
+	Proxy *string `json:"proxy,omitempty"`
+	// NoProxy is a comma separated list of hosts that are not accessed through the proxy
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+	// ForceHTTPBasicAuth enforces HTTP basic auth instead of negotiating the authentication scheme
+	// +optional
+	ForceHTTPBasicAuth *bool `json:"forceHttpBasicAuth,omitempty"`
+	// UseAzureWorkloadIdentity specifies whether Azure Workload Identity is used to authenticate at the repo server
+	// +optional
+	UseAzureWorkloadIdentity *bool `json:"useAzureWorkloadIdentity,omitempty"`
+	// BearerToken for authenticating at the repo server, only for Bitbucket Data Center
+	// +optional
+	BearerTokenRef *SecretReference `json:"bearerTokenRef,omitempty"`
+}
+
+// RepositoryCredentialsObservation represents an argocd repository credential set.
+type RepositoryCredentialsObservation struct {
+	// Username reported by ArgoCD
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// Password tracks changes to a Password secret
+	// +optional
+	Password *PasswordObservation `json:"password,omitempty"`
+
+	// SSHPrivateKey tracks changes to a SSHPrivateKey secret
+	// +optional
+	SSHPrivateKey *PasswordObservation `json:"sshPrivateKey,omitempty"`
+
+	// TLSClientCertData tracks changes to a TLSClientCertData secret
+	// +optional
+	TLSClientCertData *PasswordObservation `json:"tlsClientCertData,omitempty"`
+
+	// TLSClientCertKey tracks changes to a TLSClientCertKey secret
+	// +optional
+	TLSClientCertKey *PasswordObservation `json:"tlsClientCertKey,omitempty"`
+
+	// GithubAppPrivateKey tracks changes to a GithubAppPrivateKey secret
+	// +optional
+	GithubAppPrivateKey *PasswordObservation `json:"githubAppPrivateKey,omitempty"`
+
+	// GCPServiceAccountKey tracks changes to a GCPServiceAccountKey secret
+	// +optional
+	GCPServiceAccountKey *PasswordObservation `json:"gcpServiceAccountKey,omitempty"`
+
+	// BearerToken tracks changes to a BearerToken secret
+	// +optional
+	BearerToken *PasswordObservation `json:"bearerToken,omitempty"`
+}
+
+// A WriteRepositoryCredentialsSpec defines the desired state of an ArgoCD write repository credential set.
+type WriteRepositoryCredentialsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryCredentialsParameters `json:"forProvider"`
+}
+
+// A WriteRepositoryCredentialsStatus represents the observed state of an ArgoCD write repository credential set.
+type WriteRepositoryCredentialsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryCredentialsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WriteRepositoryCredentials is a managed resource that represents an ArgoCD credential
+// template with write access for all repositories below a URL prefix.
+// The external name is the URL.
+// ArgoCD stores write credentials in the same secret as read credentials of
+// the same URL, so they must not be managed by other means, and observing
+// them requires the get permission on repositories.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type WriteRepositoryCredentials struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WriteRepositoryCredentialsSpec   `json:"spec"`
+	Status WriteRepositoryCredentialsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WriteRepositoryCredentialsList contains a list of WriteRepositoryCredentials items
+type WriteRepositoryCredentialsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WriteRepositoryCredentials `json:"items"`
+}
@@ -21,46 +21,47 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionState) DeepCopyInto(out *ConnectionState) {
+func (in *CertificateInfo) DeepCopyInto(out *CertificateInfo) {
 	*out = *in
-	if in.ModifiedAt != nil {
-		in, out := &in.ModifiedAt, &out.ModifiedAt
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionState.
-func (in *ConnectionState) DeepCopy() *ConnectionState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateInfo.
+func (in *CertificateInfo) DeepCopy() *CertificateInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionState)
+	out := new(CertificateInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordObservation) DeepCopyInto(out *PasswordObservation) {
+func (in *ConnectionState) DeepCopyInto(out *ConnectionState) {
 	*out = *in
-	out.Secret = in.Secret
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordObservation.
-func (in *PasswordObservation) DeepCopy() *PasswordObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionState.
+func (in *ConnectionState) DeepCopy() *ConnectionState {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordObservation)
+	out := new(ConnectionState)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Repository) DeepCopyInto(out *Repository) {
+func (in *GPGKey) DeepCopyInto(out *GPGKey) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -68,18 +69,18 @@ func (in *Repository) DeepCopyInto(out *Repository) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Repository.
-func (in *Repository) DeepCopy() *Repository {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPGKey.
+func (in *GPGKey) DeepCopy() *GPGKey {
 	if in == nil {
 		return nil
 	}
-	out := new(Repository)
+	out := new(GPGKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Repository) DeepCopyObject() runtime.Object {
+func (in *GPGKey) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -87,31 +88,31 @@ func (in *Repository) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositoryList) DeepCopyInto(out *RepositoryList) {
+func (in *GPGKeyList) DeepCopyInto(out *GPGKeyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Repository, len(*in))
+		*out = make([]GPGKey, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryList.
-func (in *RepositoryList) DeepCopy() *RepositoryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPGKeyList.
+func (in *GPGKeyList) DeepCopy() *GPGKeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RepositoryList)
+	out := new(GPGKeyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RepositoryList) DeepCopyObject() runtime.Object {
+func (in *GPGKeyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -119,201 +120,1170 @@ func (in *RepositoryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositoryObservation) DeepCopyInto(out *RepositoryObservation) {
+func (in *GPGKeyObservation) DeepCopyInto(out *GPGKeyObservation) {
 	*out = *in
-	in.ConnectionState.DeepCopyInto(&out.ConnectionState)
-	if in.Password != nil {
-		in, out := &in.Password, &out.Password
-		*out = new(PasswordObservation)
+	if in.KeyID != nil {
+		in, out := &in.KeyID, &out.KeyID
+		*out = new(string)
 		**out = **in
 	}
-	if in.SSHPrivateKey != nil {
-		in, out := &in.SSHPrivateKey, &out.SSHPrivateKey
-		*out = new(PasswordObservation)
+	if in.Fingerprint != nil {
+		in, out := &in.Fingerprint, &out.Fingerprint
+		*out = new(string)
 		**out = **in
 	}
-	if in.TLSClientCertData != nil {
-		in, out := &in.TLSClientCertData, &out.TLSClientCertData
-		*out = new(PasswordObservation)
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(string)
 		**out = **in
 	}
-	if in.TLSClientCertKey != nil {
-		in, out := &in.TLSClientCertKey, &out.TLSClientCertKey
-		*out = new(PasswordObservation)
+	if in.Trust != nil {
+		in, out := &in.Trust, &out.Trust
+		*out = new(string)
 		**out = **in
 	}
-	if in.GithubAppPrivateKey != nil {
-		in, out := &in.GithubAppPrivateKey, &out.GithubAppPrivateKey
-		*out = new(PasswordObservation)
+	if in.SubType != nil {
+		in, out := &in.SubType, &out.SubType
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryObservation.
-func (in *RepositoryObservation) DeepCopy() *RepositoryObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPGKeyObservation.
+func (in *GPGKeyObservation) DeepCopy() *GPGKeyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RepositoryObservation)
+	out := new(GPGKeyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositoryParameters) DeepCopyInto(out *RepositoryParameters) {
+func (in *GPGKeyParameters) DeepCopyInto(out *GPGKeyParameters) {
 	*out = *in
-	if in.Username != nil {
-		in, out := &in.Username, &out.Username
+	if in.PublicKeyData != nil {
+		in, out := &in.PublicKeyData, &out.PublicKeyData
 		*out = new(string)
 		**out = **in
 	}
-	if in.PasswordRef != nil {
-		in, out := &in.PasswordRef, &out.PasswordRef
-		*out = new(SecretReference)
-		**out = **in
-	}
-	if in.SSHPrivateKeyRef != nil {
-		in, out := &in.SSHPrivateKeyRef, &out.SSHPrivateKeyRef
-		*out = new(SecretReference)
-		**out = **in
-	}
-	if in.Insecure != nil {
-		in, out := &in.Insecure, &out.Insecure
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EnableLFS != nil {
-		in, out := &in.EnableLFS, &out.EnableLFS
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TLSClientCertDataRef != nil {
-		in, out := &in.TLSClientCertDataRef, &out.TLSClientCertDataRef
-		*out = new(SecretReference)
-		**out = **in
-	}
-	if in.TLSClientCertKeyRef != nil {
-		in, out := &in.TLSClientCertKeyRef, &out.TLSClientCertKeyRef
+	if in.PublicKeyDataRef != nil {
+		in, out := &in.PublicKeyDataRef, &out.PublicKeyDataRef
 		*out = new(SecretReference)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
-		**out = **in
-	}
-	if in.Project != nil {
-		in, out := &in.Project, &out.Project
-		*out = new(string)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.InheritedCreds != nil {
-		in, out := &in.InheritedCreds, &out.InheritedCreds
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EnableOCI != nil {
-		in, out := &in.EnableOCI, &out.EnableOCI
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPGKeyParameters.
+func (in *GPGKeyParameters) DeepCopy() *GPGKeyParameters {
+	if in == nil {
+		return nil
 	}
-	if in.GithubAppPrivateKeyRef != nil {
-		in, out := &in.GithubAppPrivateKeyRef, &out.GithubAppPrivateKeyRef
-		*out = new(SecretReference)
-		**out = **in
+	out := new(GPGKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPGKeySpec) DeepCopyInto(out *GPGKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPGKeySpec.
+func (in *GPGKeySpec) DeepCopy() *GPGKeySpec {
+	if in == nil {
+		return nil
 	}
-	if in.GithubAppID != nil {
-		in, out := &in.GithubAppID, &out.GithubAppID
-		*out = new(int64)
-		**out = **in
+	out := new(GPGKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPGKeyStatus) DeepCopyInto(out *GPGKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPGKeyStatus.
+func (in *GPGKeyStatus) DeepCopy() *GPGKeyStatus {
+	if in == nil {
+		return nil
 	}
-	if in.GithubAppInstallationID != nil {
-		in, out := &in.GithubAppInstallationID, &out.GithubAppInstallationID
-		*out = new(int64)
-		**out = **in
+	out := new(GPGKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartVersions) DeepCopyInto(out *HelmChartVersions) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.GitHubAppEnterpriseBaseURL != nil {
-		in, out := &in.GitHubAppEnterpriseBaseURL, &out.GitHubAppEnterpriseBaseURL
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartVersions.
+func (in *HelmChartVersions) DeepCopy() *HelmChartVersions {
+	if in == nil {
+		return nil
 	}
+	out := new(HelmChartVersions)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryParameters.
-func (in *RepositoryParameters) DeepCopy() *RepositoryParameters {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordObservation) DeepCopyInto(out *PasswordObservation) {
+	*out = *in
+	out.Secret = in.Secret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordObservation.
+func (in *PasswordObservation) DeepCopy() *PasswordObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RepositoryParameters)
+	out := new(PasswordObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositorySpec) DeepCopyInto(out *RepositorySpec) {
+func (in *Repository) DeepCopyInto(out *Repository) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositorySpec.
-func (in *RepositorySpec) DeepCopy() *RepositorySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Repository.
+func (in *Repository) DeepCopy() *Repository {
 	if in == nil {
 		return nil
 	}
-	out := new(RepositorySpec)
+	out := new(Repository)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Repository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositoryStatus) DeepCopyInto(out *RepositoryStatus) {
+func (in *RepositoryCertificate) DeepCopyInto(out *RepositoryCertificate) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryStatus.
-func (in *RepositoryStatus) DeepCopy() *RepositoryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCertificate.
+func (in *RepositoryCertificate) DeepCopy() *RepositoryCertificate {
 	if in == nil {
 		return nil
 	}
-	out := new(RepositoryStatus)
+	out := new(RepositoryCertificate)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryCertificate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretObservation) DeepCopyInto(out *SecretObservation) {
+func (in *RepositoryCertificateList) DeepCopyInto(out *RepositoryCertificateList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RepositoryCertificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretObservation.
-func (in *SecretObservation) DeepCopy() *SecretObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCertificateList.
+func (in *RepositoryCertificateList) DeepCopy() *RepositoryCertificateList {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretObservation)
+	out := new(RepositoryCertificateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryCertificateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+func (in *RepositoryCertificateObservation) DeepCopyInto(out *RepositoryCertificateObservation) {
 	*out = *in
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateInfo, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
-func (in *SecretReference) DeepCopy() *SecretReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCertificateObservation.
+func (in *RepositoryCertificateObservation) DeepCopy() *RepositoryCertificateObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretReference)
+	out := new(RepositoryCertificateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryCertificateParameters) DeepCopyInto(out *RepositoryCertificateParameters) {
+	*out = *in
+	if in.CertSubType != nil {
+		in, out := &in.CertSubType, &out.CertSubType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertData != nil {
+		in, out := &in.CertData, &out.CertData
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertDataRef != nil {
+		in, out := &in.CertDataRef, &out.CertDataRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCertificateParameters.
+func (in *RepositoryCertificateParameters) DeepCopy() *RepositoryCertificateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryCertificateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryCertificateSpec) DeepCopyInto(out *RepositoryCertificateSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCertificateSpec.
+func (in *RepositoryCertificateSpec) DeepCopy() *RepositoryCertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryCertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryCertificateStatus) DeepCopyInto(out *RepositoryCertificateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCertificateStatus.
+func (in *RepositoryCertificateStatus) DeepCopy() *RepositoryCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryCredentialsObservation) DeepCopyInto(out *RepositoryCredentialsObservation) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.SSHPrivateKey != nil {
+		in, out := &in.SSHPrivateKey, &out.SSHPrivateKey
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.TLSClientCertData != nil {
+		in, out := &in.TLSClientCertData, &out.TLSClientCertData
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.TLSClientCertKey != nil {
+		in, out := &in.TLSClientCertKey, &out.TLSClientCertKey
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.GithubAppPrivateKey != nil {
+		in, out := &in.GithubAppPrivateKey, &out.GithubAppPrivateKey
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.GCPServiceAccountKey != nil {
+		in, out := &in.GCPServiceAccountKey, &out.GCPServiceAccountKey
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCredentialsObservation.
+func (in *RepositoryCredentialsObservation) DeepCopy() *RepositoryCredentialsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryCredentialsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryCredentialsParameters) DeepCopyInto(out *RepositoryCredentialsParameters) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.SSHPrivateKeyRef != nil {
+		in, out := &in.SSHPrivateKeyRef, &out.SSHPrivateKeyRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.TLSClientCertDataRef != nil {
+		in, out := &in.TLSClientCertDataRef, &out.TLSClientCertDataRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.TLSClientCertKeyRef != nil {
+		in, out := &in.TLSClientCertKeyRef, &out.TLSClientCertKeyRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.GithubAppPrivateKeyRef != nil {
+		in, out := &in.GithubAppPrivateKeyRef, &out.GithubAppPrivateKeyRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.GithubAppID != nil {
+		in, out := &in.GithubAppID, &out.GithubAppID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GithubAppInstallationID != nil {
+		in, out := &in.GithubAppInstallationID, &out.GithubAppInstallationID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GitHubAppEnterpriseBaseURL != nil {
+		in, out := &in.GitHubAppEnterpriseBaseURL, &out.GitHubAppEnterpriseBaseURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableOCI != nil {
+		in, out := &in.EnableOCI, &out.EnableOCI
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCPServiceAccountKeyRef != nil {
+		in, out := &in.GCPServiceAccountKeyRef, &out.GCPServiceAccountKeyRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForceHTTPBasicAuth != nil {
+		in, out := &in.ForceHTTPBasicAuth, &out.ForceHTTPBasicAuth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseAzureWorkloadIdentity != nil {
+		in, out := &in.UseAzureWorkloadIdentity, &out.UseAzureWorkloadIdentity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BearerTokenRef != nil {
+		in, out := &in.BearerTokenRef, &out.BearerTokenRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCredentialsParameters.
+func (in *RepositoryCredentialsParameters) DeepCopy() *RepositoryCredentialsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryCredentialsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryList) DeepCopyInto(out *RepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Repository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryList.
+func (in *RepositoryList) DeepCopy() *RepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryObservation) DeepCopyInto(out *RepositoryObservation) {
+	*out = *in
+	in.ConnectionState.DeepCopyInto(&out.ConnectionState)
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.SSHPrivateKey != nil {
+		in, out := &in.SSHPrivateKey, &out.SSHPrivateKey
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.TLSClientCertData != nil {
+		in, out := &in.TLSClientCertData, &out.TLSClientCertData
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.TLSClientCertKey != nil {
+		in, out := &in.TLSClientCertKey, &out.TLSClientCertKey
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.GithubAppPrivateKey != nil {
+		in, out := &in.GithubAppPrivateKey, &out.GithubAppPrivateKey
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.GCPServiceAccountKey != nil {
+		in, out := &in.GCPServiceAccountKey, &out.GCPServiceAccountKey
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(PasswordObservation)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryObservation.
+func (in *RepositoryObservation) DeepCopy() *RepositoryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryParameters) DeepCopyInto(out *RepositoryParameters) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.SSHPrivateKeyRef != nil {
+		in, out := &in.SSHPrivateKeyRef, &out.SSHPrivateKeyRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableLFS != nil {
+		in, out := &in.EnableLFS, &out.EnableLFS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSClientCertDataRef != nil {
+		in, out := &in.TLSClientCertDataRef, &out.TLSClientCertDataRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.TLSClientCertKeyRef != nil {
+		in, out := &in.TLSClientCertKeyRef, &out.TLSClientCertKeyRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.InheritedCreds != nil {
+		in, out := &in.InheritedCreds, &out.InheritedCreds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableOCI != nil {
+		in, out := &in.EnableOCI, &out.EnableOCI
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GithubAppPrivateKeyRef != nil {
+		in, out := &in.GithubAppPrivateKeyRef, &out.GithubAppPrivateKeyRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.GithubAppID != nil {
+		in, out := &in.GithubAppID, &out.GithubAppID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GithubAppInstallationID != nil {
+		in, out := &in.GithubAppInstallationID, &out.GithubAppInstallationID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GitHubAppEnterpriseBaseURL != nil {
+		in, out := &in.GitHubAppEnterpriseBaseURL, &out.GitHubAppEnterpriseBaseURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForceHTTPBasicAuth != nil {
+		in, out := &in.ForceHTTPBasicAuth, &out.ForceHTTPBasicAuth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GCPServiceAccountKeyRef != nil {
+		in, out := &in.GCPServiceAccountKeyRef, &out.GCPServiceAccountKeyRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.BearerTokenRef != nil {
+		in, out := &in.BearerTokenRef, &out.BearerTokenRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.UseAzureWorkloadIdentity != nil {
+		in, out := &in.UseAzureWorkloadIdentity, &out.UseAzureWorkloadIdentity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CertificatesRefs != nil {
+		in, out := &in.CertificatesRefs, &out.CertificatesRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CertificatesSelector != nil {
+		in, out := &in.CertificatesSelector, &out.CertificatesSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryParameters.
+func (in *RepositoryParameters) DeepCopy() *RepositoryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRefs) DeepCopyInto(out *RepositoryRefs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRefs.
+func (in *RepositoryRefs) DeepCopy() *RepositoryRefs {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRefs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryRefs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRefsList) DeepCopyInto(out *RepositoryRefsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RepositoryRefs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRefsList.
+func (in *RepositoryRefsList) DeepCopy() *RepositoryRefsList {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRefsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryRefsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRefsObservation) DeepCopyInto(out *RepositoryRefsObservation) {
+	*out = *in
+	if in.Branches != nil {
+		in, out := &in.Branches, &out.Branches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HelmCharts != nil {
+		in, out := &in.HelmCharts, &out.HelmCharts
+		*out = make([]HelmChartVersions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceType != nil {
+		in, out := &in.SourceType, &out.SourceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ObservedQuery != nil {
+		in, out := &in.ObservedQuery, &out.ObservedQuery
+		*out = new(RepositoryRefsQuery)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRefsObservation.
+func (in *RepositoryRefsObservation) DeepCopy() *RepositoryRefsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRefsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRefsParameters) DeepCopyInto(out *RepositoryRefsParameters) {
+	*out = *in
+	if in.Repo != nil {
+		in, out := &in.Repo, &out.Repo
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepoRef != nil {
+		in, out := &in.RepoRef, &out.RepoRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoSelector != nil {
+		in, out := &in.RepoSelector, &out.RepoSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetRevision != nil {
+		in, out := &in.TargetRevision, &out.TargetRevision
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRefsParameters.
+func (in *RepositoryRefsParameters) DeepCopy() *RepositoryRefsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRefsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRefsQuery) DeepCopyInto(out *RepositoryRefsQuery) {
+	*out = *in
+	if in.Repo != nil {
+		in, out := &in.Repo, &out.Repo
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetRevision != nil {
+		in, out := &in.TargetRevision, &out.TargetRevision
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRefsQuery.
+func (in *RepositoryRefsQuery) DeepCopy() *RepositoryRefsQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRefsQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRefsSpec) DeepCopyInto(out *RepositoryRefsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRefsSpec.
+func (in *RepositoryRefsSpec) DeepCopy() *RepositoryRefsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRefsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRefsStatus) DeepCopyInto(out *RepositoryRefsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRefsStatus.
+func (in *RepositoryRefsStatus) DeepCopy() *RepositoryRefsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRefsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositorySpec) DeepCopyInto(out *RepositorySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositorySpec.
+func (in *RepositorySpec) DeepCopy() *RepositorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryStatus) DeepCopyInto(out *RepositoryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryStatus.
+func (in *RepositoryStatus) DeepCopy() *RepositoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretObservation) DeepCopyInto(out *SecretObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretObservation.
+func (in *SecretObservation) DeepCopy() *SecretObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteRepository) DeepCopyInto(out *WriteRepository) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteRepository.
+func (in *WriteRepository) DeepCopy() *WriteRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WriteRepository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteRepositoryCredentials) DeepCopyInto(out *WriteRepositoryCredentials) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteRepositoryCredentials.
+func (in *WriteRepositoryCredentials) DeepCopy() *WriteRepositoryCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteRepositoryCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WriteRepositoryCredentials) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteRepositoryCredentialsList) DeepCopyInto(out *WriteRepositoryCredentialsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WriteRepositoryCredentials, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteRepositoryCredentialsList.
+func (in *WriteRepositoryCredentialsList) DeepCopy() *WriteRepositoryCredentialsList {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteRepositoryCredentialsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WriteRepositoryCredentialsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteRepositoryCredentialsSpec) DeepCopyInto(out *WriteRepositoryCredentialsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteRepositoryCredentialsSpec.
+func (in *WriteRepositoryCredentialsSpec) DeepCopy() *WriteRepositoryCredentialsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteRepositoryCredentialsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteRepositoryCredentialsStatus) DeepCopyInto(out *WriteRepositoryCredentialsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteRepositoryCredentialsStatus.
+func (in *WriteRepositoryCredentialsStatus) DeepCopy() *WriteRepositoryCredentialsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteRepositoryCredentialsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteRepositoryList) DeepCopyInto(out *WriteRepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WriteRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteRepositoryList.
+func (in *WriteRepositoryList) DeepCopy() *WriteRepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteRepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WriteRepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteRepositorySpec) DeepCopyInto(out *WriteRepositorySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteRepositorySpec.
+func (in *WriteRepositorySpec) DeepCopy() *WriteRepositorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteRepositorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteRepositoryStatus) DeepCopyInto(out *WriteRepositoryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteRepositoryStatus.
+func (in *WriteRepositoryStatus) DeepCopy() *WriteRepositoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteRepositoryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,151 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyncWindowParameters define the desired state of a single sync window within an ArgoCD Project
+type SyncWindowParameters struct {
+	// Project is the project the sync window belongs to
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/cluster/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	Project *string `json:"project"`
+
+	// ProjectRef is a reference to a Project used to set Project
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects reference to a Project used to ProjectRef
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Kind defines if the window allows or blocks syncs
+	// +kubebuilder:validation:Enum=allow;deny
+	Kind string `json:"kind"`
+
+	// Schedule is the time the window will begin, specified in cron format
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is the amount of time the sync window will be open, e.g. 1h30m
+	// +kubebuilder:validation:MinLength=1
+	Duration string `json:"duration"`
+
+	// Applications contains a list of applications that the window will apply to
+	// +optional
+	Applications []string `json:"applications,omitempty"`
+
+	// Namespaces contains a list of namespaces that the window will apply to
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Clusters contains a list of clusters that the window will apply to
+	// +optional
+	Clusters []string `json:"clusters,omitempty"`
+
+	// ManualSync enables manual syncs when they would otherwise be blocked
+	// +optional
+	ManualSync *bool `json:"manualSync,omitempty"`
+
+	// TimeZone of the sync window that will be applied to the schedule. Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// UseAndOperator uses the AND operator for matching applications, namespaces
+	// and clusters instead of the default OR operator
+	// +optional
+	UseAndOperator *bool `json:"andOperator,omitempty"`
+}
+
+// SyncWindowObservation represents the observed state of a sync window within an ArgoCD Project
+type SyncWindowObservation struct {
+	// Kind of the observed window
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+
+	// Schedule of the observed window
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+
+	// Duration of the observed window
+	// +optional
+	Duration *string `json:"duration,omitempty"`
+
+	// Applications the observed window applies to
+	// +optional
+	Applications []string `json:"applications,omitempty"`
+
+	// Namespaces the observed window applies to
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Clusters the observed window applies to
+	// +optional
+	Clusters []string `json:"clusters,omitempty"`
+
+	// ManualSync of the observed window
+	// +optional
+	ManualSync *bool `json:"manualSync,omitempty"`
+
+	// TimeZone of the observed window
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// UseAndOperator of the observed window
+	// +optional
+	UseAndOperator *bool `json:"andOperator,omitempty"`
+
+	// Active is true if the window is currently open
+	// +optional
+	Active *bool `json:"active,omitempty"`
+
+	// NextOpen is the time the window opens next
+	// +optional
+	NextOpen *metav1.Time `json:"nextOpen,omitempty"`
+}
+
+// A SyncWindowSpec defines the desired state of an ArgoCD Project Sync Window.
+type SyncWindowSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SyncWindowParameters `json:"forProvider"`
+}
+
+// A SyncWindowStatus represents the observed state of an ArgoCD Project Sync Window.
+type SyncWindowStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SyncWindowObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SyncWindow is a managed resource that represents a single sync window of an ArgoCD Project.
+// Sync windows have no name in ArgoCD, a window is identified by its parameters.
+// Only the oldest of several SyncWindows with identical parameters manages the window,
+// the others report an error.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.project"
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.forProvider.kind"
+// +kubebuilder:printcolumn:name="ACTIVE",type="boolean",JSONPath=".status.atProvider.active"
+// +kubebuilder:printcolumn:name="NEXT-OPEN",type="date",JSONPath=".status.atProvider.nextOpen"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,argocd}
+type SyncWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SyncWindowSpec   `json:"spec"`
+	Status SyncWindowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SyncWindowList contains a list of SyncWindow items
+type SyncWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SyncWindow `json:"items"`
+}
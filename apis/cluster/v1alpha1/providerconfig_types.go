@@ -48,6 +48,25 @@ type ProviderConfigSpec struct {
 
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// Kubernetes configures access to the Kubernetes cluster ArgoCD is
+	// running in. It is only required by features that manage Kubernetes
+	// resources next to ArgoCD directly, like mirroring the generator
+	// secrets of ApplicationSets.
+	// +optional
+	Kubernetes *KubernetesConfig `json:"kubernetes,omitempty"`
+}
+
+// KubernetesConfig configures access to the Kubernetes cluster ArgoCD is running in.
+type KubernetesConfig struct {
+	// KubeconfigSecretRef references a secret key holding a kubeconfig of
+	// the cluster ArgoCD is running in.
+	KubeconfigSecretRef xpv1.SecretKeySelector `json:"kubeconfigSecretRef"`
+
+	// Namespace ArgoCD is running in. Default: argocd.
+	// +kubebuilder:default=argocd
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ApplicationRollbackParameters and ApplicationRollbackObservation are copied
+// together with the Application types into zz_generated.types.copied.go.
+
+// An ApplicationRollbackSpec defines the desired state of an ArgoCD rollback operation.
+type ApplicationRollbackSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ApplicationRollbackParameters `json:"forProvider"`
+}
+
+// An ApplicationRollbackStatus represents the observed state of an ArgoCD rollback operation.
+type ApplicationRollbackStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApplicationRollbackObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApplicationRollback is a managed resource that rolls an ArgoCD
+// Application back to an entry of its history and tracks the resulting
+// operation. The external name is the resolved history ID. It becomes ready
+// once the rollback succeeded. Deleting it terminates a still running rollback.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPLICATION",type="string",JSONPath=".spec.forProvider.application"
+// +kubebuilder:printcolumn:name="HISTORY-ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.atProvider.operationState.phase"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type ApplicationRollback struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationRollbackSpec   `json:"spec"`
+	Status ApplicationRollbackStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationRollbackList contains a list of ApplicationRollback items
+type ApplicationRollbackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationRollback `json:"items"`
+}
+
+// ApplicationRollback type metadata
+var (
+	ApplicationRollbackKind             = reflect.TypeOf(ApplicationRollback{}).Name()
+	ApplicationRollbackGroupKind        = schema.GroupKind{Group: Group, Kind: ApplicationRollbackKind}.String()
+	ApplicationRollbackKindAPIVersion   = ApplicationRollbackKind + "." + SchemeGroupVersion.String()
+	ApplicationRollbackGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationRollbackKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ApplicationRollback{}, &ApplicationRollbackList{})
+}
@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ApplicationSyncParameters and ApplicationSyncObservation are copied together
+// with the Application types into zz_generated.types.copied.go.
+
+// An ApplicationSyncSpec defines the desired state of an ArgoCD sync operation.
+type ApplicationSyncSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ApplicationSyncParameters `json:"forProvider"`
+}
+
+// An ApplicationSyncStatus represents the observed state of an ArgoCD sync operation.
+type ApplicationSyncStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApplicationSyncObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApplicationSync is a managed resource that triggers a sync of an ArgoCD
+// Application and tracks the resulting operation. It becomes ready once the
+// sync succeeded. Deleting it terminates a still running sync.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPLICATION",type="string",JSONPath=".spec.forProvider.application"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.atProvider.operationState.phase"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type ApplicationSync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSyncSpec   `json:"spec"`
+	Status ApplicationSyncStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationSyncList contains a list of ApplicationSync items
+type ApplicationSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationSync `json:"items"`
+}
+
+// ApplicationSync type metadata
+var (
+	ApplicationSyncKind             = reflect.TypeOf(ApplicationSync{}).Name()
+	ApplicationSyncGroupKind        = schema.GroupKind{Group: Group, Kind: ApplicationSyncKind}.String()
+	ApplicationSyncKindAPIVersion   = ApplicationSyncKind + "." + SchemeGroupVersion.String()
+	ApplicationSyncGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationSyncKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ApplicationSync{}, &ApplicationSyncList{})
+}
@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ResourceActionParameters and ResourceActionObservation are copied together
+// with the Application types into zz_generated.types.copied.go.
+
+// A ResourceActionSpec defines the desired state of an ArgoCD resource action.
+type ResourceActionSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ResourceActionParameters `json:"forProvider"`
+}
+
+// A ResourceActionStatus represents the observed state of an ArgoCD resource action.
+type ResourceActionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ResourceActionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourceAction is a managed resource that runs an ArgoCD resource action,
+// like restarting a Deployment, on a resource of an Application. The action is
+// run once and again whenever the trigger changes. Deleting it has no effect
+// on the resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPLICATION",type="string",JSONPath=".spec.forProvider.application"
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.forProvider.kind"
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.forProvider.action"
+// +kubebuilder:printcolumn:name="LAST-RUN",type="date",JSONPath=".status.atProvider.lastRunTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type ResourceAction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceActionSpec   `json:"spec"`
+	Status ResourceActionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceActionList contains a list of ResourceAction items
+type ResourceActionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceAction `json:"items"`
+}
+
+// ResourceAction type metadata
+var (
+	ResourceActionKind             = reflect.TypeOf(ResourceAction{}).Name()
+	ResourceActionGroupKind        = schema.GroupKind{Group: Group, Kind: ResourceActionKind}.String()
+	ResourceActionKindAPIVersion   = ResourceActionKind + "." + SchemeGroupVersion.String()
+	ResourceActionGroupVersionKind = SchemeGroupVersion.WithKind(ResourceActionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ResourceAction{}, &ResourceActionList{})
+}
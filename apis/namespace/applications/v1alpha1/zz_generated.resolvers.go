@@ -20,7 +20,9 @@ package v1alpha1
 
 import (
 	"context"
-	v1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/namespace/cluster/v1alpha1"
+	v1alpha11 "github.com/crossplane-contrib/provider-argocd/apis/namespace/cluster/v1alpha1"
+	v1alpha12 "github.com/crossplane-contrib/provider-argocd/apis/namespace/projects/v1alpha1"
+	v1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/namespace/repositories/v1alpha1"
 	reference "github.com/crossplane/crossplane-runtime/v2/pkg/reference"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,15 +35,34 @@ func (mg *Application) ResolveReferences(ctx context.Context, c client.Reader) e
 	var rsp reference.NamespacedResolutionResponse
 	var err error
 
+	if mg.Spec.ForProvider.Source != nil {
+		rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.Source.RepoURL,
+			Extract:      reference.ExternalName(),
+			Namespace:    mg.GetNamespace(),
+			Reference:    mg.Spec.ForProvider.Source.RepoURLRef,
+			Selector:     mg.Spec.ForProvider.Source.RepoURLSelector,
+			To: reference.To{
+				List:    &v1alpha1.RepositoryList{},
+				Managed: &v1alpha1.Repository{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Source.RepoURL")
+		}
+		mg.Spec.ForProvider.Source.RepoURL = rsp.ResolvedValue
+		mg.Spec.ForProvider.Source.RepoURLRef = rsp.ResolvedReference
+
+	}
 	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Destination.Server),
-		Extract:      v1alpha1.ServerAddress(),
+		Extract:      v1alpha11.ServerAddress(),
 		Namespace:    mg.GetNamespace(),
 		Reference:    mg.Spec.ForProvider.Destination.ServerRef,
 		Selector:     mg.Spec.ForProvider.Destination.ServerSelector,
 		To: reference.To{
-			List:    &v1alpha1.ClusterList{},
-			Managed: &v1alpha1.Cluster{},
+			List:    &v1alpha11.ClusterList{},
+			Managed: &v1alpha11.Cluster{},
 		},
 	})
 	if err != nil {
@@ -52,13 +73,13 @@ func (mg *Application) ResolveReferences(ctx context.Context, c client.Reader) e
 
 	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Destination.Name),
-		Extract:      v1alpha1.ServerName(),
+		Extract:      v1alpha11.ServerName(),
 		Namespace:    mg.GetNamespace(),
 		Reference:    mg.Spec.ForProvider.Destination.NameRef,
 		Selector:     mg.Spec.ForProvider.Destination.NameSelector,
 		To: reference.To{
-			List:    &v1alpha1.ClusterList{},
-			Managed: &v1alpha1.Cluster{},
+			List:    &v1alpha11.ClusterList{},
+			Managed: &v1alpha11.Cluster{},
 		},
 	})
 	if err != nil {
@@ -67,15 +88,51 @@ func (mg *Application) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.Destination.Name = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.Destination.NameRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Project,
+		Extract:      reference.ExternalName(),
+		Namespace:    mg.GetNamespace(),
+		Reference:    mg.Spec.ForProvider.ProjectRef,
+		Selector:     mg.Spec.ForProvider.ProjectSelector,
+		To: reference.To{
+			List:    &v1alpha12.ProjectList{},
+			Managed: &v1alpha12.Project{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Project")
+	}
+	mg.Spec.ForProvider.Project = rsp.ResolvedValue
+	mg.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Sources); i3++ {
+		rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.Sources[i3].RepoURL,
+			Extract:      reference.ExternalName(),
+			Namespace:    mg.GetNamespace(),
+			Reference:    mg.Spec.ForProvider.Sources[i3].RepoURLRef,
+			Selector:     mg.Spec.ForProvider.Sources[i3].RepoURLSelector,
+			To: reference.To{
+				List:    &v1alpha1.RepositoryList{},
+				Managed: &v1alpha1.Repository{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Sources[i3].RepoURL")
+		}
+		mg.Spec.ForProvider.Sources[i3].RepoURL = rsp.ResolvedValue
+		mg.Spec.ForProvider.Sources[i3].RepoURLRef = rsp.ResolvedReference
+
+	}
 	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Status.AtProvider.Sync.ComparedTo.Destination.Server),
-		Extract:      v1alpha1.ServerAddress(),
+		Extract:      v1alpha11.ServerAddress(),
 		Namespace:    mg.GetNamespace(),
 		Reference:    mg.Status.AtProvider.Sync.ComparedTo.Destination.ServerRef,
 		Selector:     mg.Status.AtProvider.Sync.ComparedTo.Destination.ServerSelector,
 		To: reference.To{
-			List:    &v1alpha1.ClusterList{},
-			Managed: &v1alpha1.Cluster{},
+			List:    &v1alpha11.ClusterList{},
+			Managed: &v1alpha11.Cluster{},
 		},
 	})
 	if err != nil {
@@ -86,13 +143,13 @@ func (mg *Application) ResolveReferences(ctx context.Context, c client.Reader) e
 
 	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Status.AtProvider.Sync.ComparedTo.Destination.Name),
-		Extract:      v1alpha1.ServerName(),
+		Extract:      v1alpha11.ServerName(),
 		Namespace:    mg.GetNamespace(),
 		Reference:    mg.Status.AtProvider.Sync.ComparedTo.Destination.NameRef,
 		Selector:     mg.Status.AtProvider.Sync.ComparedTo.Destination.NameSelector,
 		To: reference.To{
-			List:    &v1alpha1.ClusterList{},
-			Managed: &v1alpha1.Cluster{},
+			List:    &v1alpha11.ClusterList{},
+			Managed: &v1alpha11.Cluster{},
 		},
 	})
 	if err != nil {
@@ -103,3 +160,84 @@ func (mg *Application) ResolveReferences(ctx context.Context, c client.Reader) e
 
 	return nil
 }
+
+// ResolveReferences of this ApplicationRollback.
+func (mg *ApplicationRollback) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	var rsp reference.NamespacedResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Application),
+		Extract:      reference.ExternalName(),
+		Namespace:    mg.GetNamespace(),
+		Reference:    mg.Spec.ForProvider.ApplicationRef,
+		Selector:     mg.Spec.ForProvider.ApplicationSelector,
+		To: reference.To{
+			List:    &ApplicationList{},
+			Managed: &Application{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Application")
+	}
+	mg.Spec.ForProvider.Application = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ApplicationRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ApplicationSync.
+func (mg *ApplicationSync) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	var rsp reference.NamespacedResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Application),
+		Extract:      reference.ExternalName(),
+		Namespace:    mg.GetNamespace(),
+		Reference:    mg.Spec.ForProvider.ApplicationRef,
+		Selector:     mg.Spec.ForProvider.ApplicationSelector,
+		To: reference.To{
+			List:    &ApplicationList{},
+			Managed: &Application{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Application")
+	}
+	mg.Spec.ForProvider.Application = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ApplicationRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ResourceAction.
+func (mg *ResourceAction) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPINamespacedResolver(c, mg)
+
+	var rsp reference.NamespacedResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Application),
+		Extract:      reference.ExternalName(),
+		Namespace:    mg.GetNamespace(),
+		Reference:    mg.Spec.ForProvider.ApplicationRef,
+		Selector:     mg.Spec.ForProvider.ApplicationSelector,
+		To: reference.To{
+			List:    &ApplicationList{},
+			Managed: &Application{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Application")
+	}
+	mg.Spec.ForProvider.Application = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ApplicationRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -16,7 +16,17 @@ type ApplicationParameters struct {
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
 	// Project is a reference to the project this application belongs to.
 	// The empty string means that application belongs to the 'default' project.
-	Project string `json:"project" protobuf:"bytes,3,name=project"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/namespace/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	// +optional
+	Project string `json:"project,omitempty" protobuf:"bytes,3,name=project"`
+	// ProjectRef is a reference to a Project used to set Project
+	// +optional
+	ProjectRef *v1.NamespacedReference `json:"projectRef,omitempty"`
+	// ProjectSelector selects reference to a Project used to set Project
+	// +optional
+	ProjectSelector *v1.NamespacedSelector `json:"projectSelector,omitempty"`
 	// SyncPolicy controls when and how a sync will be performed
 	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,name=syncPolicy"`
 	// IgnoreDifferences is a list of resources and their fields which should be ignored during comparison
@@ -31,7 +41,7 @@ type ApplicationParameters struct {
 	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,7,name=revisionHistoryLimit"`
 
 	// Sources is a reference to the location of the application's manifests or chart
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
 
 	// Annotations that will be applied to the ArgoCD Application
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,12,opt,name=annotations"`
@@ -49,12 +59,52 @@ type ApplicationParameters struct {
 	DeleteCascade *bool `json:"deleteCascade,omitempty"`
 	// DeletePropagationPolicy defines the policy for propagating deletions to the app's resources
 	DeletePropagationPolicy *string `json:"deletePropagationPolicy,omitempty"`
+
+	// Refresh is the type of refresh requested by RefreshRequestedAt. A hard
+	// refresh also invalidates the cached manifests. Defaults to normal.
+	// +kubebuilder:validation:Enum=normal;hard
+	// +optional
+	Refresh *string `json:"refresh,omitempty"`
+	// RefreshRequestedAt requests a refresh of the application whenever it
+	// changes, e.g. to pick up new commits without waiting for the next poll.
+	// +optional
+	RefreshRequestedAt *metav1.Time `json:"refreshRequestedAt,omitempty"`
+
+	// ObserveResourceTree enables observing the live resource tree of the
+	// application, summarized in status.atProvider.resourceTree.
+	// +optional
+	ObserveResourceTree *bool `json:"observeResourceTree,omitempty"`
+	// ObserveManagedResources enables observing the managed resources of the
+	// application, summarized in status.atProvider.managedResources.
+	// +optional
+	ObserveManagedResources *bool `json:"observeManagedResources,omitempty"`
+
+	// ExportManifests writes the manifests rendered for the revision ArgoCD
+	// last compared the application to into a ConfigMap or Secret whenever
+	// the revision or the target changes, or the exported object was
+	// deleted. Existing objects that were not exported by the application
+	// are never overwritten. Failures are reported by the ManifestsExported
+	// condition.
+	// +optional
+	ExportManifests *ManifestsExport `json:"exportManifests,omitempty"`
 }
 
 // ApplicationSource contains all required information about the source of an application
+// in its spec. Unlike ApplicationSourceObservation, the repoURL can be resolved from a
+// Repository.
 type ApplicationSource struct {
 	// RepoURL is the URL to the repository (Git or Helm) that contains the application manifests
-	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/namespace/repositories/v1alpha1.Repository
+	// +crossplane:generate:reference:refFieldName=RepoURLRef
+	// +crossplane:generate:reference:selectorFieldName=RepoURLSelector
+	// +optional
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// RepoURLRef is a reference to a Repository used to set RepoURL
+	// +optional
+	RepoURLRef *v1.NamespacedReference `json:"repoURLRef,omitempty"`
+	// RepoURLSelector selects reference to a Repository used to set RepoURL
+	// +optional
+	RepoURLSelector *v1.NamespacedSelector `json:"repoURLSelector,omitempty"`
 	// Path is a directory path within the Git repository, and is only valid for applications sourced from Git.
 	Path *string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
 	// TargetRevision defines the revision of the source to sync the application to.
@@ -142,9 +192,6 @@ type Info struct {
 	Value string `json:"value" protobuf:"bytes,2,name=value"`
 }
 
-// ApplicationSources contains list of required information about the sources of an application
-type ApplicationSources []ApplicationSource
-
 // SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
 // hydrated manifests, and an optional hydrateTo location to act as a "staging" aread for hydrated manifests.
 type SourceHydrator struct {
@@ -157,6 +204,25 @@ type SourceHydrator struct {
 	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
 }
 
+// ManifestsExport references the ConfigMap or Secret the rendered manifests
+// are written to. The manifests are stored gzip compressed under the key
+// manifests.yaml.gz and split across additional objects suffixed with -1,
+// -2, ... if they exceed the size limit of a single object.
+type ManifestsExport struct {
+	// Kind of the object the manifests are written to
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	// +kubebuilder:default=ConfigMap
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+	// Name of the object the manifests are written to
+	Name string `json:"name"`
+	// Namespace of the object the manifests are written to. Defaults to the
+	// namespace of a namespaced Application, which cannot export into other
+	// namespaces.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+}
+
 // ApplicationSourceHelm holds helm specific options
 type ApplicationSourceHelm struct {
 	// ValuesFiles is a list of Helm value files to use when generating a template
@@ -469,6 +535,17 @@ type ArgoApplicationStatus struct {
 	ResourceHealthSource string `json:"resourceHealthSource,omitempty" protobuf:"bytes,11,opt,name=resourceHealthSource"`
 	// SourceTypes specifies the type of the sources included in the application
 	SourceTypes []ApplicationSourceType `json:"sourceTypes,omitempty" protobuf:"bytes,12,opt,name=sourceTypes"`
+
+	// LastRefreshRequestedAt is the RefreshRequestedAt the last refresh was issued for
+	LastRefreshRequestedAt *metav1.Time `json:"lastRefreshRequestedAt,omitempty"`
+	// LastRefreshTime indicates when the last refresh was issued
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+	// ResourceTree summarizes the live resource tree of the application
+	ResourceTree *ResourceTreeSummary `json:"resourceTree,omitempty"`
+	// ManagedResources summarizes the managed resources of the application
+	ManagedResources *ManagedResourcesSummary `json:"managedResources,omitempty"`
+	// ExportedManifests describes the last export of the rendered manifests
+	ExportedManifests *ManifestsExportStatus `json:"exportedManifests,omitempty"`
 }
 
 // ResourceStatus holds the current sync and health status of a resource
@@ -550,14 +627,58 @@ type ApplicationSummary struct {
 	Images []string `json:"images,omitempty" protobuf:"bytes,2,opt,name=images"`
 }
 
+// ResourceTreeSummary summarizes the live resource tree of an application.
+// Only a bounded number of resources is listed to keep the status small.
+type ResourceTreeSummary struct {
+	// Nodes is the number of resources in the tree
+	Nodes int64 `json:"nodes"`
+	// Health is the number of resources by health status
+	Health map[string]int64 `json:"health,omitempty"`
+	// Unhealthy lists resources that are degraded, missing or of unknown health
+	Unhealthy []ResourceSummary `json:"unhealthy,omitempty"`
+	// Orphaned is the number of orphaned resources in the application namespace
+	Orphaned int64 `json:"orphaned,omitempty"`
+	// OrphanedResources lists the orphaned resources
+	OrphanedResources []ResourceSummary `json:"orphanedResources,omitempty"`
+}
+
+// ManagedResourcesSummary summarizes the resources managed by an application.
+// Only a bounded number of resources is listed to keep the status small.
+type ManagedResourcesSummary struct {
+	// Resources is the number of managed resources
+	Resources int64 `json:"resources"`
+	// Modified is the number of resources whose live state differs from the target state
+	Modified int64 `json:"modified,omitempty"`
+	// ModifiedResources lists the resources whose live state differs from the target state
+	ModifiedResources []ResourceSummary `json:"modifiedResources,omitempty"`
+}
+
+// ManifestsExportStatus describes an export of the rendered manifests
+type ManifestsExportStatus struct {
+	// Kind of the object the manifests were exported to
+	Kind string `json:"kind,omitempty"`
+	// Name of the object the manifests were exported to
+	Name string `json:"name,omitempty"`
+	// Namespace of the object the manifests were exported to
+	Namespace string `json:"namespace,omitempty"`
+	// Revision is the revision the manifests were rendered for
+	Revision string `json:"revision"`
+	// Manifests is the number of exported manifests
+	Manifests int64 `json:"manifests"`
+	// Chunks is the number of objects the manifests are split across
+	Chunks int64 `json:"chunks"`
+	// ExportedAt indicates when the manifests were exported
+	ExportedAt *metav1.Time `json:"exportedAt,omitempty"`
+}
+
 // ComparedTo contains application source and target which was used for resources comparison
 type ComparedTo struct {
 	// Source is a reference to the application's source used for comparison
-	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
+	Source ApplicationSourceObservation `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	// Destination is a reference to the application's destination used for comparison
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,opt,name=destination"`
 	// Sources is a reference to the application's multiple sources used for comparison
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,3,opt,name=sources"`
+	Sources ApplicationSourceObservations `json:"sources,omitempty" protobuf:"bytes,3,opt,name=sources"`
 }
 
 // RevisionHistory contains history information about a previous sync
@@ -569,11 +690,11 @@ type RevisionHistory struct {
 	// ID is an auto incrementing identifier of the RevisionHistory
 	ID *int64 `json:"id" protobuf:"bytes,5,opt,name=id"`
 	// Source is a reference to the application source used for the sync operation
-	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
+	Source ApplicationSourceObservation `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
 	// DeployStartedAt holds the time the sync operation started
 	DeployStartedAt *metav1.Time `json:"deployStartedAt,omitempty" protobuf:"bytes,7,opt,name=deployStartedAt"`
 	// Sources is a reference to the application sources used for the sync operation
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	Sources ApplicationSourceObservations `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
 	// Revisions holds the revision of each source in sources field the sync was performed against
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 }
@@ -600,13 +721,61 @@ type SyncOperationResult struct {
 	// Revision holds the revision this sync operation was performed to
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Source records the application source information of the sync, used for comparing auto-sync
-	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
+	Source ApplicationSourceObservation `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
 	// Source records the application source information of the sync, used for comparing auto-sync
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,4,opt,name=sources"`
+	Sources ApplicationSourceObservations `json:"sources,omitempty" protobuf:"bytes,4,opt,name=sources"`
 	// Revisions holds the revision this sync operation was performed for respective indexed source in sources field
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 }
 
+// ResourceSummary identifies a resource of an application and its health
+type ResourceSummary struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      string  `json:"kind"`
+	Namespace *string `json:"namespace,omitempty"`
+	Name      string  `json:"name"`
+	// Health is the health status of the resource
+	Health *string `json:"health,omitempty"`
+	// Message describes the health status of the resource
+	Message *string `json:"message,omitempty"`
+}
+
+// ApplicationSources contains list of required information about the sources of an application.
+// ApplicationParameters.Sources is declared as a plain slice, so that references
+// of the sources are resolved, but remains assignable to ApplicationSources.
+type ApplicationSources []ApplicationSource
+
+// ApplicationSourceObservation contains all required information about the source of an application
+// as reported in the status
+type ApplicationSourceObservation struct {
+	// RepoURL is the URL to the repository (Git or Helm) that contains the application manifests
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Path is a directory path within the Git repository, and is only valid for applications sourced from Git.
+	Path *string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+	// TargetRevision defines the revision of the source to sync the application to.
+	// In case of Git, this can be commit, tag, or branch. If omitted, will equal to HEAD.
+	// In case of Helm, this is a semver tag for the Chart's version.
+	TargetRevision *string `json:"targetRevision,omitempty" protobuf:"bytes,4,opt,name=targetRevision"`
+	// Helm holds helm specific options
+	Helm *ApplicationSourceHelm `json:"helm,omitempty" protobuf:"bytes,7,opt,name=helm"`
+	// Kustomize holds kustomize specific options
+	Kustomize *ApplicationSourceKustomize `json:"kustomize,omitempty" protobuf:"bytes,8,opt,name=kustomize"`
+	// Directory holds path/directory specific options
+	Directory *ApplicationSourceDirectory `json:"directory,omitempty" protobuf:"bytes,10,opt,name=directory"`
+	// Plugin holds config management plugin specific options
+	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
+	// Chart is a Helm chart name, and must be specified for applications sourced from a Helm repo.
+	Chart *string `json:"chart,omitempty" protobuf:"bytes,12,opt,name=chart"`
+	// Ref is reference to another source within sources field. This field will not be used if used with a `source` tag.
+	Ref *string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
+	// Name is the name of the application source
+	Name string `json:"name,omitempty" protobuf:"bytes,14,opt,name=name"`
+}
+
+// ApplicationSourceObservations contains list of required information about the sources of an application
+// as reported in the status
+type ApplicationSourceObservations []ApplicationSourceObservation
+
 // SyncOperation contains details about a sync operation.
 type SyncOperation struct {
 	// Revision is the revision (Git) or chart version (Helm) which to sync the application to
@@ -622,14 +791,14 @@ type SyncOperation struct {
 	Resources []SyncOperationResource `json:"resources,omitempty" protobuf:"bytes,6,opt,name=resources"`
 	// Source overrides the source definition set in the application.
 	// This is typically set in a Rollback operation and is nil during a Sync operation
-	Source *ApplicationSource `json:"source,omitempty" protobuf:"bytes,7,opt,name=source"`
+	Source *ApplicationSourceObservation `json:"source,omitempty" protobuf:"bytes,7,opt,name=source"`
 	// Manifests is an optional field that overrides sync source with a local directory for development
 	Manifests []string `json:"manifests,omitempty" protobuf:"bytes,8,opt,name=manifests"`
 	// SyncOptions provide per-sync sync-options, e.g. Validate=false
 	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,9,opt,name=syncOptions"`
 	// Sources overrides the source definition set in the application.
 	// This is typically set in a Rollback operation and is nil during a Sync operation
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,10,opt,name=sources"`
+	Sources ApplicationSourceObservations `json:"sources,omitempty" protobuf:"bytes,10,opt,name=sources"`
 	// Revisions is the list of revision (Git) or chart version (Helm) which to sync each source in sources field for the application to
 	// If omitted, will use the revision specified in app spec.
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
@@ -702,3 +871,192 @@ type SyncStrategyHook struct {
 	// +optional
 	SyncStrategyApply `json:",inline" protobuf:"bytes,1,opt,name=syncStrategyApply"`
 }
+
+// ApplicationSyncParameters define the sync operation that is triggered on
+// an ArgoCD Application. The sync is triggered once, changes to the
+// parameters afterwards have no effect.
+type ApplicationSyncParameters struct {
+	// Application is the name of the application to sync
+	// +crossplane:generate:reference:type=Application
+	// +crossplane:generate:reference:refFieldName=ApplicationRef
+	// +crossplane:generate:reference:selectorFieldName=ApplicationSelector
+	// +optional
+	Application *string `json:"application,omitempty"`
+
+	// ApplicationRef is a reference to an Application used to set Application
+	// +optional
+	ApplicationRef *v1.NamespacedReference `json:"applicationRef,omitempty"`
+
+	// ApplicationSelector selects reference to an Application used to set Application
+	// +optional
+	ApplicationSelector *v1.NamespacedSelector `json:"applicationSelector,omitempty"`
+
+	// AppNamespace is the namespace of the application in the ArgoCD server
+	// +optional
+	AppNamespace *string `json:"appNamespace,omitempty"`
+
+	// Project is the project of the application
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// Revision is the revision (Git) or chart version (Helm) to sync the
+	// application to. If omitted, the revision of the application spec is used.
+	// +optional
+	Revision *string `json:"revision,omitempty"`
+
+	// Prune specifies to delete resources that are no longer tracked in git
+	// +optional
+	Prune *bool `json:"prune,omitempty"`
+
+	// DryRun specifies to perform a `kubectl apply --dry-run` without
+	// actually performing the sync
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Force specifies to use a force apply
+	// +optional
+	Force *bool `json:"force,omitempty"`
+
+	// Resources limits the sync to the given resources
+	// +optional
+	Resources []SyncOperationResource `json:"resources,omitempty"`
+
+	// SyncOptions provide per-sync sync-options, e.g. Validate=false
+	// +optional
+	SyncOptions SyncOptions `json:"syncOptions,omitempty"`
+}
+
+// ApplicationSyncObservation represents the observed state of a sync operation
+type ApplicationSyncObservation struct {
+	// OperationState contains information about the triggered sync operation
+	// +optional
+	OperationState *OperationState `json:"operationState,omitempty"`
+}
+
+// ApplicationRollbackParameters define the rollback operation that is
+// triggered on an ArgoCD Application. The rollback is triggered once, changes
+// to the parameters afterwards have no effect.
+type ApplicationRollbackParameters struct {
+	// Application is the name of the application to roll back
+	// +crossplane:generate:reference:type=Application
+	// +crossplane:generate:reference:refFieldName=ApplicationRef
+	// +crossplane:generate:reference:selectorFieldName=ApplicationSelector
+	// +optional
+	Application *string `json:"application,omitempty"`
+
+	// ApplicationRef is a reference to an Application used to set Application
+	// +optional
+	ApplicationRef *v1.NamespacedReference `json:"applicationRef,omitempty"`
+
+	// ApplicationSelector selects reference to an Application used to set Application
+	// +optional
+	ApplicationSelector *v1.NamespacedSelector `json:"applicationSelector,omitempty"`
+
+	// AppNamespace is the namespace of the application in the ArgoCD server
+	// +optional
+	AppNamespace *string `json:"appNamespace,omitempty"`
+
+	// Project is the project of the application
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// HistoryID is the ID of the history entry to roll back to, or "previous"
+	// to roll back to the deployment before the current one.
+	// +kubebuilder:validation:Pattern=`^([0-9]+|previous)$`
+	HistoryID string `json:"historyID"`
+
+	// Prune specifies to delete resources that are no longer tracked
+	// +optional
+	Prune *bool `json:"prune,omitempty"`
+
+	// DryRun specifies to perform a `kubectl apply --dry-run` without
+	// actually performing the rollback
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// ApplicationRollbackObservation represents the observed state of a rollback operation
+type ApplicationRollbackObservation struct {
+	// HistoryID is the resolved ID of the history entry rolled back to
+	// +optional
+	HistoryID *int64 `json:"historyID,omitempty"`
+
+	// Revision is the revision of the history entry rolled back to
+	// +optional
+	Revision *string `json:"revision,omitempty"`
+
+	// OperationState contains information about the triggered rollback operation
+	// +optional
+	OperationState *OperationState `json:"operationState,omitempty"`
+}
+
+// ResourceActionParameters define the resource action that is run on a
+// resource of an ArgoCD Application.
+type ResourceActionParameters struct {
+	// Application is the name of the application the resource belongs to
+	// +crossplane:generate:reference:type=Application
+	// +crossplane:generate:reference:refFieldName=ApplicationRef
+	// +crossplane:generate:reference:selectorFieldName=ApplicationSelector
+	// +optional
+	Application *string `json:"application,omitempty"`
+
+	// ApplicationRef is a reference to an Application used to set Application
+	// +optional
+	ApplicationRef *v1.NamespacedReference `json:"applicationRef,omitempty"`
+
+	// ApplicationSelector selects reference to an Application used to set Application
+	// +optional
+	ApplicationSelector *v1.NamespacedSelector `json:"applicationSelector,omitempty"`
+
+	// AppNamespace is the namespace of the application in the ArgoCD server
+	// +optional
+	AppNamespace *string `json:"appNamespace,omitempty"`
+
+	// Project is the project of the application
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// Group is the API group of the resource, empty for the core group
+	// +optional
+	Group *string `json:"group,omitempty"`
+
+	// Version is the API version of the resource
+	Version string `json:"version"`
+
+	// Kind is the kind of the resource
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the resource, empty for cluster scoped resources
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource
+	Name string `json:"name"`
+
+	// Action is the name of the action to run, e.g. restart
+	Action string `json:"action"`
+
+	// Trigger is an arbitrary value. The action is run again whenever the
+	// value changes.
+	// +optional
+	Trigger *string `json:"trigger,omitempty"`
+}
+
+// ResourceActionObservation represents the observed state of a resource action
+type ResourceActionObservation struct {
+	// LastTrigger is the trigger value the action was last run for
+	// +optional
+	LastTrigger *string `json:"lastTrigger,omitempty"`
+
+	// LastRunTime is the time the action was last run
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// Result is the result of the last run, either Succeeded or Failed
+	// +optional
+	Result *string `json:"result,omitempty"`
+
+	// Message contains the error of the last run if it failed
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
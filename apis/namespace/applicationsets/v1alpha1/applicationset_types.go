@@ -17,7 +17,7 @@ limitations under the License.
 package v1alpha1
 
 // Copy types from cluster-scope apis replace references with namespace types:
-//go:generate go run -modfile ../../../../tools/go.mod -tags generate github.com/mistermx/copystruct/cmd/copystruct ../../../cluster/applicationsets/v1alpha1 zz_generated.types.copied.go ApplicationSetParameters,ArgoApplicationSetStatus
+//go:generate go run -modfile ../../../../tools/go.mod -tags generate github.com/mistermx/copystruct/cmd/copystruct ../../../cluster/applicationsets/v1alpha1 zz_generated.types.copied.go ApplicationSetParameters,ArgoApplicationSetStatus,ApplicationSetDiscoveryParameters,ApplicationSetDiscoveryObservation
 //go:generate sed -i s|github\.com/crossplane-contrib/provider-argocd/apis/cluster|github.com/crossplane-contrib/provider-argocd/apis/namespace|g zz_generated.types.copied.go
 //go:generate sed -i s|commonv1\.Reference|commonv1.NamespacedReference|g zz_generated.types.copied.go
 //go:generate sed -i s|commonv1\.Selector|commonv1.NamespacedSelector|g zz_generated.types.copied.go
@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ApplicationSetDiscoveryParameters and ApplicationSetDiscoveryObservation are
+// copied together with the ApplicationSet types into zz_generated.types.copied.go.
+
+// An ApplicationSetDiscoverySpec defines the application sets listed by an
+// ApplicationSetDiscovery resource.
+type ApplicationSetDiscoverySpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ApplicationSetDiscoveryParameters `json:"forProvider"`
+}
+
+// An ApplicationSetDiscoveryStatus represents the discovered application sets.
+type ApplicationSetDiscoveryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApplicationSetDiscoveryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApplicationSetDiscovery is an observe-only managed resource that lists
+// the application sets of an ArgoCD instance and reports the ones that are
+// not managed by an ApplicationSet managed resource in the same namespace yet.
+// It never creates, updates or deletes anything in ArgoCD.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MANAGED",type="integer",JSONPath=".status.atProvider.managed"
+// +kubebuilder:printcolumn:name="UNMANAGED",type="integer",JSONPath=".status.atProvider.unmanaged"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type ApplicationSetDiscovery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSetDiscoverySpec   `json:"spec"`
+	Status ApplicationSetDiscoveryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationSetDiscoveryList contains a list of ApplicationSetDiscovery items
+type ApplicationSetDiscoveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationSetDiscovery `json:"items"`
+}
+
+// ApplicationSetDiscovery type metadata
+var (
+	ApplicationSetDiscoveryKind             = reflect.TypeOf(ApplicationSetDiscovery{}).Name()
+	ApplicationSetDiscoveryGroupKind        = schema.GroupKind{Group: Group, Kind: ApplicationSetDiscoveryKind}.String()
+	ApplicationSetDiscoveryKindAPIVersion   = ApplicationSetDiscoveryKind + "." + SchemeGroupVersion.String()
+	ApplicationSetDiscoveryGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationSetDiscoveryKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ApplicationSetDiscovery{}, &ApplicationSetDiscoveryList{})
+}
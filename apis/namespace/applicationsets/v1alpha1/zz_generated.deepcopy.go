@@ -193,6 +193,160 @@ func (in *ApplicationSetCondition) DeepCopy() *ApplicationSetCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetDiscovery) DeepCopyInto(out *ApplicationSetDiscovery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetDiscovery.
+func (in *ApplicationSetDiscovery) DeepCopy() *ApplicationSetDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationSetDiscovery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetDiscoveryList) DeepCopyInto(out *ApplicationSetDiscoveryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationSetDiscovery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetDiscoveryList.
+func (in *ApplicationSetDiscoveryList) DeepCopy() *ApplicationSetDiscoveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetDiscoveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationSetDiscoveryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetDiscoveryObservation) DeepCopyInto(out *ApplicationSetDiscoveryObservation) {
+	*out = *in
+	if in.UnmanagedApplicationSets != nil {
+		in, out := &in.UnmanagedApplicationSets, &out.UnmanagedApplicationSets
+		*out = make([]DiscoveredApplicationSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetDiscoveryObservation.
+func (in *ApplicationSetDiscoveryObservation) DeepCopy() *ApplicationSetDiscoveryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetDiscoveryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetDiscoveryParameters) DeepCopyInto(out *ApplicationSetDiscoveryParameters) {
+	*out = *in
+	if in.AppsetNamespace != nil {
+		in, out := &in.AppsetNamespace, &out.AppsetNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetDiscoveryParameters.
+func (in *ApplicationSetDiscoveryParameters) DeepCopy() *ApplicationSetDiscoveryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetDiscoveryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetDiscoverySpec) DeepCopyInto(out *ApplicationSetDiscoverySpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetDiscoverySpec.
+func (in *ApplicationSetDiscoverySpec) DeepCopy() *ApplicationSetDiscoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetDiscoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetDiscoveryStatus) DeepCopyInto(out *ApplicationSetDiscoveryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetDiscoveryStatus.
+func (in *ApplicationSetDiscoveryStatus) DeepCopy() *ApplicationSetDiscoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetDiscoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 	*out = *in
@@ -424,6 +578,28 @@ func (in *ApplicationSetParameters) DeepCopyInto(out *ApplicationSetParameters)
 		*out = new(string)
 		**out = **in
 	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ObserveApplications != nil {
+		in, out := &in.ObserveApplications, &out.ObserveApplications
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireHealthyApplications != nil {
+		in, out := &in.RequireHealthyApplications, &out.RequireHealthyApplications
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GeneratorSecrets != nil {
+		in, out := &in.GeneratorSecrets, &out.GeneratorSecrets
+		*out = make([]GeneratorSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetParameters.
@@ -436,6 +612,28 @@ func (in *ApplicationSetParameters) DeepCopy() *ApplicationSetParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetPreview) DeepCopyInto(out *ApplicationSetPreview) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PreviewApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetPreview.
+func (in *ApplicationSetPreview) DeepCopy() *ApplicationSetPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetResourceIgnoreDifferences) DeepCopyInto(out *ApplicationSetResourceIgnoreDifferences) {
 	*out = *in
@@ -638,6 +836,16 @@ func (in *ApplicationSetTemplateMeta) DeepCopy() *ApplicationSetTemplateMeta {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 	*out = *in
+	if in.RepoURLRef != nil {
+		in, out := &in.RepoURLRef, &out.RepoURLRef
+		*out = new(commonv1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoURLSelector != nil {
+		in, out := &in.RepoURLSelector, &out.RepoURLSelector
+		*out = new(commonv1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Path != nil {
 		in, out := &in.Path, &out.Path
 		*out = new(string)
@@ -1065,6 +1273,16 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Destination.DeepCopyInto(&out.Destination)
+	if in.ProjectRef != nil {
+		in, out := &in.ProjectRef, &out.ProjectRef
+		*out = new(commonv1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(commonv1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SyncPolicy != nil {
 		in, out := &in.SyncPolicy, &out.SyncPolicy
 		*out = new(SyncPolicy)
@@ -1089,7 +1307,7 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 	}
 	if in.Sources != nil {
 		in, out := &in.Sources, &out.Sources
-		*out = make(ApplicationSources, len(*in))
+		*out = make([]ApplicationSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1135,6 +1353,21 @@ func (in *ArgoApplicationSetStatus) DeepCopyInto(out *ArgoApplicationSetStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(ApplicationSetPreview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeneratedApplications != nil {
+		in, out := &in.GeneratedApplications, &out.GeneratedApplications
+		*out = new(GeneratedApplicationsSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeneratorSecrets != nil {
+		in, out := &in.GeneratorSecrets, &out.GeneratorSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoApplicationSetStatus.
@@ -1276,6 +1509,31 @@ func (in *ConfigMapKeyRef) DeepCopy() *ConfigMapKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredApplicationSet) DeepCopyInto(out *DiscoveredApplicationSet) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredApplicationSet.
+func (in *DiscoveredApplicationSet) DeepCopy() *DiscoveredApplicationSet {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredApplicationSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DrySource) DeepCopyInto(out *DrySource) {
 	*out = *in
@@ -1360,6 +1618,113 @@ func (in *EnvEntry) DeepCopy() *EnvEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedApplicationSummary) DeepCopyInto(out *GeneratedApplicationSummary) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedApplicationSummary.
+func (in *GeneratedApplicationSummary) DeepCopy() *GeneratedApplicationSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedApplicationSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedApplicationsSummary) DeepCopyInto(out *GeneratedApplicationsSummary) {
+	*out = *in
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Unhealthy != nil {
+		in, out := &in.Unhealthy, &out.Unhealthy
+		*out = make([]GeneratedApplicationSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedApplicationsSummary.
+func (in *GeneratedApplicationsSummary) DeepCopy() *GeneratedApplicationsSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedApplicationsSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorSecret) DeepCopyInto(out *GeneratorSecret) {
+	*out = *in
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorSecret.
+func (in *GeneratorSecret) DeepCopy() *GeneratorSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorSecretSource) DeepCopyInto(out *GeneratorSecretSource) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorSecretSource.
+func (in *GeneratorSecretSource) DeepCopy() *GeneratorSecretSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorSecretSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitDirectoryGeneratorItem) DeepCopyInto(out *GitDirectoryGeneratorItem) {
 	*out = *in
@@ -1934,6 +2299,99 @@ func (in PluginParameters) DeepCopy() PluginParameters {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewApplication) DeepCopyInto(out *PreviewApplication) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	in.Destination.DeepCopyInto(&out.Destination)
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]PreviewSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewApplication.
+func (in *PreviewApplication) DeepCopy() *PreviewApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewDestination) DeepCopyInto(out *PreviewDestination) {
+	*out = *in
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewDestination.
+func (in *PreviewDestination) DeepCopy() *PreviewDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewSource) DeepCopyInto(out *PreviewSource) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetRevision != nil {
+		in, out := &in.TargetRevision, &out.TargetRevision
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewSource.
+func (in *PreviewSource) DeepCopy() *PreviewSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestGenerator) DeepCopyInto(out *PullRequestGenerator) {
 	*out = *in
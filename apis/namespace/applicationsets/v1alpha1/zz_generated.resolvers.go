@@ -20,7 +20,9 @@ package v1alpha1
 
 import (
 	"context"
-	v1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/namespace/cluster/v1alpha1"
+	v1alpha11 "github.com/crossplane-contrib/provider-argocd/apis/namespace/cluster/v1alpha1"
+	v1alpha12 "github.com/crossplane-contrib/provider-argocd/apis/namespace/projects/v1alpha1"
+	v1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/namespace/repositories/v1alpha1"
 	reference "github.com/crossplane/crossplane-runtime/v2/pkg/reference"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,17 +35,40 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 	var rsp reference.NamespacedResolutionResponse
 	var err error
 
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].List != nil {
+			if mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Source != nil {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Source.RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Source.RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Source.RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Source.RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].List != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Destination.Server),
-				Extract:      reference.ExternalName(),
+				Extract:      v1alpha11.ServerAddress(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Destination.ServerRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Destination.ServerSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -58,13 +83,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 		if mg.Spec.ForProvider.Generators[i3].List != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Destination.Name),
-				Extract:      v1alpha1.ServerName(),
+				Extract:      v1alpha11.ServerName(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Destination.NameRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Destination.NameSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -75,17 +100,84 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].List != nil {
+			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Project,
+				Extract:      reference.ExternalName(),
+				Namespace:    mg.GetNamespace(),
+				Reference:    mg.Spec.ForProvider.Generators[i3].List.Template.Spec.ProjectRef,
+				Selector:     mg.Spec.ForProvider.Generators[i3].List.Template.Spec.ProjectSelector,
+				To: reference.To{
+					List:    &v1alpha12.ProjectList{},
+					Managed: &v1alpha12.Project{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Project")
+			}
+			mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Project = rsp.ResolvedValue
+			mg.Spec.ForProvider.Generators[i3].List.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].List != nil {
+			for i7 := 0; i7 < len(mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Sources); i7++ {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Sources[i7].RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Sources[i7].RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Sources[i7].RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Sources[i7].RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Sources[i7].RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].List.Template.Spec.Sources[i7].RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Clusters != nil {
+			if mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Source != nil {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Source.RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Source.RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Source.RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Source.RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Clusters != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Destination.Server),
-				Extract:      reference.ExternalName(),
+				Extract:      v1alpha11.ServerAddress(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Destination.ServerRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Destination.ServerSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -100,13 +192,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 		if mg.Spec.ForProvider.Generators[i3].Clusters != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Destination.Name),
-				Extract:      v1alpha1.ServerName(),
+				Extract:      v1alpha11.ServerName(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Destination.NameRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Destination.NameSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -117,17 +209,84 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Clusters != nil {
+			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Project,
+				Extract:      reference.ExternalName(),
+				Namespace:    mg.GetNamespace(),
+				Reference:    mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.ProjectRef,
+				Selector:     mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.ProjectSelector,
+				To: reference.To{
+					List:    &v1alpha12.ProjectList{},
+					Managed: &v1alpha12.Project{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Project")
+			}
+			mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Project = rsp.ResolvedValue
+			mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Clusters != nil {
+			for i7 := 0; i7 < len(mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Sources); i7++ {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Sources[i7].RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Sources[i7].RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Sources[i7].RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Sources[i7].RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Sources[i7].RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Clusters.Template.Spec.Sources[i7].RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Git != nil {
+			if mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Source != nil {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Source.RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Source.RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Source.RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Source.RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Git != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Destination.Server),
-				Extract:      reference.ExternalName(),
+				Extract:      v1alpha11.ServerAddress(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Destination.ServerRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Destination.ServerSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -142,13 +301,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 		if mg.Spec.ForProvider.Generators[i3].Git != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Destination.Name),
-				Extract:      v1alpha1.ServerName(),
+				Extract:      v1alpha11.ServerName(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Destination.NameRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Destination.NameSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -159,17 +318,84 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Git != nil {
+			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Project,
+				Extract:      reference.ExternalName(),
+				Namespace:    mg.GetNamespace(),
+				Reference:    mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.ProjectRef,
+				Selector:     mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.ProjectSelector,
+				To: reference.To{
+					List:    &v1alpha12.ProjectList{},
+					Managed: &v1alpha12.Project{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Project")
+			}
+			mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Project = rsp.ResolvedValue
+			mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Git != nil {
+			for i7 := 0; i7 < len(mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Sources); i7++ {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Sources[i7].RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Sources[i7].RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Sources[i7].RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Sources[i7].RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Sources[i7].RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Git.Template.Spec.Sources[i7].RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].SCMProvider != nil {
+			if mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Source != nil {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Source.RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Source.RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Source.RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Source.RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].SCMProvider != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Destination.Server),
-				Extract:      reference.ExternalName(),
+				Extract:      v1alpha11.ServerAddress(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Destination.ServerRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Destination.ServerSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -184,13 +410,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 		if mg.Spec.ForProvider.Generators[i3].SCMProvider != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Destination.Name),
-				Extract:      v1alpha1.ServerName(),
+				Extract:      v1alpha11.ServerName(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Destination.NameRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Destination.NameSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -201,17 +427,84 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].SCMProvider != nil {
+			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Project,
+				Extract:      reference.ExternalName(),
+				Namespace:    mg.GetNamespace(),
+				Reference:    mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.ProjectRef,
+				Selector:     mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.ProjectSelector,
+				To: reference.To{
+					List:    &v1alpha12.ProjectList{},
+					Managed: &v1alpha12.Project{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Project")
+			}
+			mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Project = rsp.ResolvedValue
+			mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].SCMProvider != nil {
+			for i7 := 0; i7 < len(mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Sources); i7++ {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Sources[i7].RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Sources[i7].RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Sources[i7].RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Sources[i7].RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Sources[i7].RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].SCMProvider.Template.Spec.Sources[i7].RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource != nil {
+			if mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Source != nil {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Source.RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Source.RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Source.RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Source.RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Destination.Server),
-				Extract:      reference.ExternalName(),
+				Extract:      v1alpha11.ServerAddress(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Destination.ServerRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Destination.ServerSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -226,13 +519,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 		if mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Destination.Name),
-				Extract:      v1alpha1.ServerName(),
+				Extract:      v1alpha11.ServerName(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Destination.NameRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Destination.NameSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -243,17 +536,84 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource != nil {
+			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Project,
+				Extract:      reference.ExternalName(),
+				Namespace:    mg.GetNamespace(),
+				Reference:    mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.ProjectRef,
+				Selector:     mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.ProjectSelector,
+				To: reference.To{
+					List:    &v1alpha12.ProjectList{},
+					Managed: &v1alpha12.Project{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Project")
+			}
+			mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Project = rsp.ResolvedValue
+			mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource != nil {
+			for i7 := 0; i7 < len(mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Sources); i7++ {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Sources[i7].RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Sources[i7].RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Sources[i7].RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Sources[i7].RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Sources[i7].RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].ClusterDecisionResource.Template.Spec.Sources[i7].RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].PullRequest != nil {
+			if mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Source != nil {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Source.RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Source.RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Source.RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Source.RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].PullRequest != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Destination.Server),
-				Extract:      reference.ExternalName(),
+				Extract:      v1alpha11.ServerAddress(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Destination.ServerRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Destination.ServerSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -268,13 +628,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 		if mg.Spec.ForProvider.Generators[i3].PullRequest != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Destination.Name),
-				Extract:      v1alpha1.ServerName(),
+				Extract:      v1alpha11.ServerName(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Destination.NameRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Destination.NameSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -285,19 +645,90 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].PullRequest != nil {
+			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Project,
+				Extract:      reference.ExternalName(),
+				Namespace:    mg.GetNamespace(),
+				Reference:    mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.ProjectRef,
+				Selector:     mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.ProjectSelector,
+				To: reference.To{
+					List:    &v1alpha12.ProjectList{},
+					Managed: &v1alpha12.Project{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Project")
+			}
+			mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Project = rsp.ResolvedValue
+			mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].PullRequest != nil {
+			for i7 := 0; i7 < len(mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Sources); i7++ {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Sources[i7].RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Sources[i7].RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Sources[i7].RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Sources[i7].RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Sources[i7].RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].PullRequest.Template.Spec.Sources[i7].RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List != nil {
+					if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -316,13 +747,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -335,19 +766,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].List.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters != nil {
+					if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -366,13 +876,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -385,19 +895,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git != nil {
+					if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -416,13 +1005,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -438,16 +1027,95 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
-				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider != nil {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
-						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Destination.Server),
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Project,
 						Extract:      reference.ExternalName(),
 						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Git.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider != nil {
+					if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Destination.Server),
+						Extract:      v1alpha11.ServerAddress(),
+						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -466,13 +1134,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -485,19 +1153,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource != nil {
+					if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -516,13 +1263,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -535,19 +1282,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest != nil {
+					if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -566,13 +1392,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -585,19 +1411,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin != nil {
+					if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -616,13 +1521,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -635,17 +1540,92 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Matrix.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			if mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Source != nil {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Source.RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Source.RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Source.RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Source.RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Destination.Server),
-				Extract:      reference.ExternalName(),
+				Extract:      v1alpha11.ServerAddress(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Destination.ServerRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Destination.ServerSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -660,13 +1640,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Destination.Name),
-				Extract:      v1alpha1.ServerName(),
+				Extract:      v1alpha11.ServerName(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Destination.NameRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Destination.NameSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -677,19 +1657,90 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Project,
+				Extract:      reference.ExternalName(),
+				Namespace:    mg.GetNamespace(),
+				Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.ProjectRef,
+				Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.ProjectSelector,
+				To: reference.To{
+					List:    &v1alpha12.ProjectList{},
+					Managed: &v1alpha12.Project{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Project")
+			}
+			mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Project = rsp.ResolvedValue
+			mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Matrix != nil {
+			for i7 := 0; i7 < len(mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Sources); i7++ {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Sources[i7].RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Sources[i7].RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Sources[i7].RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Sources[i7].RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Sources[i7].RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Matrix.Template.Spec.Sources[i7].RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List != nil {
+					if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -708,13 +1759,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -727,19 +1778,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].List.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters != nil {
+					if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -758,13 +1888,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -777,19 +1907,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Clusters.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git != nil {
+					if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -808,13 +2017,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -827,19 +2036,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Git.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider != nil {
+					if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -858,13 +2146,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -877,19 +2165,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].SCMProvider.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource != nil {
+					if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -908,13 +2275,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -927,19 +2294,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].ClusterDecisionResource.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest != nil {
+					if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -958,13 +2404,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -977,19 +2423,98 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].PullRequest.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin != nil {
+					if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Source != nil {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Source.RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Source.RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Source.RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Source.RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Destination.Server),
-						Extract:      reference.ExternalName(),
+						Extract:      v1alpha11.ServerAddress(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Destination.ServerRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Destination.ServerSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -1008,13 +2533,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin != nil {
 					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 						CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Destination.Name),
-						Extract:      v1alpha1.ServerName(),
+						Extract:      v1alpha11.ServerName(),
 						Namespace:    mg.GetNamespace(),
 						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Destination.NameRef,
 						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Destination.NameSelector,
 						To: reference.To{
-							List:    &v1alpha1.ClusterList{},
-							Managed: &v1alpha1.Cluster{},
+							List:    &v1alpha11.ClusterList{},
+							Managed: &v1alpha11.Cluster{},
 						},
 					})
 					if err != nil {
@@ -1027,17 +2552,92 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin != nil {
+					rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Project,
+						Extract:      reference.ExternalName(),
+						Namespace:    mg.GetNamespace(),
+						Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.ProjectRef,
+						Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.ProjectSelector,
+						To: reference.To{
+							List:    &v1alpha12.ProjectList{},
+							Managed: &v1alpha12.Project{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Project")
+					}
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Project = rsp.ResolvedValue
+					mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators); i5++ {
+				if mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin != nil {
+					for i9 := 0; i9 < len(mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Sources); i9++ {
+						rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+							CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURL,
+							Extract:      reference.ExternalName(),
+							Namespace:    mg.GetNamespace(),
+							Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURLRef,
+							Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURLSelector,
+							To: reference.To{
+								List:    &v1alpha1.RepositoryList{},
+								Managed: &v1alpha1.Repository{},
+							},
+						})
+						if err != nil {
+							return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURL")
+						}
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURL = rsp.ResolvedValue
+						mg.Spec.ForProvider.Generators[i3].Merge.Generators[i5].Plugin.Template.Spec.Sources[i9].RepoURLRef = rsp.ResolvedReference
+
+					}
+				}
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			if mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Source != nil {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Source.RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Source.RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Source.RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Source.RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Destination.Server),
-				Extract:      reference.ExternalName(),
+				Extract:      v1alpha11.ServerAddress(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Destination.ServerRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Destination.ServerSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -1052,13 +2652,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Destination.Name),
-				Extract:      v1alpha1.ServerName(),
+				Extract:      v1alpha11.ServerName(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Destination.NameRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Destination.NameSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -1069,17 +2669,84 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Project,
+				Extract:      reference.ExternalName(),
+				Namespace:    mg.GetNamespace(),
+				Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.ProjectRef,
+				Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.ProjectSelector,
+				To: reference.To{
+					List:    &v1alpha12.ProjectList{},
+					Managed: &v1alpha12.Project{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Project")
+			}
+			mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Project = rsp.ResolvedValue
+			mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Merge != nil {
+			for i7 := 0; i7 < len(mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Sources); i7++ {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Sources[i7].RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Sources[i7].RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Sources[i7].RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Sources[i7].RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Sources[i7].RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Merge.Template.Spec.Sources[i7].RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Plugin != nil {
+			if mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Source != nil {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Source.RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Source.RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Source.RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Source.RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
 		if mg.Spec.ForProvider.Generators[i3].Plugin != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Destination.Server),
-				Extract:      reference.ExternalName(),
+				Extract:      v1alpha11.ServerAddress(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Destination.ServerRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Destination.ServerSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -1094,13 +2761,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 		if mg.Spec.ForProvider.Generators[i3].Plugin != nil {
 			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Destination.Name),
-				Extract:      v1alpha1.ServerName(),
+				Extract:      v1alpha11.ServerName(),
 				Namespace:    mg.GetNamespace(),
 				Reference:    mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Destination.NameRef,
 				Selector:     mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Destination.NameSelector,
 				To: reference.To{
-					List:    &v1alpha1.ClusterList{},
-					Managed: &v1alpha1.Cluster{},
+					List:    &v1alpha11.ClusterList{},
+					Managed: &v1alpha11.Cluster{},
 				},
 			})
 			if err != nil {
@@ -1111,15 +2778,78 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Plugin != nil {
+			rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Project,
+				Extract:      reference.ExternalName(),
+				Namespace:    mg.GetNamespace(),
+				Reference:    mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.ProjectRef,
+				Selector:     mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.ProjectSelector,
+				To: reference.To{
+					List:    &v1alpha12.ProjectList{},
+					Managed: &v1alpha12.Project{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Project")
+			}
+			mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Project = rsp.ResolvedValue
+			mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Generators); i3++ {
+		if mg.Spec.ForProvider.Generators[i3].Plugin != nil {
+			for i7 := 0; i7 < len(mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Sources); i7++ {
+				rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Sources[i7].RepoURL,
+					Extract:      reference.ExternalName(),
+					Namespace:    mg.GetNamespace(),
+					Reference:    mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Sources[i7].RepoURLRef,
+					Selector:     mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Sources[i7].RepoURLSelector,
+					To: reference.To{
+						List:    &v1alpha1.RepositoryList{},
+						Managed: &v1alpha1.Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Sources[i7].RepoURL")
+				}
+				mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Sources[i7].RepoURL = rsp.ResolvedValue
+				mg.Spec.ForProvider.Generators[i3].Plugin.Template.Spec.Sources[i7].RepoURLRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	if mg.Spec.ForProvider.Template.Spec.Source != nil {
+		rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.Template.Spec.Source.RepoURL,
+			Extract:      reference.ExternalName(),
+			Namespace:    mg.GetNamespace(),
+			Reference:    mg.Spec.ForProvider.Template.Spec.Source.RepoURLRef,
+			Selector:     mg.Spec.ForProvider.Template.Spec.Source.RepoURLSelector,
+			To: reference.To{
+				List:    &v1alpha1.RepositoryList{},
+				Managed: &v1alpha1.Repository{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Template.Spec.Source.RepoURL")
+		}
+		mg.Spec.ForProvider.Template.Spec.Source.RepoURL = rsp.ResolvedValue
+		mg.Spec.ForProvider.Template.Spec.Source.RepoURLRef = rsp.ResolvedReference
+
+	}
 	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Template.Spec.Destination.Server),
-		Extract:      reference.ExternalName(),
+		Extract:      v1alpha11.ServerAddress(),
 		Namespace:    mg.GetNamespace(),
 		Reference:    mg.Spec.ForProvider.Template.Spec.Destination.ServerRef,
 		Selector:     mg.Spec.ForProvider.Template.Spec.Destination.ServerSelector,
 		To: reference.To{
-			List:    &v1alpha1.ClusterList{},
-			Managed: &v1alpha1.Cluster{},
+			List:    &v1alpha11.ClusterList{},
+			Managed: &v1alpha11.Cluster{},
 		},
 	})
 	if err != nil {
@@ -1130,13 +2860,13 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 
 	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Template.Spec.Destination.Name),
-		Extract:      v1alpha1.ServerName(),
+		Extract:      v1alpha11.ServerName(),
 		Namespace:    mg.GetNamespace(),
 		Reference:    mg.Spec.ForProvider.Template.Spec.Destination.NameRef,
 		Selector:     mg.Spec.ForProvider.Template.Spec.Destination.NameSelector,
 		To: reference.To{
-			List:    &v1alpha1.ClusterList{},
-			Managed: &v1alpha1.Cluster{},
+			List:    &v1alpha11.ClusterList{},
+			Managed: &v1alpha11.Cluster{},
 		},
 	})
 	if err != nil {
@@ -1145,5 +2875,42 @@ func (mg *ApplicationSet) ResolveReferences(ctx context.Context, c client.Reader
 	mg.Spec.ForProvider.Template.Spec.Destination.Name = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.Template.Spec.Destination.NameRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Template.Spec.Project,
+		Extract:      reference.ExternalName(),
+		Namespace:    mg.GetNamespace(),
+		Reference:    mg.Spec.ForProvider.Template.Spec.ProjectRef,
+		Selector:     mg.Spec.ForProvider.Template.Spec.ProjectSelector,
+		To: reference.To{
+			List:    &v1alpha12.ProjectList{},
+			Managed: &v1alpha12.Project{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Template.Spec.Project")
+	}
+	mg.Spec.ForProvider.Template.Spec.Project = rsp.ResolvedValue
+	mg.Spec.ForProvider.Template.Spec.ProjectRef = rsp.ResolvedReference
+
+	for i5 := 0; i5 < len(mg.Spec.ForProvider.Template.Spec.Sources); i5++ {
+		rsp, err = r.Resolve(ctx, reference.NamespacedResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.Template.Spec.Sources[i5].RepoURL,
+			Extract:      reference.ExternalName(),
+			Namespace:    mg.GetNamespace(),
+			Reference:    mg.Spec.ForProvider.Template.Spec.Sources[i5].RepoURLRef,
+			Selector:     mg.Spec.ForProvider.Template.Spec.Sources[i5].RepoURLSelector,
+			To: reference.To{
+				List:    &v1alpha1.RepositoryList{},
+				Managed: &v1alpha1.Repository{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Template.Spec.Sources[i5].RepoURL")
+		}
+		mg.Spec.ForProvider.Template.Spec.Sources[i5].RepoURL = rsp.ResolvedValue
+		mg.Spec.ForProvider.Template.Spec.Sources[i5].RepoURLRef = rsp.ResolvedReference
+
+	}
+
 	return nil
 }
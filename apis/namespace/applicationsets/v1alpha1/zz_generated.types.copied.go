@@ -25,6 +25,36 @@ type ApplicationSetParameters struct {
 
 	// AppsetNamespace is the namespace of the application set in the ArgoCD server
 	AppsetNamespace *string `json:"appsetNamespace,omitempty"`
+
+	// Preview enables a dry-run mode. The applications the application set
+	// would generate are listed in status.atProvider.preview, but the
+	// application set in ArgoCD is neither created nor updated. An
+	// application set that was created before is still deleted with the
+	// managed resource.
+	// +optional
+	Preview *bool `json:"preview,omitempty"`
+
+	// ObserveApplications aggregates the health and sync status of the
+	// applications generated by the application set into
+	// status.atProvider.generatedApplications. The status is taken from the
+	// resources in the status of the application set if ArgoCD reports them,
+	// otherwise the applications owned by the application set are listed.
+	// +optional
+	ObserveApplications *bool `json:"observeApplications,omitempty"`
+
+	// RequireHealthyApplications reports the application set as ready only
+	// if all generated applications are healthy. Implies ObserveApplications.
+	// +optional
+	RequireHealthyApplications *bool `json:"requireHealthyApplications,omitempty"`
+
+	// GeneratorSecrets are mirrored from secrets local to the provider into
+	// the namespace of the application set in ArgoCD, so SCM provider and
+	// pull request generators can reference them by name. The mirrored
+	// secrets are kept in sync and deleted together with the application
+	// set. Mirroring requires the kubernetes configuration of the
+	// ProviderConfig.
+	// +optional
+	GeneratorSecrets []GeneratorSecret `json:"generatorSecrets,omitempty"`
 }
 
 // ApplicationSetGenerator defines the generators for the ApplicationSet
@@ -75,6 +105,17 @@ type ApplicationPreservedFields struct {
 
 type ApplicationSetIgnoreDifferences []ApplicationSetResourceIgnoreDifferences
 
+// GeneratorSecret mirrors a secret local to the provider into ArgoCD
+type GeneratorSecret struct {
+	// Name of the mirrored secret in ArgoCD, as referenced by the
+	// secretName of a generator
+	Name string `json:"name"`
+
+	// SecretRef references the secret local to the provider. All its keys
+	// are mirrored.
+	SecretRef GeneratorSecretSource `json:"secretRef"`
+}
+
 // ListGenerator include items info
 type ListGenerator struct {
 	Elements     []apiextensionsv1.JSON `json:"elements" protobuf:"bytes,1,name=elements"`
@@ -87,6 +128,9 @@ type ClusterGenerator struct {
 	// Selector defines a label selector to match against all clusters registered with ArgoCD.
 	// Clusters today are stored as Kubernetes Secrets, thus the Secret labels will be used
 	// for matching the selector.
+	// The selector is evaluated by ArgoCD and not resolved by the provider, so
+	// it cannot reference Cluster managed resources. Set the labels to match
+	// in spec.forProvider.labels of the Cluster instead.
 	Selector v1.LabelSelector       `json:"selector,omitempty" protobuf:"bytes,1,name=selector"`
 	Template ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,2,name=template"`
 
@@ -223,7 +267,17 @@ type ApplicationSpec struct {
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
 	// Project is a reference to the project this application belongs to.
 	// The empty string means that application belongs to the 'default' project.
-	Project string `json:"project" protobuf:"bytes,3,name=project"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/namespace/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectSelector
+	// +optional
+	Project string `json:"project,omitempty" protobuf:"bytes,3,name=project"`
+	// ProjectRef is a reference to a Project used to set Project
+	// +optional
+	ProjectRef *commonv1.NamespacedReference `json:"projectRef,omitempty"`
+	// ProjectSelector selects reference to a Project used to set Project
+	// +optional
+	ProjectSelector *commonv1.NamespacedSelector `json:"projectSelector,omitempty"`
 	// SyncPolicy controls when and how a sync will be performed
 	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,name=syncPolicy"`
 	// IgnoreDifferences is a list of resources and their fields which should be ignored during comparison
@@ -238,7 +292,7 @@ type ApplicationSpec struct {
 	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,7,name=revisionHistoryLimit"`
 
 	// Sources is a reference to the location of the application's manifests or chart
-	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
@@ -266,6 +320,18 @@ type ApplicationSetResourceIgnoreDifferences struct {
 	JQPathExpressions []string `json:"jqPathExpressions,omitempty" protobuf:"bytes,3,name=jqExpressions"`
 }
 
+// GeneratorSecretSource references a secret local to the provider
+type GeneratorSecretSource struct {
+	// Name of the secret
+	Name string `json:"name"`
+
+	// Namespace of the secret. Required for cluster scoped ApplicationSets,
+	// namespaced ApplicationSets may only reference secrets of their own
+	// namespace.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+}
+
 // GitDirectoryGeneratorItem defines a directory to scan for resources.
 type GitDirectoryGeneratorItem struct {
 	Path    string `json:"path" protobuf:"bytes,1,name=path"`
@@ -543,7 +609,17 @@ type PluginInput struct {
 // ApplicationSource contains all required information about the source of an application
 type ApplicationSource struct {
 	// RepoURL is the URL to the repository (Git or Helm) that contains the application manifests
-	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/namespace/repositories/v1alpha1.Repository
+	// +crossplane:generate:reference:refFieldName=RepoURLRef
+	// +crossplane:generate:reference:selectorFieldName=RepoURLSelector
+	// +optional
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// RepoURLRef is a reference to a Repository used to set RepoURL
+	// +optional
+	RepoURLRef *commonv1.NamespacedReference `json:"repoURLRef,omitempty"`
+	// RepoURLSelector selects reference to a Repository used to set RepoURL
+	// +optional
+	RepoURLSelector *commonv1.NamespacedSelector `json:"repoURLSelector,omitempty"`
 	// Path is a directory path within the Git repository, and is only valid for applications sourced from Git.
 	Path *string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
 	// TargetRevision defines the revision of the source to sync the application to.
@@ -570,11 +646,14 @@ type ApplicationSource struct {
 type ApplicationDestination struct {
 	// Server specifies the URL of the target cluster and must be set to the Kubernetes control plane API
 	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/namespace/cluster/v1alpha1.Cluster
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-argocd/apis/namespace/cluster/v1alpha1.ServerAddress()
 	// +crossplane:generate:reference:refFieldName=ServerRef
 	// +crossplane:generate:reference:selectorFieldName=ServerSelector
 	// +optional
 	Server *string `json:"server,omitempty"`
-	// ServerRef is a reference to Cluster used to set Server
+	// ServerRef is a reference to Cluster used to set Server. It resolves
+	// to the server address of the Cluster, like the destination of
+	// Applications.
 	// +optional
 	ServerRef *commonv1.NamespacedReference `json:"serverRef,omitempty"`
 	// ServerSelector selects references to Cluster used to set Server
@@ -630,7 +709,9 @@ type Info struct {
 	Value string `json:"value" protobuf:"bytes,2,name=value"`
 }
 
-// ApplicationSources contains list of required information about the sources of an application
+// ApplicationSources contains list of required information about the sources of an application.
+// ApplicationSpec.Sources is declared as a plain slice, so that references
+// of the sources are resolved, but remains assignable to ApplicationSources.
 type ApplicationSources []ApplicationSource
 
 // SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
@@ -989,6 +1070,18 @@ type ArgoApplicationSetStatus struct {
 	ApplicationStatus []ApplicationSetApplicationStatus `json:"applicationStatus,omitempty" protobuf:"bytes,2,name=applicationStatus"`
 	// Resources is a list of Applications resources managed by this application set.
 	Resources []ResourceStatus `json:"resources,omitempty" protobuf:"bytes,3,opt,name=resources"`
+
+	// Preview lists the applications the application set would generate. It
+	// is only set in preview mode.
+	Preview *ApplicationSetPreview `json:"preview,omitempty"`
+
+	// GeneratedApplications summarizes the health and sync status of the
+	// generated applications. It is only set if ObserveApplications or
+	// RequireHealthyApplications is enabled.
+	GeneratedApplications *GeneratedApplicationsSummary `json:"generatedApplications,omitempty"`
+
+	// GeneratorSecrets are the names of the secrets mirrored into ArgoCD
+	GeneratorSecrets []string `json:"generatorSecrets,omitempty"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -1035,6 +1128,30 @@ type ResourceStatus struct {
 	RequiresDeletionConfirmation bool          `json:"requiresDeletionConfirmation,omitempty" protobuf:"bytes,11,opt,name=requiresDeletionConfirmation"`
 }
 
+// ApplicationSetPreview lists the applications an application set would
+// generate. Only a bounded number of applications is listed to keep the
+// status small.
+type ApplicationSetPreview struct {
+	// Applications is the number of applications that would be generated
+	Applications int64 `json:"applications"`
+	// Items lists the applications that would be generated
+	Items []PreviewApplication `json:"items,omitempty"`
+}
+
+// GeneratedApplicationsSummary summarizes the applications generated by an
+// application set. Only a bounded number of applications is listed to keep
+// the status small.
+type GeneratedApplicationsSummary struct {
+	// Applications is the number of generated applications
+	Applications int64 `json:"applications"`
+	// Health is the number of applications by health status
+	Health map[string]int64 `json:"health,omitempty"`
+	// Sync is the number of applications by sync status
+	Sync map[string]int64 `json:"sync,omitempty"`
+	// Unhealthy lists the applications that are not healthy
+	Unhealthy []GeneratedApplicationSummary `json:"unhealthy,omitempty"`
+}
+
 // ApplicationSetConditionType represents type of application condition. Type name has following convention:
 // prefix "Error" means error condition
 // prefix "Warning" means warning condition
@@ -1052,3 +1169,106 @@ type HealthStatus struct {
 	// LastTransitionTime is the time the HealthStatus was set or updated
 	LastTransitionTime *v1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
 }
+
+// PreviewApplication describes an application an application set would generate
+type PreviewApplication struct {
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Project   *string `json:"project,omitempty"`
+	// Destination is the target cluster and namespace of the application
+	Destination PreviewDestination `json:"destination"`
+	// Sources are the sources of the application
+	Sources []PreviewSource `json:"sources,omitempty"`
+}
+
+// GeneratedApplicationSummary identifies a generated application and its status
+type GeneratedApplicationSummary struct {
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	// Health is the health status of the application
+	Health *string `json:"health,omitempty"`
+	// Sync is the sync status of the application
+	Sync *string `json:"sync,omitempty"`
+	// Message describes the health status of the application
+	Message *string `json:"message,omitempty"`
+}
+
+// PreviewDestination is the destination of a previewed application
+type PreviewDestination struct {
+	Server    *string `json:"server,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// PreviewSource is a source of a previewed application
+type PreviewSource struct {
+	RepoURL        string  `json:"repoURL"`
+	Path           *string `json:"path,omitempty"`
+	Chart          *string `json:"chart,omitempty"`
+	TargetRevision *string `json:"targetRevision,omitempty"`
+}
+
+// ApplicationSetDiscoveryParameters define which application sets an
+// ApplicationSetDiscovery resource lists.
+type ApplicationSetDiscoveryParameters struct {
+	// AppsetNamespace is the namespace the application sets are listed in.
+	// Defaults to the namespace of the ArgoCD control plane.
+	// +optional
+	AppsetNamespace *string `json:"appsetNamespace,omitempty"`
+
+	// Projects restricts the discovery to application sets of these projects
+	// +optional
+	Projects []string `json:"projects,omitempty"`
+
+	// Selector is a label selector the application sets have to match
+	// +optional
+	Selector *string `json:"selector,omitempty"`
+
+	// RefreshInterval is the minimum time between two listings of the
+	// application sets. By default they are listed on every poll.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// ApplicationSetDiscoveryObservation represents the discovered application sets
+type ApplicationSetDiscoveryObservation struct {
+	// ApplicationSets is the number of application sets found in ArgoCD
+	// +optional
+	ApplicationSets int64 `json:"applicationSets,omitempty"`
+
+	// Managed is the number of application sets managed by an ApplicationSet
+	// managed resource using the same provider config. Namespaced
+	// ApplicationSets are counted by a cluster scoped discovery if their
+	// provider config points to the same ArgoCD server.
+	// +optional
+	Managed int64 `json:"managed,omitempty"`
+
+	// Unmanaged is the number of application sets not managed by an
+	// ApplicationSet managed resource
+	// +optional
+	Unmanaged int64 `json:"unmanaged,omitempty"`
+
+	// UnmanagedApplicationSets lists the application sets not managed by an
+	// ApplicationSet managed resource, sorted by namespace and name. At most
+	// 100 entries are listed.
+	// +optional
+	UnmanagedApplicationSets []DiscoveredApplicationSet `json:"unmanagedApplicationSets,omitempty"`
+
+	// LastRefreshTime is the time the application sets were last listed
+	// +optional
+	LastRefreshTime *v1.Time `json:"lastRefreshTime,omitempty"`
+}
+
+// DiscoveredApplicationSet identifies an application set found in ArgoCD
+type DiscoveredApplicationSet struct {
+	// Name of the application set
+	Name string `json:"name"`
+
+	// Namespace of the application set
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Project of the applications generated by the application set
+	// +optional
+	Project *string `json:"project,omitempty"`
+}
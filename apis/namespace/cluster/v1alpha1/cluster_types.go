@@ -48,6 +48,8 @@ type ClusterStatus struct {
 // A Cluster is a managed resource that represents an ArgoCD Git Cluster
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.connectionState.serverVersion"
+// +kubebuilder:printcolumn:name="CONNECTION",type="string",JSONPath=".status.atProvider.connectionState.connectionState.status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
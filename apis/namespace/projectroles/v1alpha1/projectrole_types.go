@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Copy types from cluster-scope apis replace references with namespace types:
+//go:generate go run -modfile ../../../../tools/go.mod -tags generate github.com/mistermx/copystruct/cmd/copystruct ../../../cluster/projectroles/v1alpha1 zz_generated.projectrole_types.copied.go ProjectRoleParameters,ProjectRoleObservation
+//go:generate sed -i s|github\.com/crossplane-contrib/provider-argocd/apis/cluster|github.com/crossplane-contrib/provider-argocd/apis/namespace|g zz_generated.projectrole_types.copied.go
+//go:generate sed -i s|v1\.Reference|v1.NamespacedReference|g zz_generated.projectrole_types.copied.go
+//go:generate sed -i s|v1\.Selector|v1.NamespacedSelector|g zz_generated.projectrole_types.copied.go
+
+// A ProjectRoleSpec defines the desired state of an ArgoCD Project Role.
+type ProjectRoleSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ProjectRoleParameters `json:"forProvider"`
+}
+
+// A ProjectRoleStatus represents the observed state of an ArgoCD Project Role.
+type ProjectRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectRole is a managed resource that represents a single role of an ArgoCD Project.
+// The external name is used as the role name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.project"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type ProjectRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectRoleSpec   `json:"spec"`
+	Status ProjectRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectRoleList contains a list of ProjectRole items
+type ProjectRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectRole `json:"items"`
+}
+
+// ProjectRole type metadata
+var (
+	ProjectRoleKind             = reflect.TypeOf(ProjectRole{}).Name()
+	ProjectRoleGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectRoleKind}.String()
+	ProjectRoleKindAPIVersion   = ProjectRoleKind + "." + SchemeGroupVersion.String()
+	ProjectRoleGroupVersionKind = SchemeGroupVersion.WithKind(ProjectRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProjectRole{}, &ProjectRoleList{})
+}
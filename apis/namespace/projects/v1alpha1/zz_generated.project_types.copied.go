@@ -27,6 +27,12 @@ type ProjectParameters struct {
 	// SourceNamespaces contains list of namespaces which are authorized in the project
 	// +optional
 	SourceNamespaces []string `json:"sourceNamespaces,omitempty"`
+	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination
+	// +optional
+	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty"`
+	// PermitOnlyProjectScopedClusters determines whether destinations can only reference clusters which are project-scoped
+	// +optional
+	PermitOnlyProjectScopedClusters *bool `json:"permitOnlyProjectScopedClusters,omitempty"`
 	// Description contains optional project description
 	// +optional
 	Description *string `json:"description,omitempty"`
@@ -57,6 +63,17 @@ type ProjectParameters struct {
 	// ProjectLabels labels that will be applied to the AppProject
 	// +optional
 	ProjectLabels map[string]string `json:"projectLabels,omitempty"`
+	// IgnoreUnmanagedRoles makes the controller ignore roles that exist on the
+	// AppProject but are not listed in Roles, e.g. roles managed by ProjectRole
+	// resources. Such roles are neither late-initialized nor removed on update.
+	// +optional
+	IgnoreUnmanagedRoles *bool `json:"ignoreUnmanagedRoles,omitempty"`
+	// IgnoreUnmanagedSyncWindows makes the controller ignore sync windows that
+	// exist on the AppProject but do not match one of SyncWindows, e.g. windows
+	// managed by SyncWindow resources. Such windows are neither late-initialized
+	// nor removed on update.
+	// +optional
+	IgnoreUnmanagedSyncWindows *bool `json:"ignoreUnmanagedSyncWindows,omitempty"`
 }
 
 // ApplicationDestination holds information about the application's destination
@@ -82,6 +99,17 @@ type ApplicationDestination struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// ApplicationDestinationServiceAccount holds information about the service account to be impersonated for the application sync operation
+type ApplicationDestinationServiceAccount struct {
+	// Server specifies the URL of the target cluster's Kubernetes control plane API
+	Server string `json:"server"`
+	// Namespace specifies the target namespace for the application's resources
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// DefaultServiceAccount to be used for impersonation during the sync operation
+	DefaultServiceAccount string `json:"defaultServiceAccount"`
+}
+
 // ProjectRole represents a role that has access to a project
 type ProjectRole struct {
 	// Name is a name for this role
@@ -92,9 +120,6 @@ type ProjectRole struct {
 	// Policies Stores a list of casbin formated strings that define access policies for the role in the project
 	// +optional
 	Policies []string `json:"policies,omitempty"`
-	// JWTTokens are a list of generated JWT tokens bound to this role
-	// +optional
-	JWTTokens []JWTToken `json:"jwtTokens,omitempty"`
 	// Groups are a list of OIDC group claims bound to this role
 	// +optional
 	Groups []string `json:"groups,omitempty"`
@@ -116,16 +141,19 @@ type SyncWindows []SyncWindow
 // SignatureKey is the specification of a key required to verify commit signatures with
 type SignatureKey struct {
 	// The ID of the key in hexadecimal notation
-	KeyID string `json:"keyID"`
-}
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-argocd/apis/namespace/repositories/v1alpha1.GPGKey
+	// +crossplane:generate:reference:refFieldName=KeyIDRef
+	// +crossplane:generate:reference:selectorFieldName=KeyIDSelector
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
 
-// JWTToken holds the issuedAt and expiresAt values of a token
-type JWTToken struct {
-	IssuedAt int64 `json:"iat"`
+	// KeyIDRef is a reference to a GPGKey used to set KeyID
 	// +optional
-	ExpiresAt *int64 `json:"exp,omitempty"`
+	KeyIDRef *v1.NamespacedReference `json:"keyIDRef,omitempty"`
+
+	// KeyIDSelector selects a GPGKey used to set KeyID
 	// +optional
-	ID *string `json:"id,omitempty"`
+	KeyIDSelector *v1.NamespacedSelector `json:"keyIDSelector,omitempty"`
 }
 
 // OrphanedResourceKey is a reference to a resource to be ignored from
@@ -165,7 +193,8 @@ type SyncWindow struct {
 
 // ProjectObservation represents an argocd Project.
 type ProjectObservation struct {
-	// JWTTokensByRole contains a list of JWT tokens issued for a given role
+	// JWTTokensByRole contains a list of JWT tokens issued for a given role.
+	// Tokens are owned by ArgoCD and preserved when the project is updated.
 	// +optional
 	JWTTokensByRole map[string]JWTTokens `json:"jwtTokensByRole,omitempty"`
 }
@@ -175,3 +204,12 @@ type JWTTokens struct {
 	// +optional
 	Items []JWTToken `json:"items,omitempty"`
 }
+
+// JWTToken holds the issuedAt and expiresAt values of a token
+type JWTToken struct {
+	IssuedAt int64 `json:"iat"`
+	// +optional
+	ExpiresAt *int64 `json:"exp,omitempty"`
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GPGKeyParameters and GPGKeyObservation are copied together with the
+// Repository types into zz_generated.repository_types.copied.go as they share
+// the SecretReference type.
+
+// A GPGKeySpec defines the desired state of an ArgoCD GnuPG public key.
+type GPGKeySpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              GPGKeyParameters `json:"forProvider"`
+}
+
+// A GPGKeyStatus represents the observed state of an ArgoCD GnuPG public key.
+type GPGKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GPGKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GPGKey is a managed resource that represents a GnuPG public key used by ArgoCD to verify commit signatures.
+// The external name is the key ID. A key that already exists in ArgoCD is only managed
+// if its key ID is set as external name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KEY-ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="OWNER",type="string",JSONPath=".status.atProvider.owner"
+// +kubebuilder:printcolumn:name="TRUST",type="string",JSONPath=".status.atProvider.trust"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type GPGKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GPGKeySpec   `json:"spec"`
+	Status GPGKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GPGKeyList contains a list of GPGKey items
+type GPGKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GPGKey `json:"items"`
+}
+
+// GPGKey type metadata
+var (
+	GPGKeyKind             = reflect.TypeOf(GPGKey{}).Name()
+	GPGKeyGroupKind        = schema.GroupKind{Group: Group, Kind: GPGKeyKind}.String()
+	GPGKeyKindAPIVersion   = GPGKeyKind + "." + SchemeGroupVersion.String()
+	GPGKeyGroupVersionKind = SchemeGroupVersion.WithKind(GPGKeyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GPGKey{}, &GPGKeyList{})
+}
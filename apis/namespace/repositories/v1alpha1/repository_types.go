@@ -26,7 +26,7 @@ import (
 )
 
 // Copy types from cluster-scope apis replace references with namespace types:
-//go:generate go run -modfile ../../../../tools/go.mod -tags generate github.com/mistermx/copystruct/cmd/copystruct ../../../cluster/repositories/v1alpha1 zz_generated.repository_types.copied.go RepositoryParameters,RepositoryObservation
+//go:generate go run -modfile ../../../../tools/go.mod -tags generate github.com/mistermx/copystruct/cmd/copystruct ../../../cluster/repositories/v1alpha1 zz_generated.repository_types.copied.go RepositoryParameters,RepositoryObservation,GPGKeyParameters,GPGKeyObservation,RepositoryCertificateParameters,RepositoryCertificateObservation,RepositoryCredentialsParameters,RepositoryCredentialsObservation,RepositoryRefsParameters,RepositoryRefsObservation
 //go:generate sed -i s|github\.com/crossplane-contrib/provider-argocd/apis/cluster|github.com/crossplane-contrib/provider-argocd/apis/namespace|g zz_generated.repository_types.copied.go
 //go:generate sed -i s|v1\.Reference|v1.NamespacedReference|g zz_generated.repository_types.copied.go
 //go:generate sed -i s|v1\.Selector|v1.NamespacedSelector|g zz_generated.repository_types.copied.go
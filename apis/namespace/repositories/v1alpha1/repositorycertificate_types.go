@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RepositoryCertificateParameters and RepositoryCertificateObservation are
+// copied together with the Repository types into
+// zz_generated.repository_types.copied.go as they share the SecretReference type.
+
+// A RepositoryCertificateSpec defines the desired state of an ArgoCD repository certificate.
+type RepositoryCertificateSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              RepositoryCertificateParameters `json:"forProvider"`
+}
+
+// A RepositoryCertificateStatus represents the observed state of an ArgoCD repository certificate.
+type RepositoryCertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryCertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryCertificate is a managed resource that represents a TLS certificate or an SSH
+// known host entry ArgoCD uses to verify repository servers.
+// The external name is the server name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVER",type="string",JSONPath=".spec.forProvider.serverName"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.certType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type RepositoryCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryCertificateSpec   `json:"spec"`
+	Status RepositoryCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryCertificateList contains a list of RepositoryCertificate items
+type RepositoryCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryCertificate `json:"items"`
+}
+
+// RepositoryCertificate type metadata
+var (
+	RepositoryCertificateKind             = reflect.TypeOf(RepositoryCertificate{}).Name()
+	RepositoryCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryCertificateKind}.String()
+	RepositoryCertificateKindAPIVersion   = RepositoryCertificateKind + "." + SchemeGroupVersion.String()
+	RepositoryCertificateGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryCertificateKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RepositoryCertificate{}, &RepositoryCertificateList{})
+}
@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RepositoryRefsParameters and RepositoryRefsObservation are copied together
+// with the Repository types into zz_generated.repository_types.copied.go.
+
+// A RepositoryRefsSpec defines the repository observed by a RepositoryRefs resource.
+type RepositoryRefsSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              RepositoryRefsParameters `json:"forProvider"`
+}
+
+// A RepositoryRefsStatus represents the observed refs of a repository.
+type RepositoryRefsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryRefsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryRefs is an observe-only managed resource that lists the branches
+// and tags, or the helm charts, of an ArgoCD repository. It never creates,
+// updates or deletes anything in ArgoCD.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REPO",type="string",JSONPath=".spec.forProvider.repo"
+// +kubebuilder:printcolumn:name="SOURCE-TYPE",type="string",JSONPath=".status.atProvider.sourceType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type RepositoryRefs struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryRefsSpec   `json:"spec"`
+	Status RepositoryRefsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryRefsList contains a list of RepositoryRefs items
+type RepositoryRefsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryRefs `json:"items"`
+}
+
+// RepositoryRefs type metadata
+var (
+	RepositoryRefsKind             = reflect.TypeOf(RepositoryRefs{}).Name()
+	RepositoryRefsGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryRefsKind}.String()
+	RepositoryRefsKindAPIVersion   = RepositoryRefsKind + "." + SchemeGroupVersion.String()
+	RepositoryRefsGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryRefsKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RepositoryRefs{}, &RepositoryRefsList{})
+}
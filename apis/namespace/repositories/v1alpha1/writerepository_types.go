@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A WriteRepositorySpec defines the desired state of an ArgoCD write repository.
+type WriteRepositorySpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              RepositoryParameters `json:"forProvider"`
+}
+
+// A WriteRepositoryStatus represents the observed state of an ArgoCD write repository.
+type WriteRepositoryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WriteRepository is a managed resource that represents an ArgoCD Git repository
+// with write access, used by the source hydrator to push hydrated manifests.
+// The external name is the repository URL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type WriteRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WriteRepositorySpec   `json:"spec"`
+	Status WriteRepositoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WriteRepositoryList contains a list of WriteRepository items
+type WriteRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WriteRepository `json:"items"`
+}
+
+// WriteRepository type metadata
+var (
+	WriteRepositoryKind             = reflect.TypeOf(WriteRepository{}).Name()
+	WriteRepositoryGroupKind        = schema.GroupKind{Group: Group, Kind: WriteRepositoryKind}.String()
+	WriteRepositoryKindAPIVersion   = WriteRepositoryKind + "." + SchemeGroupVersion.String()
+	WriteRepositoryGroupVersionKind = SchemeGroupVersion.WithKind(WriteRepositoryKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&WriteRepository{}, &WriteRepositoryList{})
+}
@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RepositoryCredentialsParameters and RepositoryCredentialsObservation are
+// copied together with the Repository types into
+// zz_generated.repository_types.copied.go as they share the SecretReference type.
+
+// A WriteRepositoryCredentialsSpec defines the desired state of an ArgoCD write repository credential set.
+type WriteRepositoryCredentialsSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              RepositoryCredentialsParameters `json:"forProvider"`
+}
+
+// A WriteRepositoryCredentialsStatus represents the observed state of an ArgoCD write repository credential set.
+type WriteRepositoryCredentialsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryCredentialsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WriteRepositoryCredentials is a managed resource that represents an ArgoCD credential
+// template with write access for all repositories below a URL prefix.
+// The external name is the URL.
+// ArgoCD stores write credentials in the same secret as read credentials of
+// the same URL, so they must not be managed by other means, and observing
+// them requires the get permission on repositories.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type WriteRepositoryCredentials struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WriteRepositoryCredentialsSpec   `json:"spec"`
+	Status WriteRepositoryCredentialsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WriteRepositoryCredentialsList contains a list of WriteRepositoryCredentials items
+type WriteRepositoryCredentialsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WriteRepositoryCredentials `json:"items"`
+}
+
+// WriteRepositoryCredentials type metadata
+var (
+	WriteRepositoryCredentialsKind             = reflect.TypeOf(WriteRepositoryCredentials{}).Name()
+	WriteRepositoryCredentialsGroupKind        = schema.GroupKind{Group: Group, Kind: WriteRepositoryCredentialsKind}.String()
+	WriteRepositoryCredentialsKindAPIVersion   = WriteRepositoryCredentialsKind + "." + SchemeGroupVersion.String()
+	WriteRepositoryCredentialsGroupVersionKind = SchemeGroupVersion.WithKind(WriteRepositoryCredentialsKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&WriteRepositoryCredentials{}, &WriteRepositoryCredentialsList{})
+}
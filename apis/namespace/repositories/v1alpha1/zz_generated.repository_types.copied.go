@@ -3,7 +3,8 @@
 package v1alpha1
 
 import (
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // RepositoryParameters define the desired state of an ArgoCD Git Repository
@@ -62,6 +63,38 @@ type RepositoryParameters struct {
 	// Github App Enterprise base url if empty will default to https://api.github.com
 	// +optional
 	GitHubAppEnterpriseBaseURL *string `json:"githubAppEnterpriseBaseUrl,omitempty"`
+	// Proxy is the HTTP/HTTPS proxy used to access the repo
+	// +optional
+	Proxy *string `json:"proxy,omitempty"`
+	// NoProxy is a comma separated list of hosts that are not accessed through the proxy
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+	// ForceHTTPBasicAuth enforces HTTP basic auth instead of negotiating the authentication scheme
+	// +optional
+	ForceHTTPBasicAuth *bool `json:"forceHttpBasicAuth,omitempty"`
+	// GCPServiceAccountKey for authenticating at Google Cloud Source repositories
+	// +optional
+	GCPServiceAccountKeyRef *SecretReference `json:"gcpServiceAccountKeyRef,omitempty"`
+	// BearerToken for authenticating at the repo server, only for Bitbucket Data Center
+	// +optional
+	BearerTokenRef *SecretReference `json:"bearerTokenRef,omitempty"`
+	// UseAzureWorkloadIdentity specifies whether Azure Workload Identity is used to authenticate at the repo server
+	// +optional
+	UseAzureWorkloadIdentity *bool `json:"useAzureWorkloadIdentity,omitempty"`
+	// Certificates contains the server names of RepositoryCertificates the
+	// repo server is verified with. They are not sent to ArgoCD but make sure
+	// the certificates exist before the repository is created.
+	// +crossplane:generate:reference:type=RepositoryCertificate
+	// +crossplane:generate:reference:refFieldName=CertificatesRefs
+	// +crossplane:generate:reference:selectorFieldName=CertificatesSelector
+	// +optional
+	Certificates []string `json:"certificates,omitempty"`
+	// CertificatesRefs is a reference to an array of RepositoryCertificate used to set Certificates
+	// +optional
+	CertificatesRefs []v1.NamespacedReference `json:"certificatesRefs,omitempty"`
+	// CertificatesSelector selects references to RepositoryCertificates used to set Certificates
+	// +optional
+	CertificatesSelector *v1.NamespacedSelector `json:"certificatesSelector,omitempty"`
 }
 
 // SecretReference holds the reference to a Kubernetes secret
@@ -100,13 +133,21 @@ type RepositoryObservation struct {
 	// GithubAppPrivateKey tracks changes to a GithubAppPrivateKey secret
 	// +optional
 	GithubAppPrivateKey *PasswordObservation `json:"githubAppPrivateKey,omitempty"`
+
+	// GCPServiceAccountKey tracks changes to a GCPServiceAccountKey secret
+	// +optional
+	GCPServiceAccountKey *PasswordObservation `json:"gcpServiceAccountKey,omitempty"`
+
+	// BearerToken tracks changes to a BearerToken secret
+	// +optional
+	BearerToken *PasswordObservation `json:"bearerToken,omitempty"`
 }
 
 // ConnectionState is the observed state of the argocd repository
 type ConnectionState struct {
-	Status     string   `json:"status,omitempty"`
-	Message    string   `json:"message,omitempty"`
-	ModifiedAt *v1.Time `json:"attemptedAt,omitempty"`
+	Status     string       `json:"status,omitempty"`
+	Message    string       `json:"message,omitempty"`
+	ModifiedAt *metav1.Time `json:"attemptedAt,omitempty"`
 }
 
 // PasswordObservation holds the status of a referenced password
@@ -119,3 +160,288 @@ type SecretObservation struct {
 	// ResourceVersion tracks the meta1.ResourceVersion of an Object
 	ResourceVersion string `json:"resourceVersion,omitempty"`
 }
+
+// GPGKeyParameters define the desired state of an ArgoCD GnuPG public key.
+// Exactly one of PublicKeyData and PublicKeyDataRef must be set. The key is
+// immutable, create a new GPGKey to rotate it.
+type GPGKeyParameters struct {
+	// PublicKeyData is the ASCII-armored public key
+	// +optional
+	PublicKeyData *string `json:"publicKeyData,omitempty"`
+
+	// PublicKeyDataRef references a secret key holding the ASCII-armored public key
+	// +optional
+	PublicKeyDataRef *SecretReference `json:"publicKeyDataRef,omitempty"`
+}
+
+// GPGKeyObservation represents the observed state of an ArgoCD GnuPG public key
+type GPGKeyObservation struct {
+	// KeyID specifies the key ID, in hexadecimal string format
+	// +optional
+	KeyID *string `json:"keyID,omitempty"`
+
+	// Fingerprint is the fingerprint of the key
+	// +optional
+	Fingerprint *string `json:"fingerprint,omitempty"`
+
+	// Owner holds the owner identification, e.g. a name and e-mail address
+	// +optional
+	Owner *string `json:"owner,omitempty"`
+
+	// Trust holds the level of trust assigned to this key
+	// +optional
+	Trust *string `json:"trust,omitempty"`
+
+	// SubType holds the key's sub type (e.g. rsa4096)
+	// +optional
+	SubType *string `json:"subType,omitempty"`
+}
+
+// RepositoryCertificateParameters define the desired state of an ArgoCD repository certificate.
+// Exactly one of CertData and CertDataRef must be set.
+type RepositoryCertificateParameters struct {
+	// ServerName is the DNS name of the repository server the certificate is intended for.
+	// It must not contain the glob characters *, ?, [, ] or \.
+	// +immutable
+	ServerName string `json:"serverName"`
+
+	// CertType is the type of the certificate, "https" for TLS certificates
+	// or "ssh" for SSH known host entries
+	// +immutable
+	// +kubebuilder:validation:Enum=https;ssh
+	CertType string `json:"certType"`
+
+	// CertSubType is the type of the SSH host key, e.g. "ssh-ed25519".
+	// Required for SSH known host entries, ignored for TLS certificates.
+	// +immutable
+	// +optional
+	CertSubType *string `json:"certSubType,omitempty"`
+
+	// CertData contains the PEM encoded TLS certificate(s) or the base64
+	// encoded SSH public host key
+	// +optional
+	CertData *string `json:"certData,omitempty"`
+
+	// CertDataRef references a secret key holding the certificate data
+	// +optional
+	CertDataRef *SecretReference `json:"certDataRef,omitempty"`
+}
+
+// RepositoryCertificateObservation represents the observed state of an ArgoCD repository certificate
+type RepositoryCertificateObservation struct {
+	// Certificates lists the certificates ArgoCD holds for the server name and type
+	// +optional
+	Certificates []CertificateInfo `json:"certificates,omitempty"`
+}
+
+// CertificateInfo holds the information ArgoCD reports for a single certificate
+type CertificateInfo struct {
+	// CertSubType is the SSH key type or the public key algorithm of the TLS certificate
+	// +optional
+	CertSubType string `json:"certSubType,omitempty"`
+
+	// CertInfo holds the SHA256 fingerprint of SSH host keys or the subject of TLS certificates
+	// +optional
+	CertInfo string `json:"certInfo,omitempty"`
+}
+
+// RepositoryCredentialsParameters define the desired state of an ArgoCD repository credential set
+type RepositoryCredentialsParameters struct {
+	// URL is the URL prefix of the repositories the credentials are used for
+	// +immutable
+	URL string `json:"url"`
+	// Username for authenticating at the repo server
+	// +optional
+	Username *string `json:"username,omitempty"`
+	// Password for authenticating at the repo server
+	// +optional
+	PasswordRef *SecretReference `json:"passwordRef,omitempty"`
+	// SSH private key data for authenticating at the repo server
+	// +optional
+	SSHPrivateKeyRef *SecretReference `json:"sshPrivateKeyRef,omitempty"`
+	// TLS client cert data for authenticating at the repo server
+	// +optional
+	TLSClientCertDataRef *SecretReference `json:"tlsClientCertDataRef,omitempty"`
+	// TLS client cert key for authenticating at the repo server
+	// +optional
+	TLSClientCertKeyRef *SecretReference `json:"tlsClientCertKeyRef,omitempty"`
+	// Github App Private Key PEM data
+	// +optional
+	GithubAppPrivateKeyRef *SecretReference `json:"githubAppPrivateKeyRef,omitempty"`
+	// Github App ID of the app used to access the repo
+	// +optional
+	GithubAppID *int64 `json:"githubAppID,omitempty"`
+	// Github App Installation ID of the installed GitHub App
+	// +optional
+	GithubAppInstallationID *int64 `json:"githubAppInstallationID,omitempty"`
+	// Github App Enterprise base url if empty will default to https://api.github.com
+	// +optional
+	GitHubAppEnterpriseBaseURL *string `json:"githubAppEnterpriseBaseUrl,omitempty"`
+	// Whether helm-oci support should be enabled for the repos
+	// +optional
+	EnableOCI *bool `json:"enableOCI,omitempty"`
+	// type of the repos, maybe "git or "helm, "git" is assumed if empty or absent
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// GCPServiceAccountKey for authenticating at Google Cloud Source repositories
+	// +optional
+	GCPServiceAccountKeyRef *SecretReference `json:"gcpServiceAccountKeyRef,omitempty"`
+	// Proxy is the HTTP/HTTPS proxy used to access the repos
+	// +optional
+	Proxy *string `json:"proxy,omitempty"`
+	// NoProxy is a comma separated list of hosts that are not accessed through the proxy
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+	// ForceHTTPBasicAuth enforces HTTP basic auth instead of negotiating the authentication scheme
+	// +optional
+	ForceHTTPBasicAuth *bool `json:"forceHttpBasicAuth,omitempty"`
+	// UseAzureWorkloadIdentity specifies whether Azure Workload Identity is used to authenticate at the repo server
+	// +optional
+	UseAzureWorkloadIdentity *bool `json:"useAzureWorkloadIdentity,omitempty"`
+	// BearerToken for authenticating at the repo server, only for Bitbucket Data Center
+	// +optional
+	BearerTokenRef *SecretReference `json:"bearerTokenRef,omitempty"`
+}
+
+// RepositoryCredentialsObservation represents an argocd repository credential set.
+type RepositoryCredentialsObservation struct {
+	// Username reported by ArgoCD
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// Password tracks changes to a Password secret
+	// +optional
+	Password *PasswordObservation `json:"password,omitempty"`
+
+	// SSHPrivateKey tracks changes to a SSHPrivateKey secret
+	// +optional
+	SSHPrivateKey *PasswordObservation `json:"sshPrivateKey,omitempty"`
+
+	// TLSClientCertData tracks changes to a TLSClientCertData secret
+	// +optional
+	TLSClientCertData *PasswordObservation `json:"tlsClientCertData,omitempty"`
+
+	// TLSClientCertKey tracks changes to a TLSClientCertKey secret
+	// +optional
+	TLSClientCertKey *PasswordObservation `json:"tlsClientCertKey,omitempty"`
+
+	// GithubAppPrivateKey tracks changes to a GithubAppPrivateKey secret
+	// +optional
+	GithubAppPrivateKey *PasswordObservation `json:"githubAppPrivateKey,omitempty"`
+
+	// GCPServiceAccountKey tracks changes to a GCPServiceAccountKey secret
+	// +optional
+	GCPServiceAccountKey *PasswordObservation `json:"gcpServiceAccountKey,omitempty"`
+
+	// BearerToken tracks changes to a BearerToken secret
+	// +optional
+	BearerToken *PasswordObservation `json:"bearerToken,omitempty"`
+}
+
+// RepositoryRefsParameters define the repository and path a RepositoryRefs
+// resource observes.
+type RepositoryRefsParameters struct {
+	// Repo is the URL of the repository to observe
+	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:refFieldName=RepoRef
+	// +crossplane:generate:reference:selectorFieldName=RepoSelector
+	// +optional
+	Repo *string `json:"repo,omitempty"`
+
+	// RepoRef is a reference to a Repository used to set Repo
+	// +optional
+	RepoRef *v1.NamespacedReference `json:"repoRef,omitempty"`
+
+	// RepoSelector selects reference to a Repository used to set Repo
+	// +optional
+	RepoSelector *v1.NamespacedSelector `json:"repoSelector,omitempty"`
+
+	// Type of the repository. Branches and tags are listed for git
+	// repositories, charts and their versions for helm repositories.
+	// +kubebuilder:validation:Enum=git;helm
+	// +kubebuilder:default=git
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Project is the ArgoCD project used to access the repository
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// Path within a git repository, or the chart name of a helm repository,
+	// to detect the application source type for
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// TargetRevision used to detect the application source type. Defaults to
+	// HEAD for git repositories and the latest version for helm charts.
+	// +optional
+	TargetRevision *string `json:"targetRevision,omitempty"`
+
+	// RefreshInterval is the minimum time between two queries of the
+	// repository. By default the repository is queried on every poll. Changes
+	// of the other parameters always trigger a query.
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// RepositoryRefsObservation represents the observed refs of a repository
+type RepositoryRefsObservation struct {
+	// Branches of a git repository
+	// +optional
+	Branches []string `json:"branches,omitempty"`
+
+	// Tags of a git repository
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// HelmCharts of a helm repository
+	// +optional
+	HelmCharts []HelmChartVersions `json:"helmCharts,omitempty"`
+
+	// SourceType is the application source type detected for Path, e.g. Helm,
+	// Kustomize, Directory or Plugin
+	// +optional
+	SourceType *string `json:"sourceType,omitempty"`
+
+	// LastRefreshTime is the time the repository was last queried
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+
+	// ObservedQuery holds the parameters the repository was last queried
+	// with. The repository is queried again as soon as they change.
+	// +optional
+	ObservedQuery *RepositoryRefsQuery `json:"observedQuery,omitempty"`
+}
+
+// RepositoryRefsQuery holds the parameters a repository was queried with
+type RepositoryRefsQuery struct {
+	// Repo is the URL of the queried repository
+	// +optional
+	Repo *string `json:"repo,omitempty"`
+
+	// Type of the queried repository
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Project used to access the repository
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// Path the application source type was detected for
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// TargetRevision the application source type was detected for
+	// +optional
+	TargetRevision *string `json:"targetRevision,omitempty"`
+}
+
+// HelmChartVersions holds the versions of a helm chart
+type HelmChartVersions struct {
+	// Name of the chart
+	Name string `json:"name"`
+
+	// Versions of the chart
+	// +optional
+	Versions []string `json:"versions,omitempty"`
+}
@@ -0,0 +1,72 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Copy types from cluster-scope apis replace references with namespace types:
+//go:generate go run -modfile ../../../../tools/go.mod -tags generate github.com/mistermx/copystruct/cmd/copystruct ../../../cluster/syncwindows/v1alpha1 zz_generated.syncwindow_types.copied.go SyncWindowParameters,SyncWindowObservation
+//go:generate sed -i s|github\.com/crossplane-contrib/provider-argocd/apis/cluster|github.com/crossplane-contrib/provider-argocd/apis/namespace|g zz_generated.syncwindow_types.copied.go
+//go:generate sed -i s|v1\.Reference|v1.NamespacedReference|g zz_generated.syncwindow_types.copied.go
+//go:generate sed -i s|v1\.Selector|v1.NamespacedSelector|g zz_generated.syncwindow_types.copied.go
+
+// A SyncWindowSpec defines the desired state of an ArgoCD Project Sync Window.
+type SyncWindowSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              SyncWindowParameters `json:"forProvider"`
+}
+
+// A SyncWindowStatus represents the observed state of an ArgoCD Project Sync Window.
+type SyncWindowStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SyncWindowObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SyncWindow is a managed resource that represents a single sync window of an ArgoCD Project.
+// Sync windows have no name in ArgoCD, a window is identified by its parameters.
+// Only the oldest of several SyncWindows with identical parameters manages the window,
+// the others report an error.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.project"
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.forProvider.kind"
+// +kubebuilder:printcolumn:name="ACTIVE",type="boolean",JSONPath=".status.atProvider.active"
+// +kubebuilder:printcolumn:name="NEXT-OPEN",type="date",JSONPath=".status.atProvider.nextOpen"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,argocd}
+type SyncWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SyncWindowSpec   `json:"spec"`
+	Status SyncWindowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SyncWindowList contains a list of SyncWindow items
+type SyncWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SyncWindow `json:"items"`
+}
+
+// SyncWindow type metadata
+var (
+	SyncWindowKind             = reflect.TypeOf(SyncWindow{}).Name()
+	SyncWindowGroupKind        = schema.GroupKind{Group: Group, Kind: SyncWindowKind}.String()
+	SyncWindowKindAPIVersion   = SyncWindowKind + "." + SchemeGroupVersion.String()
+	SyncWindowGroupVersionKind = SchemeGroupVersion.WithKind(SyncWindowKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SyncWindow{}, &SyncWindowList{})
+}
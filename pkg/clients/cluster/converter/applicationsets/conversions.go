@@ -32,10 +32,29 @@ type Converter interface {
 
 	ToArgoDestination(in v1alpha1.ApplicationDestination) argocdv1alpha1.ApplicationDestination
 
+	// goverter:ignore RepoURLRef
+	// goverter:ignore RepoURLSelector
+	FromArgoApplicationSource(in argocdv1alpha1.ApplicationSource) v1alpha1.ApplicationSource
+
+	ToArgoApplicationSource(in v1alpha1.ApplicationSource) argocdv1alpha1.ApplicationSource
+
+	// goverter:ignore ProjectRef
+	// goverter:ignore ProjectSelector
+	FromArgoApplicationSpec(in argocdv1alpha1.ApplicationSpec) v1alpha1.ApplicationSpec
+
+	ToArgoApplicationSpec(in v1alpha1.ApplicationSpec) argocdv1alpha1.ApplicationSpec
+
 	ToArgoApplicationSetSpec(in *v1alpha1.ApplicationSetParameters) *argocdv1alpha1.ApplicationSetSpec
 	// goverter:ignore AppsetNamespace
+	// goverter:ignore Preview
+	// goverter:ignore ObserveApplications
+	// goverter:ignore RequireHealthyApplications
+	// goverter:ignore GeneratorSecrets
 	FromArgoApplicationSetSpec(in *argocdv1alpha1.ApplicationSetSpec) *v1alpha1.ApplicationSetParameters
 
+	// goverter:ignore Preview
+	// goverter:ignore GeneratedApplications
+	// goverter:ignore GeneratorSecrets
 	FromArgoApplicationSetStatus(in *argocdv1alpha1.ApplicationSetStatus) *v1alpha1.ArgoApplicationSetStatus
 	ToArgoApplicationSetStatus(in *v1alpha1.ArgoApplicationSetStatus) *argocdv1alpha1.ApplicationSetStatus
 }
@@ -0,0 +1,65 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/v1alpha1"
+)
+
+const (
+	errNoKubernetesConfig  = "kubernetes configuration of the ProviderConfig is not set"
+	errGetKubeconfigSecret = "cannot get kubeconfig secret"
+	errEmptyKubeconfig     = "kubeconfig secret key is empty"
+	errParseKubeconfig     = "cannot parse kubeconfig"
+	errCreateKubeClient    = "cannot create kubernetes client"
+
+	defaultArgoCDNamespace = "argocd"
+)
+
+// GetArgoCDKubeClient returns a client of the Kubernetes cluster ArgoCD is
+// running in and the namespace of ArgoCD, as configured by the
+// ProviderConfig of the managed resource.
+func GetArgoCDKubeClient(ctx context.Context, c client.Client, mg resource.LegacyManaged) (client.Client, string, error) {
+	if mg.GetProviderConfigReference() == nil {
+		return nil, "", errors.New("providerConfigRef is not given")
+	}
+	pc := &v1alpha1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, "", errors.Wrap(err, "cannot get referenced Provider")
+	}
+	return NewArgoCDKubeClient(ctx, c, &pc.Spec)
+}
+
+// NewArgoCDKubeClient creates a client of the Kubernetes cluster ArgoCD is
+// running in from the kubeconfig referenced by the ProviderConfig.
+func NewArgoCDKubeClient(ctx context.Context, c client.Client, pcSpec *v1alpha1.ProviderConfigSpec) (client.Client, string, error) {
+	k := pcSpec.Kubernetes
+	if k == nil {
+		return nil, "", errors.New(errNoKubernetesConfig)
+	}
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: k.KubeconfigSecretRef.Namespace, Name: k.KubeconfigSecretRef.Name}, s); err != nil {
+		return nil, "", errors.Wrap(err, errGetKubeconfigSecret)
+	}
+	kubeconfig := s.Data[k.KubeconfigSecretRef.Key]
+	if len(kubeconfig) == 0 {
+		return nil, "", errors.New(errEmptyKubeconfig)
+	}
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errParseKubeconfig)
+	}
+	kube, err := client.New(cfg, client.Options{})
+	if err != nil {
+		return nil, "", errors.Wrap(err, errCreateKubeClient)
+	}
+	return kube, ptr.Deref(k.Namespace, defaultArgoCDNamespace), nil
+}
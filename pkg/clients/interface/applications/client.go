@@ -7,12 +7,14 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	reposerver "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"google.golang.org/grpc"
 )
 
 const (
-	errorNotFound = "code = NotFound desc = repo"
+	errorNotFound            = "code = NotFound desc = repo"
+	errorNoOperationToCancel = "No operation is in progress"
 )
 
 // ServiceClient wraps the functions to connect to argocd repositories
@@ -31,6 +33,27 @@ type ServiceClient interface {
 
 	// Delete deletes an application
 	Delete(ctx context.Context, in *application.ApplicationDeleteRequest, opts ...grpc.CallOption) (*application.ApplicationResponse, error)
+
+	// ResourceTree returns the live resource tree of an application
+	ResourceTree(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
+
+	// ManagedResources returns the resources managed by an application and their diffs
+	ManagedResources(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption) (*application.ManagedResourcesResponse, error)
+
+	// GetManifests returns the rendered manifests of an application
+	GetManifests(ctx context.Context, in *application.ApplicationManifestQuery, opts ...grpc.CallOption) (*reposerver.ManifestResponse, error)
+
+	// Sync syncs an application to its target state
+	Sync(ctx context.Context, in *application.ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+
+	// Rollback syncs an application to a previous deployment of its history
+	Rollback(ctx context.Context, in *application.ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+
+	// RunResourceAction runs a resource action on a resource of an application
+	RunResourceAction(ctx context.Context, in *application.ResourceActionRunRequest, opts ...grpc.CallOption) (*application.ApplicationResponse, error)
+
+	// TerminateOperation terminates the currently running operation of an application
+	TerminateOperation(ctx context.Context, in *application.OperationTerminateRequest, opts ...grpc.CallOption) (*application.OperationTerminateResponse, error)
 }
 
 // NewApplicationServiceClient creates a new API client from a set of config
@@ -56,3 +79,11 @@ func IsErrorApplicationNotFound(err error) bool {
 	}
 	return strings.Contains(err.Error(), errorNotFound)
 }
+
+// IsErrorNoOperationInProgress helper function to test for errorNoOperationToCancel error.
+func IsErrorNoOperationInProgress(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), errorNoOperationToCancel)
+}
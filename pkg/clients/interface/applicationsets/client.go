@@ -20,6 +20,8 @@ type ServiceClient interface {
 	List(ctx context.Context, in *applicationset.ApplicationSetListQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetList, error)
 	// Create creates an applicationset
 	Create(ctx context.Context, in *applicationset.ApplicationSetCreateRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
+	// Generate returns the applications an applicationset would generate
+	Generate(ctx context.Context, in *applicationset.ApplicationSetGenerateRequest, opts ...grpc.CallOption) (*applicationset.ApplicationSetGenerateResponse, error)
 	// Delete deletes an application set
 	Delete(ctx context.Context, in *applicationset.ApplicationSetDeleteRequest, opts ...grpc.CallOption) (*applicationset.ApplicationSetResponse, error)
 }
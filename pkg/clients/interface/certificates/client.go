@@ -0,0 +1,37 @@
+package certificates
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"google.golang.org/grpc"
+)
+
+// CertificateServiceClient wraps the functions to connect to argocd repository certificates
+type CertificateServiceClient interface {
+	// List all available repository certificates
+	ListCertificates(ctx context.Context, in *certificate.RepositoryCertificateQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error)
+	// Creates repository certificates on the server
+	CreateCertificate(ctx context.Context, in *certificate.RepositoryCertificateCreateRequest, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error)
+	// Delete the certificates that match the RepositoryCertificateQuery
+	DeleteCertificate(ctx context.Context, in *certificate.RepositoryCertificateQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error)
+}
+
+// NewCertificateServiceClient creates a new API client from a set of config
+// options. Any error from constructing the underlying argo-cd client or
+// opening the certificate gRPC connection is returned to the caller so the
+// reconciler can retry with backoff instead of crashing the controller process.
+func NewCertificateServiceClient(clientOpts *apiclient.ClientOptions) (io.Closer, certificate.CertificateServiceClient, error) {
+	client, err := apiclient.NewClient(clientOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, certIf, err := client.NewCertClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, certIf, nil
+}
@@ -26,6 +26,8 @@ type ServiceClient interface {
 	Update(ctx context.Context, in *cluster.ClusterUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// Delete deletes a cluster
 	Delete(ctx context.Context, in *cluster.ClusterQuery, opts ...grpc.CallOption) (*cluster.ClusterResponse, error)
+	// InvalidateCache invalidates cluster cache
+	InvalidateCache(ctx context.Context, in *cluster.ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 }
 
 // NewClusterServiceClient creates a new API client from a set of config
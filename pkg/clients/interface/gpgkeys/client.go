@@ -0,0 +1,50 @@
+package gpgkeys
+
+import (
+	"context"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"google.golang.org/grpc"
+)
+
+const (
+	errorGPGKeyNotFound = "No such key"
+)
+
+// GPGKeyServiceClient wraps the functions to connect to argocd gpg keys
+type GPGKeyServiceClient interface {
+	// Get information about specified GPG public key from the server
+	Get(ctx context.Context, in *gpgkey.GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKey, error)
+	// Create one or more GPG public keys in the server's configuration
+	Create(ctx context.Context, in *gpgkey.GnuPGPublicKeyCreateRequest, opts ...grpc.CallOption) (*gpgkey.GnuPGPublicKeyCreateResponse, error)
+	// Delete specified GPG public key from the server's configuration
+	Delete(ctx context.Context, in *gpgkey.GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*gpgkey.GnuPGPublicKeyResponse, error)
+}
+
+// NewGPGKeyServiceClient creates a new API client from a set of config
+// options. Any error from constructing the underlying argo-cd client or
+// opening the gpgkey gRPC connection is returned to the caller so the
+// reconciler can retry with backoff instead of crashing the controller process.
+func NewGPGKeyServiceClient(clientOpts *apiclient.ClientOptions) (io.Closer, gpgkey.GPGKeyServiceClient, error) {
+	client, err := apiclient.NewClient(clientOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, gpgkeyIf, err := client.NewGPGKeyClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, gpgkeyIf, nil
+}
+
+// IsErrorGPGKeyNotFound helper function to test for errorGPGKeyNotFound error.
+func IsErrorGPGKeyNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), errorGPGKeyNotFound)
+}
@@ -15,6 +15,7 @@ import (
 
 	application "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	v1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	apiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	gomock "go.uber.org/mock/gomock"
 	grpc "google.golang.org/grpc"
 )
@@ -103,6 +104,26 @@ func (mr *MockServiceClientMockRecorder) Get(ctx, in any, opts ...any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceClient)(nil).Get), varargs...)
 }
 
+// GetManifests mocks base method.
+func (m *MockServiceClient) GetManifests(ctx context.Context, in *application.ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetManifests", varargs...)
+	ret0, _ := ret[0].(*apiclient.ManifestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManifests indicates an expected call of GetManifests.
+func (mr *MockServiceClientMockRecorder) GetManifests(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManifests", reflect.TypeOf((*MockServiceClient)(nil).GetManifests), varargs...)
+}
+
 // List mocks base method.
 func (m *MockServiceClient) List(ctx context.Context, in *application.ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +144,126 @@ func (mr *MockServiceClientMockRecorder) List(ctx, in any, opts ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServiceClient)(nil).List), varargs...)
 }
 
+// ManagedResources mocks base method.
+func (m *MockServiceClient) ManagedResources(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption) (*application.ManagedResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ManagedResources", varargs...)
+	ret0, _ := ret[0].(*application.ManagedResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ManagedResources indicates an expected call of ManagedResources.
+func (mr *MockServiceClientMockRecorder) ManagedResources(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedResources", reflect.TypeOf((*MockServiceClient)(nil).ManagedResources), varargs...)
+}
+
+// ResourceTree mocks base method.
+func (m *MockServiceClient) ResourceTree(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResourceTree", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.ApplicationTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResourceTree indicates an expected call of ResourceTree.
+func (mr *MockServiceClientMockRecorder) ResourceTree(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceTree", reflect.TypeOf((*MockServiceClient)(nil).ResourceTree), varargs...)
+}
+
+// Rollback mocks base method.
+func (m *MockServiceClient) Rollback(ctx context.Context, in *application.ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Rollback", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockServiceClientMockRecorder) Rollback(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockServiceClient)(nil).Rollback), varargs...)
+}
+
+// RunResourceAction mocks base method.
+func (m *MockServiceClient) RunResourceAction(ctx context.Context, in *application.ResourceActionRunRequest, opts ...grpc.CallOption) (*application.ApplicationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunResourceAction", varargs...)
+	ret0, _ := ret[0].(*application.ApplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunResourceAction indicates an expected call of RunResourceAction.
+func (mr *MockServiceClientMockRecorder) RunResourceAction(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunResourceAction", reflect.TypeOf((*MockServiceClient)(nil).RunResourceAction), varargs...)
+}
+
+// Sync mocks base method.
+func (m *MockServiceClient) Sync(ctx context.Context, in *application.ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Sync", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockServiceClientMockRecorder) Sync(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockServiceClient)(nil).Sync), varargs...)
+}
+
+// TerminateOperation mocks base method.
+func (m *MockServiceClient) TerminateOperation(ctx context.Context, in *application.OperationTerminateRequest, opts ...grpc.CallOption) (*application.OperationTerminateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TerminateOperation", varargs...)
+	ret0, _ := ret[0].(*application.OperationTerminateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TerminateOperation indicates an expected call of TerminateOperation.
+func (mr *MockServiceClientMockRecorder) TerminateOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateOperation", reflect.TypeOf((*MockServiceClient)(nil).TerminateOperation), varargs...)
+}
+
 // Update mocks base method.
 func (m *MockServiceClient) Update(ctx context.Context, in *application.ApplicationUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
 	m.ctrl.T.Helper()
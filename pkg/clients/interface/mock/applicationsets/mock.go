@@ -83,6 +83,26 @@ func (mr *MockServiceClientMockRecorder) Delete(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceClient)(nil).Delete), varargs...)
 }
 
+// Generate mocks base method.
+func (m *MockServiceClient) Generate(ctx context.Context, in *applicationset.ApplicationSetGenerateRequest, opts ...grpc.CallOption) (*applicationset.ApplicationSetGenerateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Generate", varargs...)
+	ret0, _ := ret[0].(*applicationset.ApplicationSetGenerateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockServiceClientMockRecorder) Generate(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockServiceClient)(nil).Generate), varargs...)
+}
+
 // Get mocks base method.
 func (m *MockServiceClient) Get(ctx context.Context, in *applicationset.ApplicationSetGetQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +103,26 @@ func (mr *MockServiceClientMockRecorder) Get(ctx, in any, opts ...any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceClient)(nil).Get), varargs...)
 }
 
+// InvalidateCache mocks base method.
+func (m *MockServiceClient) InvalidateCache(ctx context.Context, in *cluster.ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InvalidateCache", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvalidateCache indicates an expected call of InvalidateCache.
+func (mr *MockServiceClientMockRecorder) InvalidateCache(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateCache", reflect.TypeOf((*MockServiceClient)(nil).InvalidateCache), varargs...)
+}
+
 // Update mocks base method.
 func (m *MockServiceClient) Update(ctx context.Context, in *cluster.ClusterUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -7,3 +7,6 @@ package mock
 //go:generate go run -modfile ../../../../tools/go.mod -mod=mod go.uber.org/mock/mockgen -package cluster -destination=./cluster/mock.go -source=../cluster/client.go ServiceClient -build_flags=-mod=mod
 //go:generate go run -modfile ../../../../tools/go.mod -mod=mod go.uber.org/mock/mockgen -package applicationsets -destination=./applicationsets/mock.go -source=../applicationsets/client.go ServiceClient -build_flags=-mod=mod
 //go:generate go run -modfile ../../../../tools/go.mod -mod=mod go.uber.org/mock/mockgen -package repositories -destination=./repositories/mock.go -source=../repositories/client.go ServiceClient -build_flags=-mod=mod
+//go:generate go run -modfile ../../../../tools/go.mod -mod=mod go.uber.org/mock/mockgen -package gpgkeys -destination=./gpgkeys/mock.go -source=../gpgkeys/client.go ServiceClient -build_flags=-mod=mod
+//go:generate go run -modfile ../../../../tools/go.mod -mod=mod go.uber.org/mock/mockgen -package certificates -destination=./certificates/mock.go -source=../certificates/client.go ServiceClient -build_flags=-mod=mod
+//go:generate go run -modfile ../../../../tools/go.mod -mod=mod go.uber.org/mock/mockgen -package repocreds -destination=./repocreds/mock.go -source=../repocreds/client.go ServiceClient -build_flags=-mod=mod
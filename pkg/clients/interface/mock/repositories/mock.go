@@ -15,6 +15,7 @@ import (
 
 	repository "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	v1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	apiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	gomock "go.uber.org/mock/gomock"
 	grpc "google.golang.org/grpc"
 )
@@ -63,6 +64,26 @@ func (mr *MockRepositoryServiceClientMockRecorder) CreateRepository(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepository", reflect.TypeOf((*MockRepositoryServiceClient)(nil).CreateRepository), varargs...)
 }
 
+// CreateWriteRepository mocks base method.
+func (m *MockRepositoryServiceClient) CreateWriteRepository(ctx context.Context, in *repository.RepoCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWriteRepository", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWriteRepository indicates an expected call of CreateWriteRepository.
+func (mr *MockRepositoryServiceClientMockRecorder) CreateWriteRepository(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWriteRepository", reflect.TypeOf((*MockRepositoryServiceClient)(nil).CreateWriteRepository), varargs...)
+}
+
 // DeleteRepository mocks base method.
 func (m *MockRepositoryServiceClient) DeleteRepository(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*repository.RepoResponse, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +104,26 @@ func (mr *MockRepositoryServiceClientMockRecorder) DeleteRepository(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepository", reflect.TypeOf((*MockRepositoryServiceClient)(nil).DeleteRepository), varargs...)
 }
 
+// DeleteWriteRepository mocks base method.
+func (m *MockRepositoryServiceClient) DeleteWriteRepository(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*repository.RepoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWriteRepository", varargs...)
+	ret0, _ := ret[0].(*repository.RepoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWriteRepository indicates an expected call of DeleteWriteRepository.
+func (mr *MockRepositoryServiceClientMockRecorder) DeleteWriteRepository(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWriteRepository", reflect.TypeOf((*MockRepositoryServiceClient)(nil).DeleteWriteRepository), varargs...)
+}
+
 // Get mocks base method.
 func (m *MockRepositoryServiceClient) Get(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +144,86 @@ func (mr *MockRepositoryServiceClientMockRecorder) Get(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepositoryServiceClient)(nil).Get), varargs...)
 }
 
+// GetAppDetails mocks base method.
+func (m *MockRepositoryServiceClient) GetAppDetails(ctx context.Context, in *repository.RepoAppDetailsQuery, opts ...grpc.CallOption) (*apiclient.RepoAppDetailsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAppDetails", varargs...)
+	ret0, _ := ret[0].(*apiclient.RepoAppDetailsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppDetails indicates an expected call of GetAppDetails.
+func (mr *MockRepositoryServiceClientMockRecorder) GetAppDetails(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppDetails", reflect.TypeOf((*MockRepositoryServiceClient)(nil).GetAppDetails), varargs...)
+}
+
+// GetHelmCharts mocks base method.
+func (m *MockRepositoryServiceClient) GetHelmCharts(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*apiclient.HelmChartsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHelmCharts", varargs...)
+	ret0, _ := ret[0].(*apiclient.HelmChartsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelmCharts indicates an expected call of GetHelmCharts.
+func (mr *MockRepositoryServiceClientMockRecorder) GetHelmCharts(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelmCharts", reflect.TypeOf((*MockRepositoryServiceClient)(nil).GetHelmCharts), varargs...)
+}
+
+// GetWrite mocks base method.
+func (m *MockRepositoryServiceClient) GetWrite(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWrite", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWrite indicates an expected call of GetWrite.
+func (mr *MockRepositoryServiceClientMockRecorder) GetWrite(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWrite", reflect.TypeOf((*MockRepositoryServiceClient)(nil).GetWrite), varargs...)
+}
+
+// ListRefs mocks base method.
+func (m *MockRepositoryServiceClient) ListRefs(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*apiclient.Refs, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRefs", varargs...)
+	ret0, _ := ret[0].(*apiclient.Refs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRefs indicates an expected call of ListRefs.
+func (mr *MockRepositoryServiceClientMockRecorder) ListRefs(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRefs", reflect.TypeOf((*MockRepositoryServiceClient)(nil).ListRefs), varargs...)
+}
+
 // ListRepositories mocks base method.
 func (m *MockRepositoryServiceClient) ListRepositories(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error) {
 	m.ctrl.T.Helper()
@@ -142,3 +263,23 @@ func (mr *MockRepositoryServiceClientMockRecorder) UpdateRepository(ctx, in any,
 	varargs := append([]any{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepository", reflect.TypeOf((*MockRepositoryServiceClient)(nil).UpdateRepository), varargs...)
 }
+
+// UpdateWriteRepository mocks base method.
+func (m *MockRepositoryServiceClient) UpdateWriteRepository(ctx context.Context, in *repository.RepoUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWriteRepository", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWriteRepository indicates an expected call of UpdateWriteRepository.
+func (mr *MockRepositoryServiceClientMockRecorder) UpdateWriteRepository(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWriteRepository", reflect.TypeOf((*MockRepositoryServiceClient)(nil).UpdateWriteRepository), varargs...)
+}
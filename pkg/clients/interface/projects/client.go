@@ -7,8 +7,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argoGrpc "github.com/argoproj/argo-cd/v3/util/grpc"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -54,3 +56,12 @@ func IsErrorProjectNotFound(err error) bool {
 	}
 	return strings.Contains(err.Error(), errorProjectNotFound)
 }
+
+// IsErrorConflict returns true if the error is caused by a concurrent
+// modification of the project. ArgoCD maps kubernetes conflicts to Aborted.
+func IsErrorConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	return argoGrpc.UnwrapGRPCStatus(err).Code() == codes.Aborted
+}
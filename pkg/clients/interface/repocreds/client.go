@@ -0,0 +1,39 @@
+package repocreds
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repocreds"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"google.golang.org/grpc"
+)
+
+// RepoCredsServiceClient wraps the functions to connect to argocd repository credentials
+type RepoCredsServiceClient interface {
+	// ListRepositoryCredentials gets a list of all configured repository credential sets
+	ListRepositoryCredentials(ctx context.Context, in *repocreds.RepoCredsQuery, opts ...grpc.CallOption) (*v1alpha1.RepoCredsList, error)
+	// CreateWriteRepositoryCredentials creates a new repository write credential set
+	CreateWriteRepositoryCredentials(ctx context.Context, in *repocreds.RepoCredsCreateRequest, opts ...grpc.CallOption) (*v1alpha1.RepoCreds, error)
+	// UpdateWriteRepositoryCredentials updates a repository write credential set
+	UpdateWriteRepositoryCredentials(ctx context.Context, in *repocreds.RepoCredsUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.RepoCreds, error)
+	// DeleteWriteRepositoryCredentials deletes a repository write credential set from the configuration
+	DeleteWriteRepositoryCredentials(ctx context.Context, in *repocreds.RepoCredsDeleteRequest, opts ...grpc.CallOption) (*repocreds.RepoCredsResponse, error)
+}
+
+// NewRepoCredsServiceClient creates a new API client from a set of config
+// options. Any error from constructing the underlying argo-cd client or
+// opening the repocreds gRPC connection is returned to the caller so the
+// reconciler can retry with backoff instead of crashing the controller process.
+func NewRepoCredsServiceClient(clientOpts *apiclient.ClientOptions) (io.Closer, repocreds.RepoCredsServiceClient, error) {
+	client, err := apiclient.NewClient(clientOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, repoCredsIf, err := client.NewRepoCredsClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, repoCredsIf, nil
+}
@@ -7,13 +7,15 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	reposerver "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"google.golang.org/grpc"
 )
 
 const (
-	errorRepositoryNotFound = "code = NotFound desc = repo"
-	errorPermissionDenied   = "code = PermissionDenied desc = permission denied"
+	errorRepositoryNotFound      = "code = NotFound desc = repo"
+	errorWriteRepositoryNotFound = "code = NotFound desc = write repo"
+	errorPermissionDenied        = "code = PermissionDenied desc = permission denied"
 )
 
 // RepositoryServiceClient wraps the functions to connect to argocd repositories
@@ -28,6 +30,20 @@ type RepositoryServiceClient interface {
 	UpdateRepository(ctx context.Context, in *repository.RepoUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
 	// Delete deletes a repository from the configuration
 	DeleteRepository(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*repository.RepoResponse, error)
+	// GetWrite returns a repository or its write credentials
+	GetWrite(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
+	// CreateWriteRepository creates a repository with write credentials
+	CreateWriteRepository(ctx context.Context, in *repository.RepoCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
+	// UpdateWriteRepository updates a repository with write credentials
+	UpdateWriteRepository(ctx context.Context, in *repository.RepoUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
+	// DeleteWriteRepository deletes a repository with write credentials from the configuration
+	DeleteWriteRepository(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*repository.RepoResponse, error)
+	// ListRefs returns the branches and tags of a git repository
+	ListRefs(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*reposerver.Refs, error)
+	// GetHelmCharts returns the charts and their versions of a helm repository
+	GetHelmCharts(ctx context.Context, in *repository.RepoQuery, opts ...grpc.CallOption) (*reposerver.HelmChartsResponse, error)
+	// GetAppDetails returns application details for a source, including its type
+	GetAppDetails(ctx context.Context, in *repository.RepoAppDetailsQuery, opts ...grpc.CallOption) (*reposerver.RepoAppDetailsResponse, error)
 }
 
 // NewRepositoryServiceClient creates a new API client from a set of config
@@ -54,6 +70,14 @@ func IsErrorRepositoryNotFound(err error) bool {
 	return strings.Contains(err.Error(), errorRepositoryNotFound)
 }
 
+// IsErrorWriteRepositoryNotFound helper function to test for errorWriteRepositoryNotFound error.
+func IsErrorWriteRepositoryNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), errorWriteRepositoryNotFound)
+}
+
 // IsErrorPermissionDenied helper function to test for errorPermissionDenied error.
 func IsErrorPermissionDenied(err error) bool {
 	if err == nil {
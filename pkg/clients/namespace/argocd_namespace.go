@@ -54,3 +54,17 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.ModernM
 	}
 	return clusterclients.GetClientOptions(ctx, c, &pc.Spec)
 }
+
+// GetArgoCDKubeClient returns a client of the Kubernetes cluster ArgoCD is
+// running in and the namespace of ArgoCD, as configured by the
+// ProviderConfig of the managed resource.
+func GetArgoCDKubeClient(ctx context.Context, c client.Client, mg resource.ModernManaged) (client.Client, string, error) {
+	if mg.GetProviderConfigReference() == nil {
+		return nil, "", errors.New("providerConfigRef is not given")
+	}
+	pc := &v1alpha1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, "", errors.Wrap(err, "cannot get referenced Provider")
+	}
+	return clusterclients.NewArgoCDKubeClient(ctx, c, &pc.Spec)
+}
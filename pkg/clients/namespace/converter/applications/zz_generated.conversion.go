@@ -66,7 +66,7 @@ func (c *ConverterImpl) ToArgoApplicationSpec(source *v1alpha11.ApplicationParam
 	var pV1alpha1ApplicationSpec *v1alpha1.ApplicationSpec
 	if source != nil {
 		var v1alpha1ApplicationSpec v1alpha1.ApplicationSpec
-		v1alpha1ApplicationSpec.Source = c.pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource2((*source).Source)
+		v1alpha1ApplicationSpec.Source = c.pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource((*source).Source)
 		v1alpha1ApplicationSpec.Destination = c.ToArgoDestination((*source).Destination)
 		v1alpha1ApplicationSpec.Project = (*source).Project
 		v1alpha1ApplicationSpec.SyncPolicy = c.pV1alpha1SyncPolicyToPV1alpha1SyncPolicy((*source).SyncPolicy)
@@ -81,7 +81,7 @@ func (c *ConverterImpl) ToArgoApplicationSpec(source *v1alpha11.ApplicationParam
 			xint64 := *(*source).RevisionHistoryLimit
 			v1alpha1ApplicationSpec.RevisionHistoryLimit = &xint64
 		}
-		v1alpha1ApplicationSpec.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSources2((*source).Sources)
+		v1alpha1ApplicationSpec.Sources = c.v1alpha1ApplicationSourceListToV1alpha1ApplicationSources((*source).Sources)
 		v1alpha1ApplicationSpec.SourceHydrator = c.pV1alpha1SourceHydratorToPV1alpha1SourceHydrator((*source).SourceHydrator)
 		pV1alpha1ApplicationSpec = &v1alpha1ApplicationSpec
 	}
@@ -435,15 +435,7 @@ func (c *ConverterImpl) pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSo
 	}
 	return pV1alpha1ApplicationSourcePlugin
 }
-func (c *ConverterImpl) pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource(source *v1alpha1.ApplicationSource) *v1alpha11.ApplicationSource {
-	var pV1alpha1ApplicationSource *v1alpha11.ApplicationSource
-	if source != nil {
-		v1alpha1ApplicationSource := c.v1alpha1ApplicationSourceToV1alpha1ApplicationSource((*source))
-		pV1alpha1ApplicationSource = &v1alpha1ApplicationSource
-	}
-	return pV1alpha1ApplicationSource
-}
-func (c *ConverterImpl) pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource2(source *v1alpha11.ApplicationSource) *v1alpha1.ApplicationSource {
+func (c *ConverterImpl) pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource(source *v1alpha11.ApplicationSource) *v1alpha1.ApplicationSource {
 	var pV1alpha1ApplicationSource *v1alpha1.ApplicationSource
 	if source != nil {
 		var v1alpha1ApplicationSource v1alpha1.ApplicationSource
@@ -469,6 +461,14 @@ func (c *ConverterImpl) pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource2(
 	}
 	return pV1alpha1ApplicationSource
 }
+func (c *ConverterImpl) pV1alpha1ApplicationSourceToPV1alpha1ApplicationSourceObservation(source *v1alpha1.ApplicationSource) *v1alpha11.ApplicationSourceObservation {
+	var pV1alpha1ApplicationSourceObservation *v1alpha11.ApplicationSourceObservation
+	if source != nil {
+		v1alpha1ApplicationSourceObservation := c.v1alpha1ApplicationSourceToV1alpha1ApplicationSourceObservation((*source))
+		pV1alpha1ApplicationSourceObservation = &v1alpha1ApplicationSourceObservation
+	}
+	return pV1alpha1ApplicationSourceObservation
+}
 func (c *ConverterImpl) pV1alpha1BackoffToPV1alpha1Backoff(source *v1alpha1.Backoff) *v1alpha11.Backoff {
 	var pV1alpha1Backoff *v1alpha11.Backoff
 	if source != nil {
@@ -720,8 +720,8 @@ func (c *ConverterImpl) pV1alpha1SyncOperationResultToPV1alpha1SyncOperationResu
 		var v1alpha1SyncOperationResult v1alpha11.SyncOperationResult
 		v1alpha1SyncOperationResult.Resources = c.v1alpha1ResourceResultsToV1alpha1ResourceResults((*source).Resources)
 		v1alpha1SyncOperationResult.Revision = (*source).Revision
-		v1alpha1SyncOperationResult.Source = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSource((*source).Source)
-		v1alpha1SyncOperationResult.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSources((*source).Sources)
+		v1alpha1SyncOperationResult.Source = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSourceObservation((*source).Source)
+		v1alpha1SyncOperationResult.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSourceObservations((*source).Sources)
 		if (*source).Revisions != nil {
 			v1alpha1SyncOperationResult.Revisions = make([]string, len((*source).Revisions))
 			for i := 0; i < len((*source).Revisions); i++ {
@@ -749,7 +749,7 @@ func (c *ConverterImpl) pV1alpha1SyncOperationToPV1alpha1SyncOperation(source *v
 				v1alpha1SyncOperation.Resources[i] = c.v1alpha1SyncOperationResourceToV1alpha1SyncOperationResource((*source).Resources[i])
 			}
 		}
-		v1alpha1SyncOperation.Source = c.pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource((*source).Source)
+		v1alpha1SyncOperation.Source = c.pV1alpha1ApplicationSourceToPV1alpha1ApplicationSourceObservation((*source).Source)
 		if (*source).Manifests != nil {
 			v1alpha1SyncOperation.Manifests = make([]string, len((*source).Manifests))
 			for j := 0; j < len((*source).Manifests); j++ {
@@ -757,7 +757,7 @@ func (c *ConverterImpl) pV1alpha1SyncOperationToPV1alpha1SyncOperation(source *v
 			}
 		}
 		v1alpha1SyncOperation.SyncOptions = c.v1alpha1SyncOptionsToV1alpha1SyncOptions((*source).SyncOptions)
-		v1alpha1SyncOperation.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSources((*source).Sources)
+		v1alpha1SyncOperation.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSourceObservations((*source).Sources)
 		if (*source).Revisions != nil {
 			v1alpha1SyncOperation.Revisions = make([]string, len((*source).Revisions))
 			for k := 0; k < len((*source).Revisions); k++ {
@@ -887,6 +887,16 @@ func (c *ConverterImpl) v1alpha1ApplicationSourceJsonnetToV1alpha1ApplicationSou
 	}
 	return v1alpha1ApplicationSourceJsonnet
 }
+func (c *ConverterImpl) v1alpha1ApplicationSourceListToV1alpha1ApplicationSources(source []v1alpha11.ApplicationSource) v1alpha1.ApplicationSources {
+	var v1alpha1ApplicationSources v1alpha1.ApplicationSources
+	if source != nil {
+		v1alpha1ApplicationSources = make(v1alpha1.ApplicationSources, len(source))
+		for i := 0; i < len(source); i++ {
+			v1alpha1ApplicationSources[i] = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSource(source[i])
+		}
+	}
+	return v1alpha1ApplicationSources
+}
 func (c *ConverterImpl) v1alpha1ApplicationSourcePluginParameterToV1alpha1ApplicationSourcePluginParameter(source v1alpha1.ApplicationSourcePluginParameter) v1alpha11.ApplicationSourcePluginParameter {
 	var v1alpha1ApplicationSourcePluginParameter v1alpha11.ApplicationSourcePluginParameter
 	pString := source.Name
@@ -932,25 +942,7 @@ func (c *ConverterImpl) v1alpha1ApplicationSourcePluginParametersToV1alpha1Appli
 	}
 	return v1alpha1ApplicationSourcePluginParameters
 }
-func (c *ConverterImpl) v1alpha1ApplicationSourceToV1alpha1ApplicationSource(source v1alpha1.ApplicationSource) v1alpha11.ApplicationSource {
-	var v1alpha1ApplicationSource v1alpha11.ApplicationSource
-	v1alpha1ApplicationSource.RepoURL = source.RepoURL
-	pString := source.Path
-	v1alpha1ApplicationSource.Path = &pString
-	pString2 := source.TargetRevision
-	v1alpha1ApplicationSource.TargetRevision = &pString2
-	v1alpha1ApplicationSource.Helm = c.pV1alpha1ApplicationSourceHelmToPV1alpha1ApplicationSourceHelm(source.Helm)
-	v1alpha1ApplicationSource.Kustomize = c.pV1alpha1ApplicationSourceKustomizeToPV1alpha1ApplicationSourceKustomize(source.Kustomize)
-	v1alpha1ApplicationSource.Directory = c.pV1alpha1ApplicationSourceDirectoryToPV1alpha1ApplicationSourceDirectory(source.Directory)
-	v1alpha1ApplicationSource.Plugin = c.pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSourcePlugin(source.Plugin)
-	pString3 := source.Chart
-	v1alpha1ApplicationSource.Chart = &pString3
-	pString4 := source.Ref
-	v1alpha1ApplicationSource.Ref = &pString4
-	v1alpha1ApplicationSource.Name = source.Name
-	return v1alpha1ApplicationSource
-}
-func (c *ConverterImpl) v1alpha1ApplicationSourceToV1alpha1ApplicationSource2(source v1alpha11.ApplicationSource) v1alpha1.ApplicationSource {
+func (c *ConverterImpl) v1alpha1ApplicationSourceToV1alpha1ApplicationSource(source v1alpha11.ApplicationSource) v1alpha1.ApplicationSource {
 	var v1alpha1ApplicationSource v1alpha1.ApplicationSource
 	v1alpha1ApplicationSource.RepoURL = source.RepoURL
 	if source.Path != nil {
@@ -972,28 +964,36 @@ func (c *ConverterImpl) v1alpha1ApplicationSourceToV1alpha1ApplicationSource2(so
 	v1alpha1ApplicationSource.Name = source.Name
 	return v1alpha1ApplicationSource
 }
+func (c *ConverterImpl) v1alpha1ApplicationSourceToV1alpha1ApplicationSourceObservation(source v1alpha1.ApplicationSource) v1alpha11.ApplicationSourceObservation {
+	var v1alpha1ApplicationSourceObservation v1alpha11.ApplicationSourceObservation
+	v1alpha1ApplicationSourceObservation.RepoURL = source.RepoURL
+	pString := source.Path
+	v1alpha1ApplicationSourceObservation.Path = &pString
+	pString2 := source.TargetRevision
+	v1alpha1ApplicationSourceObservation.TargetRevision = &pString2
+	v1alpha1ApplicationSourceObservation.Helm = c.pV1alpha1ApplicationSourceHelmToPV1alpha1ApplicationSourceHelm(source.Helm)
+	v1alpha1ApplicationSourceObservation.Kustomize = c.pV1alpha1ApplicationSourceKustomizeToPV1alpha1ApplicationSourceKustomize(source.Kustomize)
+	v1alpha1ApplicationSourceObservation.Directory = c.pV1alpha1ApplicationSourceDirectoryToPV1alpha1ApplicationSourceDirectory(source.Directory)
+	v1alpha1ApplicationSourceObservation.Plugin = c.pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSourcePlugin(source.Plugin)
+	pString3 := source.Chart
+	v1alpha1ApplicationSourceObservation.Chart = &pString3
+	pString4 := source.Ref
+	v1alpha1ApplicationSourceObservation.Ref = &pString4
+	v1alpha1ApplicationSourceObservation.Name = source.Name
+	return v1alpha1ApplicationSourceObservation
+}
 func (c *ConverterImpl) v1alpha1ApplicationSourceTypeToV1alpha1ApplicationSourceType(source v1alpha1.ApplicationSourceType) v1alpha11.ApplicationSourceType {
 	return v1alpha11.ApplicationSourceType(source)
 }
-func (c *ConverterImpl) v1alpha1ApplicationSourcesToV1alpha1ApplicationSources(source v1alpha1.ApplicationSources) v1alpha11.ApplicationSources {
-	var v1alpha1ApplicationSources v1alpha11.ApplicationSources
+func (c *ConverterImpl) v1alpha1ApplicationSourcesToV1alpha1ApplicationSourceObservations(source v1alpha1.ApplicationSources) v1alpha11.ApplicationSourceObservations {
+	var v1alpha1ApplicationSourceObservations v1alpha11.ApplicationSourceObservations
 	if source != nil {
-		v1alpha1ApplicationSources = make(v1alpha11.ApplicationSources, len(source))
+		v1alpha1ApplicationSourceObservations = make(v1alpha11.ApplicationSourceObservations, len(source))
 		for i := 0; i < len(source); i++ {
-			v1alpha1ApplicationSources[i] = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSource(source[i])
-		}
-	}
-	return v1alpha1ApplicationSources
-}
-func (c *ConverterImpl) v1alpha1ApplicationSourcesToV1alpha1ApplicationSources2(source v1alpha11.ApplicationSources) v1alpha1.ApplicationSources {
-	var v1alpha1ApplicationSources v1alpha1.ApplicationSources
-	if source != nil {
-		v1alpha1ApplicationSources = make(v1alpha1.ApplicationSources, len(source))
-		for i := 0; i < len(source); i++ {
-			v1alpha1ApplicationSources[i] = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSource2(source[i])
+			v1alpha1ApplicationSourceObservations[i] = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSourceObservation(source[i])
 		}
 	}
-	return v1alpha1ApplicationSources
+	return v1alpha1ApplicationSourceObservations
 }
 func (c *ConverterImpl) v1alpha1ApplicationSummaryToV1alpha1ApplicationSummary(source v1alpha1.ApplicationSummary) v1alpha11.ApplicationSummary {
 	var v1alpha1ApplicationSummary v1alpha11.ApplicationSummary
@@ -1013,9 +1013,9 @@ func (c *ConverterImpl) v1alpha1ApplicationSummaryToV1alpha1ApplicationSummary(s
 }
 func (c *ConverterImpl) v1alpha1ComparedToToV1alpha1ComparedTo(source v1alpha1.ComparedTo) v1alpha11.ComparedTo {
 	var v1alpha1ComparedTo v1alpha11.ComparedTo
-	v1alpha1ComparedTo.Source = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSource(source.Source)
+	v1alpha1ComparedTo.Source = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSourceObservation(source.Source)
 	v1alpha1ComparedTo.Destination = c.FromArgoDestination(source.Destination)
-	v1alpha1ComparedTo.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSources(source.Sources)
+	v1alpha1ComparedTo.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSourceObservations(source.Sources)
 	return v1alpha1ComparedTo
 }
 func (c *ConverterImpl) v1alpha1DrySourceToV1alpha1DrySource(source v1alpha11.DrySource) v1alpha1.DrySource {
@@ -1359,9 +1359,9 @@ func (c *ConverterImpl) v1alpha1RevisionHistoryToV1alpha1RevisionHistory(source
 	v1alpha1RevisionHistory.DeployedAt = c.v1TimeToPV1Time(source.DeployedAt)
 	pInt64 := source.ID
 	v1alpha1RevisionHistory.ID = &pInt64
-	v1alpha1RevisionHistory.Source = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSource(source.Source)
+	v1alpha1RevisionHistory.Source = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSourceObservation(source.Source)
 	v1alpha1RevisionHistory.DeployStartedAt = c.pV1TimeToPV1Time(source.DeployStartedAt)
-	v1alpha1RevisionHistory.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSources(source.Sources)
+	v1alpha1RevisionHistory.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSourceObservations(source.Sources)
 	if source.Revisions != nil {
 		v1alpha1RevisionHistory.Revisions = make([]string, len(source.Revisions))
 		for i := 0; i < len(source.Revisions); i++ {
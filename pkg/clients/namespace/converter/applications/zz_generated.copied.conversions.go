@@ -36,6 +36,11 @@ type Converter interface {
 
 	ToArgoApplicationSpec(in *v1alpha1.ApplicationParameters) *argocdv1alpha1.ApplicationSpec
 
+	// goverter:ignore LastRefreshRequestedAt
+	// goverter:ignore LastRefreshTime
+	// goverter:ignore ResourceTree
+	// goverter:ignore ManagedResources
+	// goverter:ignore ExportedManifests
 	FromArgoApplicationStatus(in *argocdv1alpha1.ApplicationStatus) *v1alpha1.ArgoApplicationStatus
 }
 
@@ -73,6 +73,46 @@ func (c *ConverterImpl) FromArgoApplicationSetStatus(source *v1alpha1.Applicatio
 	}
 	return pV1alpha1ArgoApplicationSetStatus
 }
+func (c *ConverterImpl) FromArgoApplicationSource(source v1alpha1.ApplicationSource) v1alpha11.ApplicationSource {
+	var v1alpha1ApplicationSource v1alpha11.ApplicationSource
+	v1alpha1ApplicationSource.RepoURL = source.RepoURL
+	pString := source.Path
+	v1alpha1ApplicationSource.Path = &pString
+	pString2 := source.TargetRevision
+	v1alpha1ApplicationSource.TargetRevision = &pString2
+	v1alpha1ApplicationSource.Helm = c.pV1alpha1ApplicationSourceHelmToPV1alpha1ApplicationSourceHelm(source.Helm)
+	v1alpha1ApplicationSource.Kustomize = c.pV1alpha1ApplicationSourceKustomizeToPV1alpha1ApplicationSourceKustomize(source.Kustomize)
+	v1alpha1ApplicationSource.Directory = c.pV1alpha1ApplicationSourceDirectoryToPV1alpha1ApplicationSourceDirectory(source.Directory)
+	v1alpha1ApplicationSource.Plugin = c.pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSourcePlugin(source.Plugin)
+	pString3 := source.Chart
+	v1alpha1ApplicationSource.Chart = &pString3
+	pString4 := source.Ref
+	v1alpha1ApplicationSource.Ref = &pString4
+	pString5 := source.Name
+	v1alpha1ApplicationSource.Name = &pString5
+	return v1alpha1ApplicationSource
+}
+func (c *ConverterImpl) FromArgoApplicationSpec(source v1alpha1.ApplicationSpec) v1alpha11.ApplicationSpec {
+	var v1alpha1ApplicationSpec v1alpha11.ApplicationSpec
+	v1alpha1ApplicationSpec.Source = c.pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource(source.Source)
+	v1alpha1ApplicationSpec.Destination = c.FromArgoDestination(source.Destination)
+	v1alpha1ApplicationSpec.Project = source.Project
+	v1alpha1ApplicationSpec.SyncPolicy = c.pV1alpha1SyncPolicyToPV1alpha1SyncPolicy(source.SyncPolicy)
+	v1alpha1ApplicationSpec.IgnoreDifferences = c.v1alpha1IgnoreDifferencesToV1alpha1ResourceIgnoreDifferencesList(source.IgnoreDifferences)
+	if source.Info != nil {
+		v1alpha1ApplicationSpec.Info = make([]v1alpha11.Info, len(source.Info))
+		for i := 0; i < len(source.Info); i++ {
+			v1alpha1ApplicationSpec.Info[i] = c.v1alpha1InfoToV1alpha1Info(source.Info[i])
+		}
+	}
+	if source.RevisionHistoryLimit != nil {
+		xint64 := *source.RevisionHistoryLimit
+		v1alpha1ApplicationSpec.RevisionHistoryLimit = &xint64
+	}
+	v1alpha1ApplicationSpec.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSourceList(source.Sources)
+	v1alpha1ApplicationSpec.SourceHydrator = c.pV1alpha1SourceHydratorToPV1alpha1SourceHydrator(source.SourceHydrator)
+	return v1alpha1ApplicationSpec
+}
 func (c *ConverterImpl) FromArgoDestination(source v1alpha1.ApplicationDestination) v1alpha11.ApplicationDestination {
 	var v1alpha1ApplicationDestination v1alpha11.ApplicationDestination
 	pString := source.Server
@@ -140,6 +180,51 @@ func (c *ConverterImpl) ToArgoApplicationSetStatus(source *v1alpha11.ArgoApplica
 	}
 	return pV1alpha1ApplicationSetStatus
 }
+func (c *ConverterImpl) ToArgoApplicationSource(source v1alpha11.ApplicationSource) v1alpha1.ApplicationSource {
+	var v1alpha1ApplicationSource v1alpha1.ApplicationSource
+	v1alpha1ApplicationSource.RepoURL = source.RepoURL
+	if source.Path != nil {
+		v1alpha1ApplicationSource.Path = *source.Path
+	}
+	if source.TargetRevision != nil {
+		v1alpha1ApplicationSource.TargetRevision = *source.TargetRevision
+	}
+	v1alpha1ApplicationSource.Helm = c.pV1alpha1ApplicationSourceHelmToPV1alpha1ApplicationSourceHelm2(source.Helm)
+	v1alpha1ApplicationSource.Kustomize = c.pV1alpha1ApplicationSourceKustomizeToPV1alpha1ApplicationSourceKustomize2(source.Kustomize)
+	v1alpha1ApplicationSource.Directory = c.pV1alpha1ApplicationSourceDirectoryToPV1alpha1ApplicationSourceDirectory2(source.Directory)
+	v1alpha1ApplicationSource.Plugin = c.pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSourcePlugin2(source.Plugin)
+	if source.Chart != nil {
+		v1alpha1ApplicationSource.Chart = *source.Chart
+	}
+	if source.Ref != nil {
+		v1alpha1ApplicationSource.Ref = *source.Ref
+	}
+	if source.Name != nil {
+		v1alpha1ApplicationSource.Name = *source.Name
+	}
+	return v1alpha1ApplicationSource
+}
+func (c *ConverterImpl) ToArgoApplicationSpec(source v1alpha11.ApplicationSpec) v1alpha1.ApplicationSpec {
+	var v1alpha1ApplicationSpec v1alpha1.ApplicationSpec
+	v1alpha1ApplicationSpec.Source = c.pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource2(source.Source)
+	v1alpha1ApplicationSpec.Destination = c.ToArgoDestination(source.Destination)
+	v1alpha1ApplicationSpec.Project = source.Project
+	v1alpha1ApplicationSpec.SyncPolicy = c.pV1alpha1SyncPolicyToPV1alpha1SyncPolicy2(source.SyncPolicy)
+	v1alpha1ApplicationSpec.IgnoreDifferences = c.v1alpha1ResourceIgnoreDifferencesListToV1alpha1IgnoreDifferences(source.IgnoreDifferences)
+	if source.Info != nil {
+		v1alpha1ApplicationSpec.Info = make([]v1alpha1.Info, len(source.Info))
+		for i := 0; i < len(source.Info); i++ {
+			v1alpha1ApplicationSpec.Info[i] = c.v1alpha1InfoToV1alpha1Info2(source.Info[i])
+		}
+	}
+	if source.RevisionHistoryLimit != nil {
+		xint64 := *source.RevisionHistoryLimit
+		v1alpha1ApplicationSpec.RevisionHistoryLimit = &xint64
+	}
+	v1alpha1ApplicationSpec.Sources = c.v1alpha1ApplicationSourceListToV1alpha1ApplicationSources(source.Sources)
+	v1alpha1ApplicationSpec.SourceHydrator = c.pV1alpha1SourceHydratorToPV1alpha1SourceHydrator2(source.SourceHydrator)
+	return v1alpha1ApplicationSpec
+}
 func (c *ConverterImpl) ToArgoDestination(source v1alpha11.ApplicationDestination) v1alpha1.ApplicationDestination {
 	var v1alpha1ApplicationDestination v1alpha1.ApplicationDestination
 	if source.Server != nil {
@@ -620,22 +705,7 @@ func (c *ConverterImpl) pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSo
 func (c *ConverterImpl) pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource(source *v1alpha1.ApplicationSource) *v1alpha11.ApplicationSource {
 	var pV1alpha1ApplicationSource *v1alpha11.ApplicationSource
 	if source != nil {
-		var v1alpha1ApplicationSource v1alpha11.ApplicationSource
-		v1alpha1ApplicationSource.RepoURL = (*source).RepoURL
-		pString := (*source).Path
-		v1alpha1ApplicationSource.Path = &pString
-		pString2 := (*source).TargetRevision
-		v1alpha1ApplicationSource.TargetRevision = &pString2
-		v1alpha1ApplicationSource.Helm = c.pV1alpha1ApplicationSourceHelmToPV1alpha1ApplicationSourceHelm((*source).Helm)
-		v1alpha1ApplicationSource.Kustomize = c.pV1alpha1ApplicationSourceKustomizeToPV1alpha1ApplicationSourceKustomize((*source).Kustomize)
-		v1alpha1ApplicationSource.Directory = c.pV1alpha1ApplicationSourceDirectoryToPV1alpha1ApplicationSourceDirectory((*source).Directory)
-		v1alpha1ApplicationSource.Plugin = c.pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSourcePlugin((*source).Plugin)
-		pString3 := (*source).Chart
-		v1alpha1ApplicationSource.Chart = &pString3
-		pString4 := (*source).Ref
-		v1alpha1ApplicationSource.Ref = &pString4
-		pString5 := (*source).Name
-		v1alpha1ApplicationSource.Name = &pString5
+		v1alpha1ApplicationSource := c.FromArgoApplicationSource((*source))
 		pV1alpha1ApplicationSource = &v1alpha1ApplicationSource
 	}
 	return pV1alpha1ApplicationSource
@@ -643,27 +713,7 @@ func (c *ConverterImpl) pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource(s
 func (c *ConverterImpl) pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource2(source *v1alpha11.ApplicationSource) *v1alpha1.ApplicationSource {
 	var pV1alpha1ApplicationSource *v1alpha1.ApplicationSource
 	if source != nil {
-		var v1alpha1ApplicationSource v1alpha1.ApplicationSource
-		v1alpha1ApplicationSource.RepoURL = (*source).RepoURL
-		if (*source).Path != nil {
-			v1alpha1ApplicationSource.Path = *(*source).Path
-		}
-		if (*source).TargetRevision != nil {
-			v1alpha1ApplicationSource.TargetRevision = *(*source).TargetRevision
-		}
-		v1alpha1ApplicationSource.Helm = c.pV1alpha1ApplicationSourceHelmToPV1alpha1ApplicationSourceHelm2((*source).Helm)
-		v1alpha1ApplicationSource.Kustomize = c.pV1alpha1ApplicationSourceKustomizeToPV1alpha1ApplicationSourceKustomize2((*source).Kustomize)
-		v1alpha1ApplicationSource.Directory = c.pV1alpha1ApplicationSourceDirectoryToPV1alpha1ApplicationSourceDirectory2((*source).Directory)
-		v1alpha1ApplicationSource.Plugin = c.pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSourcePlugin2((*source).Plugin)
-		if (*source).Chart != nil {
-			v1alpha1ApplicationSource.Chart = *(*source).Chart
-		}
-		if (*source).Ref != nil {
-			v1alpha1ApplicationSource.Ref = *(*source).Ref
-		}
-		if (*source).Name != nil {
-			v1alpha1ApplicationSource.Name = *(*source).Name
-		}
+		v1alpha1ApplicationSource := c.ToArgoApplicationSource((*source))
 		pV1alpha1ApplicationSource = &v1alpha1ApplicationSource
 	}
 	return pV1alpha1ApplicationSource
@@ -2243,13 +2293,13 @@ func (c *ConverterImpl) v1alpha1ApplicationSetTemplateMetaToV1alpha1ApplicationS
 func (c *ConverterImpl) v1alpha1ApplicationSetTemplateToV1alpha1ApplicationSetTemplate(source v1alpha1.ApplicationSetTemplate) v1alpha11.ApplicationSetTemplate {
 	var v1alpha1ApplicationSetTemplate v1alpha11.ApplicationSetTemplate
 	v1alpha1ApplicationSetTemplate.ApplicationSetTemplateMeta = c.v1alpha1ApplicationSetTemplateMetaToV1alpha1ApplicationSetTemplateMeta(source.ApplicationSetTemplateMeta)
-	v1alpha1ApplicationSetTemplate.Spec = c.v1alpha1ApplicationSpecToV1alpha1ApplicationSpec(source.Spec)
+	v1alpha1ApplicationSetTemplate.Spec = c.FromArgoApplicationSpec(source.Spec)
 	return v1alpha1ApplicationSetTemplate
 }
 func (c *ConverterImpl) v1alpha1ApplicationSetTemplateToV1alpha1ApplicationSetTemplate2(source v1alpha11.ApplicationSetTemplate) v1alpha1.ApplicationSetTemplate {
 	var v1alpha1ApplicationSetTemplate v1alpha1.ApplicationSetTemplate
 	v1alpha1ApplicationSetTemplate.ApplicationSetTemplateMeta = c.v1alpha1ApplicationSetTemplateMetaToV1alpha1ApplicationSetTemplateMeta2(source.ApplicationSetTemplateMeta)
-	v1alpha1ApplicationSetTemplate.Spec = c.v1alpha1ApplicationSpecToV1alpha1ApplicationSpec2(source.Spec)
+	v1alpha1ApplicationSetTemplate.Spec = c.ToArgoApplicationSpec(source.Spec)
 	return v1alpha1ApplicationSetTemplate
 }
 func (c *ConverterImpl) v1alpha1ApplicationSourceJsonnetToV1alpha1ApplicationSourceJsonnet(source v1alpha1.ApplicationSourceJsonnet) v1alpha11.ApplicationSourceJsonnet {
@@ -2296,6 +2346,16 @@ func (c *ConverterImpl) v1alpha1ApplicationSourceJsonnetToV1alpha1ApplicationSou
 	}
 	return v1alpha1ApplicationSourceJsonnet
 }
+func (c *ConverterImpl) v1alpha1ApplicationSourceListToV1alpha1ApplicationSources(source []v1alpha11.ApplicationSource) v1alpha1.ApplicationSources {
+	var v1alpha1ApplicationSources v1alpha1.ApplicationSources
+	if source != nil {
+		v1alpha1ApplicationSources = make(v1alpha1.ApplicationSources, len(source))
+		for i := 0; i < len(source); i++ {
+			v1alpha1ApplicationSources[i] = c.ToArgoApplicationSource(source[i])
+		}
+	}
+	return v1alpha1ApplicationSources
+}
 func (c *ConverterImpl) v1alpha1ApplicationSourcePluginParameterToV1alpha1ApplicationSourcePluginParameter(source v1alpha1.ApplicationSourcePluginParameter) v1alpha11.ApplicationSourcePluginParameter {
 	var v1alpha1ApplicationSourcePluginParameter v1alpha11.ApplicationSourcePluginParameter
 	pString := source.Name
@@ -2341,110 +2401,15 @@ func (c *ConverterImpl) v1alpha1ApplicationSourcePluginParametersToV1alpha1Appli
 	}
 	return v1alpha1ApplicationSourcePluginParameters
 }
-func (c *ConverterImpl) v1alpha1ApplicationSourceToV1alpha1ApplicationSource(source v1alpha1.ApplicationSource) v1alpha11.ApplicationSource {
-	var v1alpha1ApplicationSource v1alpha11.ApplicationSource
-	v1alpha1ApplicationSource.RepoURL = source.RepoURL
-	pString := source.Path
-	v1alpha1ApplicationSource.Path = &pString
-	pString2 := source.TargetRevision
-	v1alpha1ApplicationSource.TargetRevision = &pString2
-	v1alpha1ApplicationSource.Helm = c.pV1alpha1ApplicationSourceHelmToPV1alpha1ApplicationSourceHelm(source.Helm)
-	v1alpha1ApplicationSource.Kustomize = c.pV1alpha1ApplicationSourceKustomizeToPV1alpha1ApplicationSourceKustomize(source.Kustomize)
-	v1alpha1ApplicationSource.Directory = c.pV1alpha1ApplicationSourceDirectoryToPV1alpha1ApplicationSourceDirectory(source.Directory)
-	v1alpha1ApplicationSource.Plugin = c.pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSourcePlugin(source.Plugin)
-	pString3 := source.Chart
-	v1alpha1ApplicationSource.Chart = &pString3
-	pString4 := source.Ref
-	v1alpha1ApplicationSource.Ref = &pString4
-	pString5 := source.Name
-	v1alpha1ApplicationSource.Name = &pString5
-	return v1alpha1ApplicationSource
-}
-func (c *ConverterImpl) v1alpha1ApplicationSourceToV1alpha1ApplicationSource2(source v1alpha11.ApplicationSource) v1alpha1.ApplicationSource {
-	var v1alpha1ApplicationSource v1alpha1.ApplicationSource
-	v1alpha1ApplicationSource.RepoURL = source.RepoURL
-	if source.Path != nil {
-		v1alpha1ApplicationSource.Path = *source.Path
-	}
-	if source.TargetRevision != nil {
-		v1alpha1ApplicationSource.TargetRevision = *source.TargetRevision
-	}
-	v1alpha1ApplicationSource.Helm = c.pV1alpha1ApplicationSourceHelmToPV1alpha1ApplicationSourceHelm2(source.Helm)
-	v1alpha1ApplicationSource.Kustomize = c.pV1alpha1ApplicationSourceKustomizeToPV1alpha1ApplicationSourceKustomize2(source.Kustomize)
-	v1alpha1ApplicationSource.Directory = c.pV1alpha1ApplicationSourceDirectoryToPV1alpha1ApplicationSourceDirectory2(source.Directory)
-	v1alpha1ApplicationSource.Plugin = c.pV1alpha1ApplicationSourcePluginToPV1alpha1ApplicationSourcePlugin2(source.Plugin)
-	if source.Chart != nil {
-		v1alpha1ApplicationSource.Chart = *source.Chart
-	}
-	if source.Ref != nil {
-		v1alpha1ApplicationSource.Ref = *source.Ref
-	}
-	if source.Name != nil {
-		v1alpha1ApplicationSource.Name = *source.Name
-	}
-	return v1alpha1ApplicationSource
-}
-func (c *ConverterImpl) v1alpha1ApplicationSourcesToV1alpha1ApplicationSources(source v1alpha1.ApplicationSources) v1alpha11.ApplicationSources {
-	var v1alpha1ApplicationSources v1alpha11.ApplicationSources
-	if source != nil {
-		v1alpha1ApplicationSources = make(v1alpha11.ApplicationSources, len(source))
-		for i := 0; i < len(source); i++ {
-			v1alpha1ApplicationSources[i] = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSource(source[i])
-		}
-	}
-	return v1alpha1ApplicationSources
-}
-func (c *ConverterImpl) v1alpha1ApplicationSourcesToV1alpha1ApplicationSources2(source v1alpha11.ApplicationSources) v1alpha1.ApplicationSources {
-	var v1alpha1ApplicationSources v1alpha1.ApplicationSources
+func (c *ConverterImpl) v1alpha1ApplicationSourcesToV1alpha1ApplicationSourceList(source v1alpha1.ApplicationSources) []v1alpha11.ApplicationSource {
+	var v1alpha1ApplicationSourceList []v1alpha11.ApplicationSource
 	if source != nil {
-		v1alpha1ApplicationSources = make(v1alpha1.ApplicationSources, len(source))
+		v1alpha1ApplicationSourceList = make([]v1alpha11.ApplicationSource, len(source))
 		for i := 0; i < len(source); i++ {
-			v1alpha1ApplicationSources[i] = c.v1alpha1ApplicationSourceToV1alpha1ApplicationSource2(source[i])
+			v1alpha1ApplicationSourceList[i] = c.FromArgoApplicationSource(source[i])
 		}
 	}
-	return v1alpha1ApplicationSources
-}
-func (c *ConverterImpl) v1alpha1ApplicationSpecToV1alpha1ApplicationSpec(source v1alpha1.ApplicationSpec) v1alpha11.ApplicationSpec {
-	var v1alpha1ApplicationSpec v1alpha11.ApplicationSpec
-	v1alpha1ApplicationSpec.Source = c.pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource(source.Source)
-	v1alpha1ApplicationSpec.Destination = c.FromArgoDestination(source.Destination)
-	v1alpha1ApplicationSpec.Project = source.Project
-	v1alpha1ApplicationSpec.SyncPolicy = c.pV1alpha1SyncPolicyToPV1alpha1SyncPolicy(source.SyncPolicy)
-	v1alpha1ApplicationSpec.IgnoreDifferences = c.v1alpha1IgnoreDifferencesToV1alpha1ResourceIgnoreDifferencesList(source.IgnoreDifferences)
-	if source.Info != nil {
-		v1alpha1ApplicationSpec.Info = make([]v1alpha11.Info, len(source.Info))
-		for i := 0; i < len(source.Info); i++ {
-			v1alpha1ApplicationSpec.Info[i] = c.v1alpha1InfoToV1alpha1Info(source.Info[i])
-		}
-	}
-	if source.RevisionHistoryLimit != nil {
-		xint64 := *source.RevisionHistoryLimit
-		v1alpha1ApplicationSpec.RevisionHistoryLimit = &xint64
-	}
-	v1alpha1ApplicationSpec.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSources(source.Sources)
-	v1alpha1ApplicationSpec.SourceHydrator = c.pV1alpha1SourceHydratorToPV1alpha1SourceHydrator(source.SourceHydrator)
-	return v1alpha1ApplicationSpec
-}
-func (c *ConverterImpl) v1alpha1ApplicationSpecToV1alpha1ApplicationSpec2(source v1alpha11.ApplicationSpec) v1alpha1.ApplicationSpec {
-	var v1alpha1ApplicationSpec v1alpha1.ApplicationSpec
-	v1alpha1ApplicationSpec.Source = c.pV1alpha1ApplicationSourceToPV1alpha1ApplicationSource2(source.Source)
-	v1alpha1ApplicationSpec.Destination = c.ToArgoDestination(source.Destination)
-	v1alpha1ApplicationSpec.Project = source.Project
-	v1alpha1ApplicationSpec.SyncPolicy = c.pV1alpha1SyncPolicyToPV1alpha1SyncPolicy2(source.SyncPolicy)
-	v1alpha1ApplicationSpec.IgnoreDifferences = c.v1alpha1ResourceIgnoreDifferencesListToV1alpha1IgnoreDifferences(source.IgnoreDifferences)
-	if source.Info != nil {
-		v1alpha1ApplicationSpec.Info = make([]v1alpha1.Info, len(source.Info))
-		for i := 0; i < len(source.Info); i++ {
-			v1alpha1ApplicationSpec.Info[i] = c.v1alpha1InfoToV1alpha1Info2(source.Info[i])
-		}
-	}
-	if source.RevisionHistoryLimit != nil {
-		xint64 := *source.RevisionHistoryLimit
-		v1alpha1ApplicationSpec.RevisionHistoryLimit = &xint64
-	}
-	v1alpha1ApplicationSpec.Sources = c.v1alpha1ApplicationSourcesToV1alpha1ApplicationSources2(source.Sources)
-	v1alpha1ApplicationSpec.SourceHydrator = c.pV1alpha1SourceHydratorToPV1alpha1SourceHydrator2(source.SourceHydrator)
-	return v1alpha1ApplicationSpec
+	return v1alpha1ApplicationSourceList
 }
 func (c *ConverterImpl) v1alpha1DrySourceToV1alpha1DrySource(source v1alpha1.DrySource) v1alpha11.DrySource {
 	var v1alpha1DrySource v1alpha11.DrySource
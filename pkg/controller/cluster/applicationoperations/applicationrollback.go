@@ -0,0 +1,265 @@
+package applicationoperations
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applications"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotApplicationRollback = "managed resource is not a Argocd application rollback custom resource"
+	errRollbackFailed         = "cannot roll back Argocd application"
+	errApplicationNotFound    = "application does not exist"
+	errNoPreviousDeployment   = "application has no previous deployment to roll back to"
+	errFmtInvalidHistoryID    = "invalid history ID %q"
+	errFmtHistoryIDNotFound   = "application has no history entry with ID %d"
+
+	historyPrevious = "previous"
+)
+
+// SetupApplicationRollback adds a controller that reconciles application rollbacks.
+func SetupApplicationRollback(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ApplicationRollbackKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&rollbackConnector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: applications.NewApplicationServiceClient,
+		}),
+		// The external name is only known once the rollback was triggered.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.ApplicationRollbackList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ApplicationRollback{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ApplicationRollbackGroupVersionKind),
+			opts...))
+}
+
+type rollbackConnector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, applications.ServiceClient, error)
+}
+
+func (c *rollbackConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationRollback)
+	if !ok {
+		return nil, errors.New(errNotApplicationRollback)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &rollbackExternal{client: argocdClient, conn: conn}, nil
+}
+
+type rollbackExternal struct {
+	client applications.ServiceClient
+	conn   io.Closer
+}
+
+// Observe tracks the rollback operation that was requested by the managed
+// resource. ArgoCD does not record additional information for rollbacks, so
+// the operation is identified by the revision it rolls back to and by having
+// started after the managed resource was created.
+func (e *rollbackExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationRollback)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApplicationRollback)
+	}
+	if cr.Spec.ForProvider.Application == nil {
+		return managed.ExternalObservation{}, errors.New(errApplicationNotResolved)
+	}
+
+	app, err := getApplication(ctx, e.client, rollbackTarget(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if app == nil || meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errFmtInvalidHistoryID, meta.GetExternalName(cr))
+	}
+	cr.Status.AtProvider.HistoryID = ptr.To(id)
+
+	var requested bool
+	var state *argocdv1alpha1.OperationState
+	if entry := findHistoryEntry(app.Status.History, id); entry != nil {
+		cr.Status.AtProvider.Revision = clients.StringToPtr(entry.Revision)
+		requested, state = findOperation(app, isRollbackTo(entry))
+		if state != nil && state.StartedAt.Before(&cr.CreationTimestamp) {
+			state = nil
+		}
+	}
+
+	if meta.WasDeleted(cr) {
+		// Only a requested operation is left to be terminated.
+		return managed.ExternalObservation{
+			ResourceExists: requested,
+		}, nil
+	}
+	if !requested && state == nil {
+		// The operation state was replaced by a newer operation, the last
+		// observed state is kept.
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	if state != nil {
+		cr.Status.AtProvider.OperationState = generateOperationState(state)
+	}
+	cr.Status.SetConditions(getOperationCondition(state))
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// Create validates the history ID against the application history and
+// triggers the rollback.
+func (e *rollbackExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationRollback)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApplicationRollback)
+	}
+
+	app, err := getApplication(ctx, e.client, rollbackTarget(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if app == nil {
+		return managed.ExternalCreation{}, errors.New(errApplicationNotFound)
+	}
+
+	entry, err := resolveHistoryEntry(app.Status.History, cr.Spec.ForProvider.HistoryID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	_, err = e.client.Rollback(ctx, &application.ApplicationRollbackRequest{
+		Name:         cr.Spec.ForProvider.Application,
+		Id:           ptr.To(entry.ID),
+		DryRun:       cr.Spec.ForProvider.DryRun,
+		Prune:        cr.Spec.ForProvider.Prune,
+		AppNamespace: cr.Spec.ForProvider.AppNamespace,
+		Project:      cr.Spec.ForProvider.Project,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRollbackFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.FormatInt(entry.ID, 10))
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is a no-op, a rollback is only triggered once.
+func (e *rollbackExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *rollbackExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationRollback)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotApplicationRollback)
+	}
+
+	return managed.ExternalDelete{}, terminateOperation(ctx, e.client, rollbackTarget(&cr.Spec.ForProvider))
+}
+
+func (e *rollbackExternal) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+func rollbackTarget(p *v1alpha1.ApplicationRollbackParameters) applicationTarget {
+	return applicationTarget{
+		name:         clients.StringValue(p.Application),
+		appNamespace: p.AppNamespace,
+		project:      p.Project,
+	}
+}
+
+// resolveHistoryEntry returns the history entry for the given ID. The history
+// is ordered by deployment, so "previous" is the second to last entry.
+func resolveHistoryEntry(history argocdv1alpha1.RevisionHistories, id string) (*argocdv1alpha1.RevisionHistory, error) {
+	if id == historyPrevious {
+		if len(history) < 2 {
+			return nil, errors.New(errNoPreviousDeployment)
+		}
+		return &history[len(history)-2], nil
+	}
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtInvalidHistoryID, id)
+	}
+	if entry := findHistoryEntry(history, n); entry != nil {
+		return entry, nil
+	}
+	return nil, errors.Errorf(errFmtHistoryIDNotFound, n)
+}
+
+func findHistoryEntry(history argocdv1alpha1.RevisionHistories, id int64) *argocdv1alpha1.RevisionHistory {
+	for i := range history {
+		if history[i].ID == id {
+			return &history[i]
+		}
+	}
+	return nil
+}
+
+// isRollbackTo matches rollback operations to the given history entry. Unlike
+// a sync, a rollback always sets the source of the sync operation.
+func isRollbackTo(entry *argocdv1alpha1.RevisionHistory) func(*argocdv1alpha1.Operation) bool {
+	return func(op *argocdv1alpha1.Operation) bool {
+		return op.Sync != nil && op.Sync.Source != nil &&
+			op.Sync.Revision == entry.Revision &&
+			slices.Equal(op.Sync.Revisions, entry.Revisions)
+	}
+}
@@ -0,0 +1,442 @@
+package applicationoperations
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/applications"
+)
+
+var (
+	testCreated = metav1.NewTime(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+	testHistory = argocdv1alpha1.RevisionHistories{
+		{ID: 3, Revision: "a1b2c3"},
+		{ID: 4, Revision: "d4e5f6"},
+		{ID: 5, Revision: "0a9b8c"},
+	}
+)
+
+type applicationRollbackModifier func(*v1alpha1.ApplicationRollback)
+
+func applicationRollback(m ...applicationRollbackModifier) *v1alpha1.ApplicationRollback {
+	cr := &v1alpha1.ApplicationRollback{}
+	cr.SetCreationTimestamp(testCreated)
+	cr.Spec.ForProvider.Application = &testAppName
+	cr.Spec.ForProvider.HistoryID = historyPrevious
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withRollbackExternalName(v string) applicationRollbackModifier {
+	return func(r *v1alpha1.ApplicationRollback) { meta.SetExternalName(r, v) }
+}
+
+func withRollbackSpec(p v1alpha1.ApplicationRollbackParameters) applicationRollbackModifier {
+	return func(r *v1alpha1.ApplicationRollback) { r.Spec.ForProvider = p }
+}
+
+func withRollbackObservation(o v1alpha1.ApplicationRollbackObservation) applicationRollbackModifier {
+	return func(r *v1alpha1.ApplicationRollback) { r.Status.AtProvider = o }
+}
+
+func withRollbackConditions(c ...xpv1.Condition) applicationRollbackModifier {
+	return func(r *v1alpha1.ApplicationRollback) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRollbackDeletionTimestamp() applicationRollbackModifier {
+	return func(r *v1alpha1.ApplicationRollback) { r.SetDeletionTimestamp(&metav1.Time{Time: time.Now()}) }
+}
+
+func rollbackOperation(revision string) argocdv1alpha1.Operation {
+	return argocdv1alpha1.Operation{
+		Sync: &argocdv1alpha1.SyncOperation{
+			Revision: revision,
+			Source:   &argocdv1alpha1.ApplicationSource{TargetRevision: revision},
+		},
+	}
+}
+
+func rollbackState(phase synccommon.OperationPhase, message, revision string, startedAt metav1.Time) *argocdv1alpha1.OperationState {
+	return &argocdv1alpha1.OperationState{
+		Operation: rollbackOperation(revision),
+		Phase:     phase,
+		Message:   message,
+		StartedAt: startedAt,
+	}
+}
+
+func TestApplicationRollbackObserve(t *testing.T) {
+	type args struct {
+		client *mockclient.MockServiceClient
+		cr     *v1alpha1.ApplicationRollback
+	}
+	type want struct {
+		cr     *v1alpha1.ApplicationRollback
+		result managed.ExternalObservation
+		err    error
+	}
+
+	after := metav1.NewTime(testCreated.Add(time.Minute))
+	before := metav1.NewTime(testCreated.Add(-time.Hour))
+	observation := v1alpha1.ApplicationRollbackObservation{
+		HistoryID: ptr.To[int64](4),
+		Revision:  ptr.To("d4e5f6"),
+	}
+	withState := func(s *argocdv1alpha1.OperationState) v1alpha1.ApplicationRollbackObservation {
+		o := observation
+		o.OperationState = generateOperationState(s)
+		return o
+	}
+
+	succeeded := rollbackState(synccommon.OperationSucceeded, "successfully synced", "d4e5f6", after)
+	failed := rollbackState(synccommon.OperationFailed, "one or more objects failed to apply", "d4e5f6", after)
+	earlier := rollbackState(synccommon.OperationSucceeded, "successfully synced", "d4e5f6", before)
+	running := rollbackState(synccommon.OperationRunning, "", "d4e5f6", after)
+	_, errParse := strconv.ParseInt("latest", 10, 64)
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotTriggered": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{History: testHistory},
+				})),
+				cr: applicationRollback(),
+			},
+			want: want{
+				cr:     applicationRollback(),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Requested": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Operation: ptr.To(rollbackOperation("d4e5f6")),
+					Status:    argocdv1alpha1.ApplicationStatus{History: testHistory, OperationState: earlier},
+				})),
+				cr: applicationRollback(withRollbackExternalName("4")),
+			},
+			want: want{
+				cr: applicationRollback(
+					withRollbackExternalName("4"),
+					withRollbackObservation(observation),
+					withRollbackConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Running": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Operation: ptr.To(rollbackOperation("d4e5f6")),
+					Status:    argocdv1alpha1.ApplicationStatus{History: testHistory, OperationState: running},
+				})),
+				cr: applicationRollback(withRollbackExternalName("4")),
+			},
+			want: want{
+				cr: applicationRollback(
+					withRollbackExternalName("4"),
+					withRollbackObservation(withState(running)),
+					withRollbackConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Succeeded": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{History: testHistory, OperationState: succeeded},
+				})),
+				cr: applicationRollback(withRollbackExternalName("4")),
+			},
+			want: want{
+				cr: applicationRollback(
+					withRollbackExternalName("4"),
+					withRollbackObservation(withState(succeeded)),
+					withRollbackConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{History: testHistory, OperationState: failed},
+				})),
+				cr: applicationRollback(withRollbackExternalName("4")),
+			},
+			want: want{
+				cr: applicationRollback(
+					withRollbackExternalName("4"),
+					withRollbackObservation(withState(failed)),
+					withRollbackConditions(xpv1.Unavailable().WithMessage("one or more objects failed to apply")),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Superseded": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{
+						History:        testHistory,
+						OperationState: operationState(synccommon.OperationSucceeded, "", nil),
+					},
+				})),
+				cr: applicationRollback(
+					withRollbackExternalName("4"),
+					withRollbackObservation(withState(succeeded)),
+					withRollbackConditions(xpv1.Available()),
+				),
+			},
+			want: want{
+				cr: applicationRollback(
+					withRollbackExternalName("4"),
+					withRollbackObservation(withState(succeeded)),
+					withRollbackConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DeletedWhileRunning": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Operation: ptr.To(rollbackOperation("d4e5f6")),
+					Status:    argocdv1alpha1.ApplicationStatus{History: testHistory, OperationState: running},
+				})),
+				cr: applicationRollback(withRollbackExternalName("4"), withRollbackDeletionTimestamp()),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"DeletedAfterCompletion": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{History: testHistory, OperationState: succeeded},
+				})),
+				cr: applicationRollback(withRollbackExternalName("4"), withRollbackDeletionTimestamp()),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"InvalidExternalName": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{})),
+				cr:     applicationRollback(withRollbackExternalName("latest")),
+			},
+			want: want{
+				err: errors.Wrapf(errParse, errFmtInvalidHistoryID, "latest"),
+			},
+		},
+		"ApplicationNotFound": {
+			args: args{
+				client: withMockClient(t, expectList(nil)),
+				cr:     applicationRollback(withRollbackExternalName("4")),
+			},
+			want: want{
+				cr:     applicationRollback(withRollbackExternalName("4")),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ApplicationNotResolved": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {}),
+				cr:     applicationRollback(withRollbackSpec(v1alpha1.ApplicationRollbackParameters{})),
+			},
+			want: want{
+				cr:  applicationRollback(withRollbackSpec(v1alpha1.ApplicationRollbackParameters{})),
+				err: errors.New(errApplicationNotResolved),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &rollbackExternal{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.cr != nil {
+				if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplicationRollbackCreate(t *testing.T) {
+	type args struct {
+		client *mockclient.MockServiceClient
+		cr     *v1alpha1.ApplicationRollback
+	}
+	type want struct {
+		cr  *v1alpha1.ApplicationRollback
+		err error
+	}
+
+	params := func(id string) v1alpha1.ApplicationRollbackParameters {
+		return v1alpha1.ApplicationRollbackParameters{
+			Application: &testAppName,
+			HistoryID:   id,
+			Prune:       ptr.To(true),
+		}
+	}
+	expectRollback := func(id int64, err error) mockModifier {
+		return func(mcs *mockclient.MockServiceClient) {
+			expectList(&argocdv1alpha1.Application{
+				Status: argocdv1alpha1.ApplicationStatus{History: testHistory},
+			})(mcs)
+			mcs.EXPECT().Rollback(context.Background(), &application.ApplicationRollbackRequest{
+				Name:  &testAppName,
+				Id:    ptr.To(id),
+				Prune: ptr.To(true),
+			}).Return(&argocdv1alpha1.Application{}, err)
+		}
+	}
+	_, errParse := strconv.ParseInt("latest", 10, 64)
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Previous": {
+			args: args{
+				client: withMockClient(t, expectRollback(4, nil)),
+				cr:     applicationRollback(withRollbackSpec(params(historyPrevious))),
+			},
+			want: want{
+				cr: applicationRollback(withRollbackSpec(params(historyPrevious)), withRollbackExternalName("4")),
+			},
+		},
+		"HistoryID": {
+			args: args{
+				client: withMockClient(t, expectRollback(3, nil)),
+				cr:     applicationRollback(withRollbackSpec(params("3"))),
+			},
+			want: want{
+				cr: applicationRollback(withRollbackSpec(params("3")), withRollbackExternalName("3")),
+			},
+		},
+		"HistoryIDNotFound": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{History: testHistory},
+				})),
+				cr: applicationRollback(withRollbackSpec(params("1"))),
+			},
+			want: want{
+				cr:  applicationRollback(withRollbackSpec(params("1"))),
+				err: errors.Errorf(errFmtHistoryIDNotFound, 1),
+			},
+		},
+		"InvalidHistoryID": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{History: testHistory},
+				})),
+				cr: applicationRollback(withRollbackSpec(params("latest"))),
+			},
+			want: want{
+				cr:  applicationRollback(withRollbackSpec(params("latest"))),
+				err: errors.Wrapf(errParse, errFmtInvalidHistoryID, "latest"),
+			},
+		},
+		"NoPreviousDeployment": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{History: testHistory[2:]},
+				})),
+				cr: applicationRollback(withRollbackSpec(params(historyPrevious))),
+			},
+			want: want{
+				cr:  applicationRollback(withRollbackSpec(params(historyPrevious))),
+				err: errors.New(errNoPreviousDeployment),
+			},
+		},
+		"ApplicationNotFound": {
+			args: args{
+				client: withMockClient(t, expectList(nil)),
+				cr:     applicationRollback(withRollbackSpec(params(historyPrevious))),
+			},
+			want: want{
+				cr:  applicationRollback(withRollbackSpec(params(historyPrevious))),
+				err: errors.New(errApplicationNotFound),
+			},
+		},
+		"RollbackFailed": {
+			args: args{
+				client: withMockClient(t, expectRollback(4, errBoom)),
+				cr:     applicationRollback(withRollbackSpec(params(historyPrevious))),
+			},
+			want: want{
+				cr:  applicationRollback(withRollbackSpec(params(historyPrevious))),
+				err: errors.Wrap(errBoom, errRollbackFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &rollbackExternal{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplicationRollbackReconcile(t *testing.T) {
+	client := withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+		mcs.EXPECT().List(gomock.Any(), &application.ApplicationQuery{Name: &testAppName}).
+			Return(&argocdv1alpha1.ApplicationList{Items: []argocdv1alpha1.Application{{
+				Status: argocdv1alpha1.ApplicationStatus{History: testHistory},
+			}}}, nil).Times(2)
+		mcs.EXPECT().Rollback(gomock.Any(), &application.ApplicationRollbackRequest{
+			Name: &testAppName,
+			Id:   ptr.To[int64](4),
+		}).Return(&argocdv1alpha1.Application{}, nil)
+	})
+	cr := applicationRollback()
+	cr.SetName("rollback")
+
+	// The rollback is triggered on the first reconciliation, and the history
+	// ID it rolled back to becomes the external name.
+	got := reconcileOnce(t, v1alpha1.ApplicationRollbackGroupVersionKind, cr,
+		&rollbackExternal{client: client, conn: io.NopCloser}, managed.WithInitializers())
+	if diff := cmp.Diff("4", meta.GetExternalName(got)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
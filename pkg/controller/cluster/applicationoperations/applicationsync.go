@@ -0,0 +1,216 @@
+package applicationoperations
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applications"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotApplicationSync = "managed resource is not a Argocd application sync custom resource"
+	errSyncFailed         = "cannot sync Argocd application"
+)
+
+// SetupApplicationSync adds a controller that reconciles application syncs.
+func SetupApplicationSync(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ApplicationSyncKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&syncConnector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: applications.NewApplicationServiceClient,
+		}),
+		// The external name is only known once the sync was triggered.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.ApplicationSyncList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ApplicationSync{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ApplicationSyncGroupVersionKind),
+			opts...))
+}
+
+type syncConnector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, applications.ServiceClient, error)
+}
+
+func (c *syncConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationSync)
+	if !ok {
+		return nil, errors.New(errNotApplicationSync)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &syncExternal{client: argocdClient, conn: conn}, nil
+}
+
+type syncExternal struct {
+	client applications.ServiceClient
+	conn   io.Closer
+}
+
+// Observe tracks the sync operation that was requested by the managed
+// resource. The operation is identified by an info item holding the UID of
+// the managed resource.
+func (e *syncExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationSync)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApplicationSync)
+	}
+	if cr.Spec.ForProvider.Application == nil {
+		return managed.ExternalObservation{}, errors.New(errApplicationNotResolved)
+	}
+
+	app, err := getApplication(ctx, e.client, syncTarget(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if app == nil {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	requested, state := findOperation(app, withOperationID(string(cr.GetUID())))
+	if meta.WasDeleted(cr) {
+		// Only a requested operation is left to be terminated.
+		return managed.ExternalObservation{
+			ResourceExists: requested,
+		}, nil
+	}
+	if !requested && state == nil {
+		if meta.GetExternalName(cr) == "" {
+			return managed.ExternalObservation{
+				ResourceExists: false,
+			}, nil
+		}
+		// The operation state was replaced by a newer operation, the last
+		// observed state is kept.
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	if state != nil {
+		cr.Status.AtProvider.OperationState = generateOperationState(state)
+	}
+	cr.Status.SetConditions(getOperationCondition(state))
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *syncExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationSync)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApplicationSync)
+	}
+
+	_, err := e.client.Sync(ctx, generateSyncRequest(&cr.Spec.ForProvider, string(cr.GetUID())))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errSyncFailed)
+	}
+
+	meta.SetExternalName(cr, clients.StringValue(cr.Spec.ForProvider.Application))
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is a no-op, a sync is only triggered once.
+func (e *syncExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *syncExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationSync)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotApplicationSync)
+	}
+
+	return managed.ExternalDelete{}, terminateOperation(ctx, e.client, syncTarget(&cr.Spec.ForProvider))
+}
+
+func (e *syncExternal) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+func syncTarget(p *v1alpha1.ApplicationSyncParameters) applicationTarget {
+	return applicationTarget{
+		name:         clients.StringValue(p.Application),
+		appNamespace: p.AppNamespace,
+		project:      p.Project,
+	}
+}
+
+func generateSyncRequest(p *v1alpha1.ApplicationSyncParameters, id string) *application.ApplicationSyncRequest {
+	req := &application.ApplicationSyncRequest{
+		Name:         p.Application,
+		Revision:     p.Revision,
+		DryRun:       p.DryRun,
+		Prune:        p.Prune,
+		AppNamespace: p.AppNamespace,
+		Project:      p.Project,
+		Infos:        []*argocdv1alpha1.Info{operationInfo(id)},
+	}
+	if clients.BoolValue(p.Force) {
+		req.Strategy = &argocdv1alpha1.SyncStrategy{
+			Hook: &argocdv1alpha1.SyncStrategyHook{
+				SyncStrategyApply: argocdv1alpha1.SyncStrategyApply{Force: true},
+			},
+		}
+	}
+	for _, r := range p.Resources {
+		req.Resources = append(req.Resources, &argocdv1alpha1.SyncOperationResource{
+			Group:     clients.StringValue(r.Group),
+			Kind:      r.Kind,
+			Name:      r.Name,
+			Namespace: clients.StringValue(r.Namespace),
+		})
+	}
+	if len(p.SyncOptions) > 0 {
+		req.SyncOptions = &application.SyncOptions{Items: p.SyncOptions}
+	}
+	return req
+}
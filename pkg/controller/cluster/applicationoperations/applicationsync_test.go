@@ -0,0 +1,473 @@
+package applicationoperations
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/applications"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	testAppName = "example-app"
+	testUID     = types.UID("2f1b7c0e-8d2e-4bb7-9d7a-1f6c2e0a5b3d")
+	testOpInfo  = []*argocdv1alpha1.Info{{Name: operationIDInfo, Value: string(testUID)}}
+)
+
+type mockModifier func(*mockclient.MockServiceClient)
+
+func withMockClient(t *testing.T, mod mockModifier) *mockclient.MockServiceClient {
+	ctrl := gomock.NewController(t)
+	mock := mockclient.NewMockServiceClient(ctrl)
+	mod(mock)
+	return mock
+}
+
+func expectList(app *argocdv1alpha1.Application) mockModifier {
+	return func(mcs *mockclient.MockServiceClient) {
+		list := &argocdv1alpha1.ApplicationList{}
+		if app != nil {
+			list.Items = []argocdv1alpha1.Application{*app}
+		}
+		mcs.EXPECT().List(
+			context.Background(),
+			&application.ApplicationQuery{Name: &testAppName},
+		).Return(list, nil)
+	}
+}
+
+type applicationSyncModifier func(*v1alpha1.ApplicationSync)
+
+func applicationSync(m ...applicationSyncModifier) *v1alpha1.ApplicationSync {
+	cr := &v1alpha1.ApplicationSync{}
+	cr.SetUID(testUID)
+	cr.Spec.ForProvider.Application = &testAppName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withSyncExternalName(v string) applicationSyncModifier {
+	return func(r *v1alpha1.ApplicationSync) { meta.SetExternalName(r, v) }
+}
+
+func withSyncSpec(p v1alpha1.ApplicationSyncParameters) applicationSyncModifier {
+	return func(r *v1alpha1.ApplicationSync) { r.Spec.ForProvider = p }
+}
+
+func withSyncOperationState(s *v1alpha1.OperationState) applicationSyncModifier {
+	return func(r *v1alpha1.ApplicationSync) { r.Status.AtProvider.OperationState = s }
+}
+
+func withSyncConditions(c ...xpv1.Condition) applicationSyncModifier {
+	return func(r *v1alpha1.ApplicationSync) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSyncDeletionTimestamp() applicationSyncModifier {
+	return func(r *v1alpha1.ApplicationSync) { r.SetDeletionTimestamp(&metav1.Time{Time: time.Now()}) }
+}
+
+func operationState(phase synccommon.OperationPhase, message string, infos []*argocdv1alpha1.Info) *argocdv1alpha1.OperationState {
+	return &argocdv1alpha1.OperationState{
+		Operation: argocdv1alpha1.Operation{
+			Sync: &argocdv1alpha1.SyncOperation{},
+			Info: infos,
+		},
+		Phase:   phase,
+		Message: message,
+	}
+}
+
+func TestApplicationSyncObserve(t *testing.T) {
+	type args struct {
+		client *mockclient.MockServiceClient
+		cr     *v1alpha1.ApplicationSync
+	}
+	type want struct {
+		cr     *v1alpha1.ApplicationSync
+		result managed.ExternalObservation
+		err    error
+	}
+
+	succeeded := operationState(synccommon.OperationSucceeded, "successfully synced", testOpInfo)
+	failed := operationState(synccommon.OperationFailed, "one or more objects failed to apply", testOpInfo)
+	running := operationState(synccommon.OperationRunning, "", testOpInfo)
+	foreign := operationState(synccommon.OperationSucceeded, "", nil)
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotTriggered": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{OperationState: foreign},
+				})),
+				cr: applicationSync(),
+			},
+			want: want{
+				cr:     applicationSync(),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Requested": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Operation: &argocdv1alpha1.Operation{Info: testOpInfo},
+					Status:    argocdv1alpha1.ApplicationStatus{OperationState: foreign},
+				})),
+				cr: applicationSync(withSyncExternalName(testAppName)),
+			},
+			want: want{
+				cr: applicationSync(
+					withSyncExternalName(testAppName),
+					withSyncConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Running": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Operation: &argocdv1alpha1.Operation{Info: testOpInfo},
+					Status:    argocdv1alpha1.ApplicationStatus{OperationState: running},
+				})),
+				cr: applicationSync(withSyncExternalName(testAppName)),
+			},
+			want: want{
+				cr: applicationSync(
+					withSyncExternalName(testAppName),
+					withSyncOperationState(generateOperationState(running)),
+					withSyncConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Succeeded": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{OperationState: succeeded},
+				})),
+				cr: applicationSync(withSyncExternalName(testAppName)),
+			},
+			want: want{
+				cr: applicationSync(
+					withSyncExternalName(testAppName),
+					withSyncOperationState(generateOperationState(succeeded)),
+					withSyncConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{OperationState: failed},
+				})),
+				cr: applicationSync(withSyncExternalName(testAppName)),
+			},
+			want: want{
+				cr: applicationSync(
+					withSyncExternalName(testAppName),
+					withSyncOperationState(generateOperationState(failed)),
+					withSyncConditions(xpv1.Unavailable().WithMessage("one or more objects failed to apply")),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Superseded": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{OperationState: foreign},
+				})),
+				cr: applicationSync(
+					withSyncExternalName(testAppName),
+					withSyncOperationState(generateOperationState(succeeded)),
+					withSyncConditions(xpv1.Available()),
+				),
+			},
+			want: want{
+				cr: applicationSync(
+					withSyncExternalName(testAppName),
+					withSyncOperationState(generateOperationState(succeeded)),
+					withSyncConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DeletedWhileRunning": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Operation: &argocdv1alpha1.Operation{Info: testOpInfo},
+					Status:    argocdv1alpha1.ApplicationStatus{OperationState: running},
+				})),
+				cr: applicationSync(withSyncExternalName(testAppName), withSyncDeletionTimestamp()),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"DeletedAfterCompletion": {
+			args: args{
+				client: withMockClient(t, expectList(&argocdv1alpha1.Application{
+					Status: argocdv1alpha1.ApplicationStatus{OperationState: succeeded},
+				})),
+				cr: applicationSync(withSyncExternalName(testAppName), withSyncDeletionTimestamp()),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ApplicationNotFound": {
+			args: args{
+				client: withMockClient(t, expectList(nil)),
+				cr:     applicationSync(),
+			},
+			want: want{
+				cr:     applicationSync(),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ApplicationNotResolved": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {}),
+				cr:     applicationSync(withSyncSpec(v1alpha1.ApplicationSyncParameters{})),
+			},
+			want: want{
+				cr:  applicationSync(withSyncSpec(v1alpha1.ApplicationSyncParameters{})),
+				err: errors.New(errApplicationNotResolved),
+			},
+		},
+		"ListFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errBoom)
+				}),
+				cr: applicationSync(),
+			},
+			want: want{
+				cr:  applicationSync(),
+				err: errors.Wrap(errBoom, errListFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &syncExternal{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.cr != nil {
+				if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplicationSyncCreate(t *testing.T) {
+	type args struct {
+		client *mockclient.MockServiceClient
+		cr     *v1alpha1.ApplicationSync
+	}
+	type want struct {
+		cr  *v1alpha1.ApplicationSync
+		err error
+	}
+
+	params := v1alpha1.ApplicationSyncParameters{
+		Application: &testAppName,
+		Revision:    ptr.To("v1.2.0"),
+		Prune:       ptr.To(true),
+		Force:       ptr.To(true),
+		Resources: []v1alpha1.SyncOperationResource{
+			{Group: ptr.To("apps"), Kind: "Deployment", Name: "web", Namespace: ptr.To("default")},
+		},
+		SyncOptions: v1alpha1.SyncOptions{"ServerSideApply=true"},
+	}
+	request := &application.ApplicationSyncRequest{
+		Name:     &testAppName,
+		Revision: ptr.To("v1.2.0"),
+		Prune:    ptr.To(true),
+		Strategy: &argocdv1alpha1.SyncStrategy{
+			Hook: &argocdv1alpha1.SyncStrategyHook{
+				SyncStrategyApply: argocdv1alpha1.SyncStrategyApply{Force: true},
+			},
+		},
+		Resources: []*argocdv1alpha1.SyncOperationResource{
+			{Group: "apps", Kind: "Deployment", Name: "web", Namespace: "default"},
+		},
+		SyncOptions: &application.SyncOptions{Items: []string{"ServerSideApply=true"}},
+		Infos:       testOpInfo,
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Sync(context.Background(), request).Return(&argocdv1alpha1.Application{}, nil)
+				}),
+				cr: applicationSync(withSyncSpec(params)),
+			},
+			want: want{
+				cr: applicationSync(withSyncSpec(params), withSyncExternalName(testAppName)),
+			},
+		},
+		"SyncFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Sync(context.Background(), request).Return(nil, errBoom)
+				}),
+				cr: applicationSync(withSyncSpec(params)),
+			},
+			want: want{
+				cr:  applicationSync(withSyncSpec(params)),
+				err: errors.Wrap(errBoom, errSyncFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &syncExternal{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplicationSyncDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful": {},
+		"AlreadyCompleted": {
+			err: errors.New("rpc error: code = InvalidArgument desc = Unable to terminate operation. No operation is in progress"),
+		},
+		"TerminateFailed": {
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errTerminateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().TerminateOperation(
+					context.Background(),
+					&application.OperationTerminateRequest{Name: &testAppName},
+				).Return(&application.OperationTerminateResponse{}, tc.err)
+			})
+
+			e := &syncExternal{client: client}
+			_, err := e.Delete(context.Background(), applicationSync(withSyncExternalName(testAppName)))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// reconcileOnce runs a single reconciliation of the managed resource through
+// the managed reconciler, using the given external client. It returns the
+// managed resource as last written by the reconciler. Like the API server,
+// an update of the managed resource reverts changes to its status.
+func reconcileOnce(t *testing.T, gvk schema.GroupVersionKind, cr resource.Managed, ext managed.ExternalClient, o ...managed.ReconcilerOption) resource.Managed {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	got := cr.DeepCopyObject().(resource.Managed)
+	store := func(obj client.Object) error {
+		got = obj.DeepCopyObject().(resource.Managed)
+		return nil
+	}
+	update := func(obj client.Object) error {
+		status := reflect.ValueOf(got.DeepCopyObject()).Elem().FieldByName("Status")
+		reflect.ValueOf(obj).Elem().FieldByName("Status").Set(status)
+		return store(obj)
+	}
+	kube := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(got.DeepCopyObject()).Elem())
+			return nil
+		}),
+		MockUpdate:       test.NewMockUpdateFn(nil, update),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, store),
+	}
+	connector := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return ext, nil
+	})
+
+	r := managed.NewReconciler(&fake.Manager{Client: kube, Scheme: s}, resource.ManagedKind(gvk),
+		append([]managed.ReconcilerOption{managed.WithExternalConnector(connector)}, o...)...)
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: cr.GetName()}}); err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestApplicationSyncReconcile(t *testing.T) {
+	client := withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+		mcs.EXPECT().List(gomock.Any(), &application.ApplicationQuery{Name: &testAppName}).
+			Return(&argocdv1alpha1.ApplicationList{Items: []argocdv1alpha1.Application{{}}}, nil)
+		mcs.EXPECT().Sync(gomock.Any(), &application.ApplicationSyncRequest{
+			Name:  &testAppName,
+			Infos: testOpInfo,
+		}).Return(&argocdv1alpha1.Application{}, nil)
+	})
+	cr := applicationSync()
+	cr.SetName("sync")
+
+	// The sync is triggered on the first reconciliation, although the name of
+	// the managed resource differs from the application.
+	got := reconcileOnce(t, v1alpha1.ApplicationSyncGroupVersionKind, cr,
+		&syncExternal{client: client, conn: io.NopCloser}, managed.WithInitializers())
+	if diff := cmp.Diff(testAppName, meta.GetExternalName(got)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,117 @@
+package applicationoperations
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	applicationsconverter "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster/converter/applications"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applications"
+)
+
+const (
+	errListFailed             = "cannot list Argocd application"
+	errApplicationNotResolved = "application is not set"
+	errTerminateFailed        = "cannot terminate Argocd application operation"
+
+	// operationIDInfo is the name of the operation info item that holds the
+	// UID of the managed resource that requested the operation.
+	operationIDInfo = "crossplane-operation-id"
+)
+
+// applicationTarget identifies the application an operation is performed on.
+type applicationTarget struct {
+	name         string
+	appNamespace *string
+	project      *string
+}
+
+// getApplication returns the application or nil if it does not exist.
+func getApplication(ctx context.Context, client applications.ServiceClient, t applicationTarget) (*argocdv1alpha1.Application, error) {
+	query := application.ApplicationQuery{
+		Name:         &t.name,
+		AppNamespace: t.appNamespace,
+	}
+	if t.project != nil {
+		query.Projects = []string{*t.project}
+	}
+
+	// we have to use List() because Get() returns permission error
+	apps, err := client.List(ctx, &query)
+	if err != nil {
+		return nil, errors.Wrap(err, errListFailed)
+	}
+	if len(apps.Items) == 0 {
+		return nil, nil
+	}
+	return &apps.Items[0], nil
+}
+
+// terminateOperation terminates the running operation of the application.
+// An operation that completed in the meantime is not an error.
+func terminateOperation(ctx context.Context, client applications.ServiceClient, t applicationTarget) error {
+	_, err := client.TerminateOperation(ctx, &application.OperationTerminateRequest{
+		Name:         &t.name,
+		AppNamespace: t.appNamespace,
+		Project:      t.project,
+	})
+	if applications.IsErrorNoOperationInProgress(err) {
+		return nil
+	}
+	return errors.Wrap(err, errTerminateFailed)
+}
+
+// operationInfo returns the info item that marks an operation as requested by
+// the managed resource with the given UID.
+func operationInfo(id string) *argocdv1alpha1.Info {
+	return &argocdv1alpha1.Info{Name: operationIDInfo, Value: id}
+}
+
+// withOperationID matches operations that carry the info item of the
+// managed resource with the given UID.
+func withOperationID(id string) func(*argocdv1alpha1.Operation) bool {
+	return func(op *argocdv1alpha1.Operation) bool {
+		for _, i := range op.Info {
+			if i != nil && i.Name == operationIDInfo && i.Value == id {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// findOperation reports whether a matching operation is still requested on
+// the application and returns its state if ArgoCD started it.
+func findOperation(app *argocdv1alpha1.Application, match func(*argocdv1alpha1.Operation) bool) (bool, *argocdv1alpha1.OperationState) {
+	requested := app.Operation != nil && match(app.Operation)
+	state := app.Status.OperationState
+	if state == nil || !match(&state.Operation) {
+		return requested, nil
+	}
+	return requested, state
+}
+
+func generateOperationState(state *argocdv1alpha1.OperationState) *v1alpha1.OperationState {
+	if state == nil {
+		return nil
+	}
+	converter := &applicationsconverter.ConverterImpl{}
+	return converter.FromArgoApplicationStatus(&argocdv1alpha1.ApplicationStatus{OperationState: state}).OperationState
+}
+
+// getOperationCondition returns Available for a succeeded operation and
+// Creating while the operation has not completed yet.
+func getOperationCondition(state *argocdv1alpha1.OperationState) xpv1.Condition {
+	switch {
+	case state == nil || !state.Phase.Completed():
+		return xpv1.Creating()
+	case state.Phase.Successful():
+		return xpv1.Available()
+	default:
+		return xpv1.Unavailable().WithMessage(state.Message)
+	}
+}
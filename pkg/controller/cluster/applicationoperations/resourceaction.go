@@ -0,0 +1,187 @@
+package applicationoperations
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applications"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotResourceAction = "managed resource is not a Argocd resource action custom resource"
+	errRunActionFailed   = "cannot run Argocd resource action"
+
+	resultSucceeded = "Succeeded"
+	resultFailed    = "Failed"
+)
+
+// SetupResourceAction adds a controller that reconciles resource actions.
+func SetupResourceAction(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ResourceActionKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&actionConnector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: applications.NewApplicationServiceClient,
+		}),
+		// ArgoCD keeps no record of actions, there is no external name.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.ResourceActionList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ResourceAction{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ResourceActionGroupVersionKind),
+			opts...))
+}
+
+type actionConnector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, applications.ServiceClient, error)
+}
+
+func (c *actionConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ResourceAction)
+	if !ok {
+		return nil, errors.New(errNotResourceAction)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &actionExternal{client: argocdClient, conn: conn}, nil
+}
+
+type actionExternal struct {
+	client applications.ServiceClient
+	conn   io.Closer
+}
+
+// Observe reports the action as existing once the managed resource was
+// created and as up to date once the action was run successfully for the
+// current trigger. ArgoCD keeps no record of actions that were run, so only
+// the managed resource is inspected.
+func (e *actionExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ResourceAction)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotResourceAction)
+	}
+	if cr.Spec.ForProvider.Application == nil {
+		return managed.ExternalObservation{}, errors.New(errApplicationNotResolved)
+	}
+
+	if meta.GetExternalCreateSucceeded(cr).IsZero() || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	o := &cr.Status.AtProvider
+	switch clients.StringValue(o.Result) {
+	case resultSucceeded:
+		cr.Status.SetConditions(xpv1.Available())
+	case resultFailed:
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(clients.StringValue(o.Message)))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: clients.StringValue(o.Result) == resultSucceeded &&
+			clients.StringValue(cr.Spec.ForProvider.Trigger) == clients.StringValue(o.LastTrigger),
+	}, nil
+}
+
+// Create does not run the action, as status changes made during Create are
+// not persisted. The action is run by the Update that follows.
+func (e *actionExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if _, ok := mg.(*v1alpha1.ResourceAction); !ok {
+		return managed.ExternalCreation{}, errors.New(errNotResourceAction)
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+// Update runs the action until it succeeded for the current trigger.
+func (e *actionExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ResourceAction)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotResourceAction)
+	}
+
+	return managed.ExternalUpdate{}, e.runAction(ctx, cr)
+}
+
+// Delete is a no-op, an action that was run cannot be undone.
+func (e *actionExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *actionExternal) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+// runAction runs the action and records the result in the status.
+func (e *actionExternal) runAction(ctx context.Context, cr *v1alpha1.ResourceAction) error {
+	_, err := e.client.RunResourceAction(ctx, generateResourceActionRunRequest(&cr.Spec.ForProvider))
+
+	o := &cr.Status.AtProvider
+	o.LastRunTime = ptr.To(metav1.Now())
+	if err != nil {
+		o.Result = ptr.To(resultFailed)
+		o.Message = ptr.To(err.Error())
+		return errors.Wrap(err, errRunActionFailed)
+	}
+	o.LastTrigger = clients.StringToPtr(clients.StringValue(cr.Spec.ForProvider.Trigger))
+	o.Result = ptr.To(resultSucceeded)
+	o.Message = nil
+	return nil
+}
+
+func generateResourceActionRunRequest(p *v1alpha1.ResourceActionParameters) *application.ResourceActionRunRequest {
+	return &application.ResourceActionRunRequest{
+		Name:         p.Application,
+		AppNamespace: p.AppNamespace,
+		Project:      p.Project,
+		Group:        p.Group,
+		Version:      ptr.To(p.Version),
+		Kind:         ptr.To(p.Kind),
+		Namespace:    p.Namespace,
+		ResourceName: ptr.To(p.Name),
+		Action:       ptr.To(p.Action),
+	}
+}
@@ -0,0 +1,313 @@
+package applicationoperations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/applications"
+)
+
+var (
+	testActionCreated = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	testActionParams  = v1alpha1.ResourceActionParameters{
+		Application: &testAppName,
+		Group:       ptr.To("apps"),
+		Version:     "v1",
+		Kind:        "Deployment",
+		Namespace:   ptr.To("default"),
+		Name:        "web",
+		Action:      "restart",
+		Trigger:     ptr.To("1"),
+	}
+	testActionRequest = &application.ResourceActionRunRequest{
+		Name:         &testAppName,
+		Group:        ptr.To("apps"),
+		Version:      ptr.To("v1"),
+		Kind:         ptr.To("Deployment"),
+		Namespace:    ptr.To("default"),
+		ResourceName: ptr.To("web"),
+		Action:       ptr.To("restart"),
+	}
+)
+
+type resourceActionModifier func(*v1alpha1.ResourceAction)
+
+func resourceAction(m ...resourceActionModifier) *v1alpha1.ResourceAction {
+	cr := &v1alpha1.ResourceAction{}
+	cr.Spec.ForProvider = *testActionParams.DeepCopy()
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withActionCreated() resourceActionModifier {
+	return func(r *v1alpha1.ResourceAction) { meta.SetExternalCreateSucceeded(r, testActionCreated) }
+}
+
+func withActionTrigger(trigger string) resourceActionModifier {
+	return func(r *v1alpha1.ResourceAction) { r.Spec.ForProvider.Trigger = clients.StringToPtr(trigger) }
+}
+
+func withActionObservation(o v1alpha1.ResourceActionObservation) resourceActionModifier {
+	return func(r *v1alpha1.ResourceAction) { r.Status.AtProvider = o }
+}
+
+func withActionConditions(c ...xpv1.Condition) resourceActionModifier {
+	return func(r *v1alpha1.ResourceAction) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withActionDeletionTimestamp() resourceActionModifier {
+	return func(r *v1alpha1.ResourceAction) { r.SetDeletionTimestamp(&metav1.Time{Time: time.Now()}) }
+}
+
+func TestResourceActionObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResourceAction
+		result managed.ExternalObservation
+		err    error
+	}
+
+	firstRun := v1alpha1.ResourceActionObservation{
+		LastTrigger: ptr.To("1"),
+		LastRunTime: ptr.To(metav1.NewTime(testActionCreated)),
+		Result:      ptr.To(resultSucceeded),
+	}
+	laterRun := v1alpha1.ResourceActionObservation{
+		LastTrigger: ptr.To("2"),
+		LastRunTime: ptr.To(metav1.NewTime(testActionCreated.Add(time.Hour))),
+		Result:      ptr.To(resultSucceeded),
+	}
+	failedRun := v1alpha1.ResourceActionObservation{
+		LastTrigger: ptr.To("1"),
+		LastRunTime: ptr.To(metav1.NewTime(testActionCreated.Add(time.Hour))),
+		Result:      ptr.To(resultFailed),
+		Message:     ptr.To("boom"),
+	}
+
+	cases := map[string]struct {
+		cr *v1alpha1.ResourceAction
+		want
+	}{
+		"NotCreated": {
+			cr: resourceAction(),
+			want: want{
+				cr:     resourceAction(),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotRun": {
+			cr: resourceAction(withActionCreated()),
+			want: want{
+				cr:     resourceAction(withActionCreated()),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"FirstRun": {
+			cr: resourceAction(withActionCreated(), withActionObservation(firstRun)),
+			want: want{
+				cr: resourceAction(
+					withActionCreated(),
+					withActionObservation(firstRun),
+					withActionConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FirstRunFailed": {
+			cr: resourceAction(withActionCreated(), withActionObservation(v1alpha1.ResourceActionObservation{
+				LastRunTime: ptr.To(metav1.NewTime(testActionCreated)),
+				Result:      ptr.To(resultFailed),
+				Message:     ptr.To("boom"),
+			})),
+			want: want{
+				cr: resourceAction(
+					withActionCreated(),
+					withActionObservation(v1alpha1.ResourceActionObservation{
+						LastRunTime: ptr.To(metav1.NewTime(testActionCreated)),
+						Result:      ptr.To(resultFailed),
+						Message:     ptr.To("boom"),
+					}),
+					withActionConditions(xpv1.Unavailable().WithMessage("boom")),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"TriggerChanged": {
+			cr: resourceAction(withActionCreated(), withActionTrigger("2"), withActionObservation(firstRun)),
+			want: want{
+				cr: resourceAction(
+					withActionCreated(),
+					withActionTrigger("2"),
+					withActionObservation(firstRun),
+					withActionConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RunAgain": {
+			cr: resourceAction(withActionCreated(), withActionTrigger("2"), withActionObservation(laterRun)),
+			want: want{
+				cr: resourceAction(
+					withActionCreated(),
+					withActionTrigger("2"),
+					withActionObservation(laterRun),
+					withActionConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RunAgainFailed": {
+			cr: resourceAction(withActionCreated(), withActionTrigger("2"), withActionObservation(failedRun)),
+			want: want{
+				cr: resourceAction(
+					withActionCreated(),
+					withActionTrigger("2"),
+					withActionObservation(failedRun),
+					withActionConditions(xpv1.Unavailable().WithMessage("boom")),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NoTrigger": {
+			cr: resourceAction(withActionTrigger(""), withActionCreated(), withActionObservation(v1alpha1.ResourceActionObservation{
+				LastRunTime: ptr.To(metav1.NewTime(testActionCreated)),
+				Result:      ptr.To(resultSucceeded),
+			})),
+			want: want{
+				cr: resourceAction(
+					withActionTrigger(""),
+					withActionCreated(),
+					withActionObservation(v1alpha1.ResourceActionObservation{
+						LastRunTime: ptr.To(metav1.NewTime(testActionCreated)),
+						Result:      ptr.To(resultSucceeded),
+					}),
+					withActionConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Deleted": {
+			cr: resourceAction(withActionCreated(), withActionDeletionTimestamp()),
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ApplicationNotResolved": {
+			cr: resourceAction(func(r *v1alpha1.ResourceAction) { r.Spec.ForProvider.Application = nil }),
+			want: want{
+				err: errors.New(errApplicationNotResolved),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &actionExternal{client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {})}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.cr != nil {
+				if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceActionRun(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ResourceAction
+		err error
+	}
+
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"Successful": {
+			want: want{
+				cr: resourceAction(
+					withActionObservation(v1alpha1.ResourceActionObservation{
+						LastTrigger: ptr.To("1"),
+						Result:      ptr.To(resultSucceeded),
+					}),
+				),
+			},
+		},
+		"RunFailed": {
+			err: errBoom,
+			want: want{
+				cr: resourceAction(
+					withActionObservation(v1alpha1.ResourceActionObservation{
+						Result:  ptr.To(resultFailed),
+						Message: ptr.To(errBoom.Error()),
+					}),
+				),
+				err: errors.Wrap(errBoom, errRunActionFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().RunResourceAction(context.Background(), testActionRequest).Return(&application.ApplicationResponse{}, tc.err)
+			})
+
+			cr := resourceAction()
+			e := &actionExternal{client: client}
+			_, err := e.Update(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if cr.Status.AtProvider.LastRunTime == nil {
+				t.Errorf("r: LastRunTime is not set")
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, cmpopts.IgnoreFields(v1alpha1.ResourceActionObservation{}, "LastRunTime")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceActionReconcileWithoutTrigger(t *testing.T) {
+	client := withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+		mcs.EXPECT().RunResourceAction(gomock.Any(), testActionRequest).Return(&application.ApplicationResponse{}, nil).Times(1)
+	})
+	var cr resource.Managed = resourceAction(withActionTrigger(""))
+	cr.SetName("restart")
+
+	// The action is run exactly once, even with the default initializer that
+	// sets the external name to the name of the managed resource.
+	for range 4 {
+		cr = reconcileOnce(t, v1alpha1.ResourceActionGroupVersionKind, cr, &actionExternal{client: client, conn: io.NopCloser})
+	}
+	if diff := cmp.Diff(resultSucceeded, clients.StringValue(cr.(*v1alpha1.ResourceAction).Status.AtProvider.Result)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
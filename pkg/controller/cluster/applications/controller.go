@@ -20,7 +20,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/io"
@@ -50,6 +49,11 @@ const (
 	errCreateFailed     = "cannot create Argocd application"
 	errUpdateFailed     = "cannot update Argocd application"
 	errDeleteFailed     = "cannot delete Argocd application"
+	errRefreshFailed    = "cannot refresh Argocd application"
+
+	errResourceTreeFailed     = "cannot get resource tree of Argocd application"
+	errManagedResourcesFailed = "cannot get managed resources of Argocd application"
+	errGetManifestsFailed     = "cannot get manifests of Argocd application"
 )
 
 // Setup adds a controller that reconciles applications.
@@ -89,8 +93,7 @@ func SetupWithExternalConnector(mgr ctrl.Manager, o xpcontroller.Options, ec man
 }
 
 type connector struct {
-	kube              client.Client
-	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, applications.ServiceClient, error)
+	kube client.Client
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -102,20 +105,21 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return NewExternal(func() (io.Closer, applications.ServiceClient, error) {
-		return c.newArgocdClientFn(cfg)
+	return NewExternal(c.kube, func() (io.Closer, applications.ServiceClient, error) {
+		return applications.NewApplicationServiceClient(cfg)
 	})
 }
 
-func NewExternal(newArgocdClientFn func() (io.Closer, applications.ServiceClient, error)) (managed.ExternalClient, error) {
+func NewExternal(kube client.Client, newArgocdClientFn func() (io.Closer, applications.ServiceClient, error)) (managed.ExternalClient, error) {
 	conn, argocdClient, err := newArgocdClientFn()
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create argocd client")
 	}
-	return &external{client: argocdClient, conn: conn}, nil
+	return &external{kube: kube, client: argocdClient, conn: conn}, nil
 }
 
 type external struct {
+	kube   client.Client
 	client applications.ServiceClient
 	conn   io.Closer
 }
@@ -161,10 +165,29 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	app := &apps.Items[0]
 
+	lastRefreshRequestedAt := cr.Status.AtProvider.LastRefreshRequestedAt
+	lastRefreshTime := cr.Status.AtProvider.LastRefreshTime
+	exportedManifests := cr.Status.AtProvider.ExportedManifests
+	if needsRefresh(&cr.Spec.ForProvider, lastRefreshRequestedAt) {
+		appQuery.Refresh = ptr.To(ptr.Deref(cr.Spec.ForProvider.Refresh, string(argocdv1alpha1.RefreshTypeNormal)))
+		app, err = e.client.Get(ctx, &appQuery)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errRefreshFailed)
+		}
+		lastRefreshRequestedAt = cr.Spec.ForProvider.RefreshRequestedAt
+		lastRefreshTime = ptr.To(metav1.Now())
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitialize(&cr.Spec.ForProvider, app)
 
+	last := cr.Status.AtProvider
 	cr.Status.AtProvider = generateApplicationObservation(app)
+	cr.Status.AtProvider.LastRefreshRequestedAt = lastRefreshRequestedAt
+	cr.Status.AtProvider.LastRefreshTime = lastRefreshTime
+	cr.Status.AtProvider.ExportedManifests = exportedManifests
+	e.observeResources(ctx, cr, &last)
+	e.exportManifests(ctx, cr, app)
 	cr.Status.SetConditions(getApplicationCondition(&cr.Status.AtProvider))
 
 	return managed.ExternalObservation{
@@ -225,6 +248,14 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
 }
 
+// needsRefresh reports whether a refresh was requested that was not issued yet.
+func needsRefresh(p *v1alpha1.ApplicationParameters, lastRequestedAt *metav1.Time) bool {
+	if p.RefreshRequestedAt == nil {
+		return false
+	}
+	return lastRequestedAt == nil || !p.RefreshRequestedAt.Equal(lastRequestedAt)
+}
+
 func lateInitialize(applicationParameters *v1alpha1.ApplicationParameters, app *argocdv1alpha1.Application) {
 	if app == nil {
 		return
@@ -19,6 +19,7 @@ package applications
 import (
 	"context"
 	"testing"
+	"time"
 
 	argocdApplication "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -30,6 +31,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
 	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applications"
@@ -211,7 +213,7 @@ func TestObserve(t *testing.T) {
 							Status:   "Synced",
 							Revision: &emptyString,
 							ComparedTo: v1alpha1.ComparedTo{
-								Source: v1alpha1.ApplicationSource{
+								Source: v1alpha1.ApplicationSourceObservation{
 									Path:           &emptyString,
 									TargetRevision: &emptyString,
 									Chart:          &emptyString,
@@ -342,7 +344,7 @@ func TestObserve(t *testing.T) {
 							Status:   "Synced",
 							Revision: &emptyString,
 							ComparedTo: v1alpha1.ComparedTo{
-								Source: v1alpha1.ApplicationSource{
+								Source: v1alpha1.ApplicationSourceObservation{
 									Path:           &emptyString,
 									TargetRevision: &emptyString,
 									Chart:          &emptyString,
@@ -556,6 +558,104 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+func TestObserveRefresh(t *testing.T) {
+	requestedAt := metav1.NewTime(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+	earlier := metav1.NewTime(requestedAt.Add(-time.Hour))
+
+	remote := argocdv1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: testApplicationExternalName},
+		Spec:       argocdv1alpha1.ApplicationSpec{Project: testProjectName},
+		Status: argocdv1alpha1.ApplicationStatus{
+			Health: argocdv1alpha1.HealthStatus{Status: "Missing"},
+			Sync:   argocdv1alpha1.SyncStatus{Status: "OutOfSync"},
+		},
+	}
+	query := func(refresh *string) *argocdApplication.ApplicationQuery {
+		return &argocdApplication.ApplicationQuery{
+			Name:     &testApplicationExternalName,
+			Projects: []string{testProjectName},
+			Refresh:  refresh,
+		}
+	}
+
+	type want struct {
+		lastRequestedAt *metav1.Time
+		refreshed       bool
+		err             error
+	}
+
+	cases := map[string]struct {
+		params          v1alpha1.ApplicationParameters
+		lastRequestedAt *metav1.Time
+		get             func(mcs *mockclient.MockServiceClient)
+		want            want
+	}{
+		"NotRequested": {
+			params: v1alpha1.ApplicationParameters{Project: testProjectName},
+		},
+		"AlreadyRefreshed": {
+			params:          v1alpha1.ApplicationParameters{Project: testProjectName, RefreshRequestedAt: &requestedAt},
+			lastRequestedAt: &requestedAt,
+			want:            want{lastRequestedAt: &requestedAt},
+		},
+		"Normal": {
+			params:          v1alpha1.ApplicationParameters{Project: testProjectName, RefreshRequestedAt: &requestedAt},
+			lastRequestedAt: &earlier,
+			get: func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().Get(context.Background(), query(ptr.To("normal"))).Return(&remote, nil)
+			},
+			want: want{lastRequestedAt: &requestedAt, refreshed: true},
+		},
+		"Hard": {
+			params: v1alpha1.ApplicationParameters{Project: testProjectName, Refresh: ptr.To("hard"), RefreshRequestedAt: &requestedAt},
+			get: func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().Get(context.Background(), query(ptr.To("hard"))).Return(&remote, nil)
+			},
+			want: want{lastRequestedAt: &requestedAt, refreshed: true},
+		},
+		"RefreshFailed": {
+			params: v1alpha1.ApplicationParameters{Project: testProjectName, RefreshRequestedAt: &requestedAt},
+			get: func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().Get(context.Background(), query(ptr.To("normal"))).Return(nil, errBoom)
+			},
+			want: want{err: errors.Wrap(errBoom, errRefreshFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().List(context.Background(), query(nil)).
+					Return(&argocdv1alpha1.ApplicationList{Items: []argocdv1alpha1.Application{remote}}, nil)
+				if tc.get != nil {
+					tc.get(mcs)
+				}
+			})
+			cr := Application(
+				withExternalName(testApplicationExternalName),
+				withSpec(tc.params),
+				withObservation(v1alpha1.ArgoApplicationStatus{LastRefreshRequestedAt: tc.lastRequestedAt}),
+			)
+
+			e := &external{client: client}
+			_, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.lastRequestedAt, cr.Status.AtProvider.LastRefreshRequestedAt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if refreshed := cr.Status.AtProvider.LastRefreshTime != nil; refreshed != tc.want.refreshed {
+				t.Errorf("r: refreshed: want %t, got %t", tc.want.refreshed, refreshed)
+			}
+		})
+	}
+}
+
 func initializedArgoAppStatus() v1alpha1.ArgoApplicationStatus {
 	return v1alpha1.ArgoApplicationStatus{
 		Resources: nil,
@@ -563,7 +663,7 @@ func initializedArgoAppStatus() v1alpha1.ArgoApplicationStatus {
 			Status:   "OutOfSync",
 			Revision: &emptyString,
 			ComparedTo: v1alpha1.ComparedTo{
-				Source: v1alpha1.ApplicationSource{
+				Source: v1alpha1.ApplicationSourceObservation{
 					Path:           &emptyString,
 					TargetRevision: &emptyString,
 					Chart:          &emptyString,
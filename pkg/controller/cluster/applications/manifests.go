@@ -0,0 +1,314 @@
+package applications
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+)
+
+const (
+	errExportNamespaceRequired = "namespace of the manifests export is required"
+	errExportNamespaceMismatch = "manifests can only be exported into the namespace of the application"
+	errCompressManifestsFailed = "cannot compress manifests"
+	errFmtWriteManifestsFailed = "cannot write manifests to %s %s/%s"
+	errFmtDeleteChunkFailed    = "cannot delete stale manifests chunk %s %s/%s"
+	errFmtGetChunkFailed       = "cannot get manifests chunk %s %s/%s"
+	errNotExported             = "object exists and was not exported by this application"
+
+	kindSecret = "Secret"
+
+	manifestsKey = "manifests.yaml.gz"
+
+	annotationManifestsRevision = "argocd.crossplane.io/manifests-revision"
+	annotationManifestsChunk    = "argocd.crossplane.io/manifests-chunk"
+	annotationManifestsChunks   = "argocd.crossplane.io/manifests-chunks"
+
+	// maxChunkSize keeps each object well below the 1MiB size limit of
+	// ConfigMaps and Secrets.
+	maxChunkSize = 768 * 1024
+)
+
+const (
+	// typeManifestsExported indicates whether the rendered manifests could
+	// be exported.
+	typeManifestsExported xpv1.ConditionType = "ManifestsExported"
+
+	reasonManifestsExported     xpv1.ConditionReason = "Exported"
+	reasonExportManifestsFailed xpv1.ConditionReason = "ExportFailed"
+)
+
+// exportManifests exports the rendered manifests if the application asks
+// for it. Failing to export them does not fail the observation of the
+// application, the error is reported by the ManifestsExported condition
+// instead.
+func (e *external) exportManifests(ctx context.Context, cr *v1alpha1.Application, app *argocdv1alpha1.Application) {
+	if cr.Spec.ForProvider.ExportManifests == nil {
+		cr.Status.AtProvider.ExportedManifests = nil
+		return
+	}
+	if err := e.writeManifests(ctx, cr, app); err != nil {
+		cr.Status.SetConditions(xpv1.Condition{
+			Type:               typeManifestsExported,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             reasonExportManifestsFailed,
+			Message:            err.Error(),
+		})
+		return
+	}
+	cr.Status.SetConditions(xpv1.Condition{
+		Type:               typeManifestsExported,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonManifestsExported,
+	})
+}
+
+// writeManifests writes the rendered manifests into the ConfigMap or Secret
+// referenced by the application, whenever the revision ArgoCD compared the
+// application to or the export target changed since the last export, or the
+// exported object is gone. The manifests are rendered for the revisions the
+// application was compared to, so they match the exported revision.
+func (e *external) writeManifests(ctx context.Context, cr *v1alpha1.Application, app *argocdv1alpha1.Application) error {
+	export := cr.Spec.ForProvider.ExportManifests
+	revision := syncRevision(app)
+	if revision == "" {
+		return nil
+	}
+	namespace, err := exportNamespace(cr, export)
+	if err != nil {
+		return err
+	}
+
+	last := cr.Status.AtProvider.ExportedManifests
+	target := &v1alpha1.ManifestsExportStatus{Kind: exportKind(export), Name: export.Name, Namespace: namespace}
+	sameTarget := last != nil && sameExportTarget(last, target)
+	if sameTarget && last.Revision == revision {
+		exists, err := e.chunkExists(ctx, cr, last)
+		if err != nil || exists {
+			return err
+		}
+	}
+
+	query := &application.ApplicationManifestQuery{
+		Name:         ptr.To(meta.GetExternalName(cr)),
+		AppNamespace: cr.Spec.ForProvider.AppNamespace,
+	}
+	if cr.Spec.ForProvider.Project != "" {
+		query.Project = &cr.Spec.ForProvider.Project
+	}
+	setManifestsRevisions(query, app)
+	res, err := e.client.GetManifests(ctx, query)
+	if err != nil {
+		return errors.Wrap(err, errGetManifestsFailed)
+	}
+
+	data, err := compressManifests(res.Manifests)
+	if err != nil {
+		return errors.Wrap(err, errCompressManifestsFailed)
+	}
+	chunks := splitChunks(data, maxChunkSize)
+	owner := meta.AsOwner(meta.TypedReferenceTo(cr, v1alpha1.ApplicationGroupVersionKind))
+	for i, chunk := range chunks {
+		obj := manifestsChunk(target, i)
+		obj.SetOwnerReferences([]metav1.OwnerReference{owner})
+		obj.SetAnnotations(map[string]string{
+			annotationManifestsRevision: revision,
+			annotationManifestsChunk:    strconv.Itoa(i),
+			annotationManifestsChunks:   strconv.Itoa(len(chunks)),
+		})
+		setManifestsData(obj, chunk)
+		if err := e.upsert(ctx, cr, obj); err != nil {
+			return errors.Wrapf(err, errFmtWriteManifestsFailed, target.Kind, namespace, obj.GetName())
+		}
+	}
+
+	// remove the chunks the previous export needed in addition, or all of
+	// them if the manifests were exported somewhere else before
+	if last != nil && last.Name != "" {
+		first := len(chunks)
+		if !sameTarget {
+			first = 0
+		}
+		for i := first; i < int(last.Chunks); i++ {
+			obj := manifestsChunk(last, i)
+			if err := e.deleteChunk(ctx, cr, obj); err != nil {
+				return errors.Wrapf(err, errFmtDeleteChunkFailed, last.Kind, last.Namespace, obj.GetName())
+			}
+		}
+	}
+
+	target.Revision = revision
+	target.Manifests = int64(len(res.Manifests))
+	target.Chunks = int64(len(chunks))
+	target.ExportedAt = ptr.To(metav1.Now())
+	cr.Status.AtProvider.ExportedManifests = target
+	return nil
+}
+
+// chunkExists returns whether the first chunk of the last export still
+// exists.
+func (e *external) chunkExists(ctx context.Context, cr *v1alpha1.Application, last *v1alpha1.ManifestsExportStatus) (bool, error) {
+	obj := manifestsChunk(last, 0)
+	err := e.kube.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if kerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, errFmtGetChunkFailed, last.Kind, last.Namespace, obj.GetName())
+	}
+	return isExportedBy(obj, cr), nil
+}
+
+// upsert creates or updates the chunk. Existing objects are only updated if
+// they were exported by the application, so that manually created objects
+// are never taken over.
+func (e *external) upsert(ctx context.Context, cr *v1alpha1.Application, obj client.Object) error {
+	var current client.Object = &corev1.ConfigMap{}
+	if _, ok := obj.(*corev1.Secret); ok {
+		current = &corev1.Secret{}
+	}
+	err := e.kube.Get(ctx, client.ObjectKeyFromObject(obj), current)
+	if kerrors.IsNotFound(err) {
+		return e.kube.Create(ctx, obj)
+	}
+	if err != nil {
+		return err
+	}
+	if !isExportedBy(current, cr) {
+		return errors.New(errNotExported)
+	}
+	obj.SetResourceVersion(current.GetResourceVersion())
+	return e.kube.Update(ctx, obj)
+}
+
+// deleteChunk deletes the chunk if it was exported by the application.
+func (e *external) deleteChunk(ctx context.Context, cr *v1alpha1.Application, obj client.Object) error {
+	err := e.kube.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if err != nil || !isExportedBy(obj, cr) {
+		return client.IgnoreNotFound(err)
+	}
+	return client.IgnoreNotFound(e.kube.Delete(ctx, obj))
+}
+
+// isExportedBy returns whether the object carries the export annotations and
+// is owned by the application.
+func isExportedBy(obj client.Object, cr *v1alpha1.Application) bool {
+	if _, ok := obj.GetAnnotations()[annotationManifestsRevision]; !ok {
+		return false
+	}
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == cr.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+func sameExportTarget(a, b *v1alpha1.ManifestsExportStatus) bool {
+	return a.Kind == b.Kind && a.Name == b.Name && a.Namespace == b.Namespace
+}
+
+// syncRevision returns the revision ArgoCD last compared the application to.
+func syncRevision(app *argocdv1alpha1.Application) string {
+	if app.Status.Sync.Revision != "" {
+		return app.Status.Sync.Revision
+	}
+	return strings.Join(app.Status.Sync.Revisions, ",")
+}
+
+// setManifestsRevisions renders the manifests of the revisions ArgoCD last
+// compared the application to, instead of the current target revisions.
+func setManifestsRevisions(query *application.ApplicationManifestQuery, app *argocdv1alpha1.Application) {
+	if !app.Spec.HasMultipleSources() {
+		query.Revision = clients.StringToPtr(app.Status.Sync.Revision)
+		return
+	}
+	for i, revision := range app.Status.Sync.Revisions {
+		query.SourcePositions = append(query.SourcePositions, int64(i+1))
+		query.Revisions = append(query.Revisions, revision)
+	}
+}
+
+// exportNamespace returns the namespace of the export. Namespaced
+// applications may only export into their own namespace.
+func exportNamespace(cr *v1alpha1.Application, export *v1alpha1.ManifestsExport) (string, error) {
+	namespace := ptr.Deref(export.Namespace, "")
+	if cr.GetNamespace() != "" {
+		if namespace != "" && namespace != cr.GetNamespace() {
+			return "", errors.New(errExportNamespaceMismatch)
+		}
+		return cr.GetNamespace(), nil
+	}
+	if namespace == "" {
+		return "", errors.New(errExportNamespaceRequired)
+	}
+	return namespace, nil
+}
+
+func exportKind(export *v1alpha1.ManifestsExport) string {
+	return ptr.Deref(export.Kind, "ConfigMap")
+}
+
+// manifestsChunk returns the object that holds the i-th chunk of the
+// manifests exported to the target. The first chunk is stored in the
+// referenced object itself.
+func manifestsChunk(target *v1alpha1.ManifestsExportStatus, i int) client.Object {
+	om := metav1.ObjectMeta{Name: target.Name, Namespace: target.Namespace}
+	if i > 0 {
+		om.Name = fmt.Sprintf("%s-%d", target.Name, i)
+	}
+	if target.Kind == kindSecret {
+		return &corev1.Secret{ObjectMeta: om}
+	}
+	return &corev1.ConfigMap{ObjectMeta: om}
+}
+
+func setManifestsData(obj client.Object, data []byte) {
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		o.Data = map[string][]byte{manifestsKey: data}
+	case *corev1.ConfigMap:
+		o.BinaryData = map[string][]byte{manifestsKey: data}
+	}
+}
+
+// compressManifests joins the manifests to a multi document YAML stream and
+// compresses it.
+func compressManifests(manifests []string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(strings.Join(manifests, "\n---\n"))); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func splitChunks(data []byte, size int) [][]byte {
+	chunks := [][]byte{}
+	for len(data) > size {
+		chunks = append(chunks, data[:size])
+		data = data[size:]
+	}
+	return append(chunks, data)
+}
@@ -0,0 +1,328 @@
+package applications
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+
+	argocdApplication "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	reposerver "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/applications"
+)
+
+var (
+	testManifests      = []string{"apiVersion: v1\nkind: Service", "apiVersion: apps/v1\nkind: Deployment"}
+	testExportRevision = "a1b2c3"
+)
+
+func decompress(t *testing.T, data []byte) string {
+	t.Helper()
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestExportManifests(t *testing.T) {
+	export := func(kind string) *v1alpha1.ManifestsExport {
+		return &v1alpha1.ManifestsExport{Kind: ptr.To(kind), Name: "manifests", Namespace: ptr.To("audit")}
+	}
+	remote := &argocdv1alpha1.Application{
+		Status: argocdv1alpha1.ApplicationStatus{
+			Sync: argocdv1alpha1.SyncStatus{Revision: testExportRevision},
+		},
+	}
+	expectGetManifests := func(err error) mockModifier {
+		return func(mcs *mockclient.MockServiceClient) {
+			mcs.EXPECT().GetManifests(context.Background(), &argocdApplication.ApplicationManifestQuery{
+				Name:     &testApplicationExternalName,
+				Project:  &testProjectName,
+				Revision: &testExportRevision,
+			}).Return(&reposerver.ManifestResponse{Manifests: testManifests}, err)
+		}
+	}
+	exportedTo := func(kind, name string, chunks int64) *v1alpha1.ManifestsExportStatus {
+		return &v1alpha1.ManifestsExportStatus{Kind: kind, Name: name, Namespace: "audit", Revision: testExportRevision, Manifests: 2, Chunks: chunks}
+	}
+	exported := func(chunks int64) *v1alpha1.ManifestsExportStatus {
+		return exportedTo("ConfigMap", "manifests", chunks)
+	}
+	owned := func(rev string) map[string]string {
+		return map[string]string{"ConfigMap/manifests": rev}
+	}
+
+	type want struct {
+		status  *v1alpha1.ManifestsExportStatus
+		written []string
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		export    *v1alpha1.ManifestsExport
+		namespace string
+		last      *v1alpha1.ManifestsExportStatus
+		// existing objects by kind/name and their revision annotation,
+		// objects without annotation are not owned by the application
+		existing map[string]string
+		mock     mockModifier
+		want     want
+	}{
+		"Disabled": {
+			last: exported(1),
+			mock: func(mcs *mockclient.MockServiceClient) {},
+		},
+		"AlreadyExported": {
+			export:   export("ConfigMap"),
+			last:     exported(1),
+			existing: owned(testExportRevision),
+			mock:     func(mcs *mockclient.MockServiceClient) {},
+			want:     want{status: exported(1)},
+		},
+		"ExportedObjectDeleted": {
+			export: export("ConfigMap"),
+			last:   exported(1),
+			mock:   expectGetManifests(nil),
+			want:   want{status: exported(1), written: []string{"ConfigMap/manifests"}},
+		},
+		"ConfigMap": {
+			export: export("ConfigMap"),
+			mock:   expectGetManifests(nil),
+			want:   want{status: exported(1), written: []string{"ConfigMap/manifests"}},
+		},
+		"Secret": {
+			export: export("Secret"),
+			mock:   expectGetManifests(nil),
+			want:   want{status: exportedTo("Secret", "manifests", 1), written: []string{"Secret/manifests"}},
+		},
+		"UpdateExisting": {
+			export: export("ConfigMap"),
+			last:   &v1alpha1.ManifestsExportStatus{Kind: "ConfigMap", Name: "manifests", Namespace: "audit", Revision: "d4e5f6", Chunks: 3},
+			existing: map[string]string{
+				"ConfigMap/manifests":   "d4e5f6",
+				"ConfigMap/manifests-1": "d4e5f6",
+				"ConfigMap/manifests-2": "d4e5f6",
+			},
+			mock: expectGetManifests(nil),
+			want: want{
+				status:  exported(1),
+				written: []string{"ConfigMap/manifests"},
+				deleted: []string{"ConfigMap/manifests-1", "ConfigMap/manifests-2"},
+			},
+		},
+		"TargetChanged": {
+			export:   export("Secret"),
+			last:     exported(1),
+			existing: owned(testExportRevision),
+			mock:     expectGetManifests(nil),
+			want: want{
+				status:  exportedTo("Secret", "manifests", 1),
+				written: []string{"Secret/manifests"},
+				deleted: []string{"ConfigMap/manifests"},
+			},
+		},
+		"NotExportedByApplication": {
+			export:   export("ConfigMap"),
+			existing: owned(""),
+			mock:     expectGetManifests(nil),
+			want:     want{err: errors.Wrapf(errors.New(errNotExported), errFmtWriteManifestsFailed, "ConfigMap", "audit", "manifests")},
+		},
+		"NamespaceRequired": {
+			export: &v1alpha1.ManifestsExport{Name: "manifests"},
+			mock:   func(mcs *mockclient.MockServiceClient) {},
+			want:   want{err: errors.New(errExportNamespaceRequired)},
+		},
+		"NamespaceMismatch": {
+			export:    export("ConfigMap"),
+			namespace: "team-a",
+			mock:      func(mcs *mockclient.MockServiceClient) {},
+			want:      want{err: errors.New(errExportNamespaceMismatch)},
+		},
+		"GetManifestsFailed": {
+			export: export("ConfigMap"),
+			mock:   expectGetManifests(errBoom),
+			want:   want{err: errors.Wrap(errBoom, errGetManifestsFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := Application(
+				withExternalName(testApplicationExternalName),
+				withSpec(v1alpha1.ApplicationParameters{Project: testProjectName, ExportManifests: tc.export}),
+				withObservation(v1alpha1.ArgoApplicationStatus{ExportedManifests: tc.last}),
+			)
+			cr.SetNamespace(tc.namespace)
+			cr.SetUID("app-uid")
+
+			var written, deleted []string
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					rev, ok := tc.existing[kindOf(obj)+"/"+key.Name]
+					if !ok {
+						return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+					}
+					if rev != "" {
+						obj.SetAnnotations(map[string]string{annotationManifestsRevision: rev})
+						obj.SetOwnerReferences([]metav1.OwnerReference{{UID: cr.GetUID()}})
+					}
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					written = append(written, describe(t, obj))
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					written = append(written, describe(t, obj))
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+					deleted = append(deleted, describe(t, obj))
+					return nil
+				},
+			}
+
+			e := &external{kube: kube, client: withMockClient(t, tc.mock)}
+			e.exportManifests(context.Background(), cr, remote)
+
+			// errors are reported by the condition, not returned
+			var wantMessage string
+			if tc.want.err != nil {
+				wantMessage = tc.want.err.Error()
+			}
+			if diff := cmp.Diff(wantMessage, cr.Status.GetCondition(typeManifestsExported).Message); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider.ExportedManifests, cmpopts.IgnoreFields(v1alpha1.ManifestsExportStatus{}, "ExportedAt")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.written, written); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetManifestsRevisions(t *testing.T) {
+	cases := map[string]struct {
+		app  *argocdv1alpha1.Application
+		want *argocdApplication.ApplicationManifestQuery
+	}{
+		"SingleSource": {
+			app: &argocdv1alpha1.Application{
+				Spec:   argocdv1alpha1.ApplicationSpec{Source: &argocdv1alpha1.ApplicationSource{TargetRevision: "HEAD"}},
+				Status: argocdv1alpha1.ApplicationStatus{Sync: argocdv1alpha1.SyncStatus{Revision: testExportRevision}},
+			},
+			want: &argocdApplication.ApplicationManifestQuery{Revision: &testExportRevision},
+		},
+		"MultipleSources": {
+			app: &argocdv1alpha1.Application{
+				Spec: argocdv1alpha1.ApplicationSpec{Sources: argocdv1alpha1.ApplicationSources{
+					{TargetRevision: "HEAD"},
+					{TargetRevision: "main"},
+				}},
+				Status: argocdv1alpha1.ApplicationStatus{Sync: argocdv1alpha1.SyncStatus{Revisions: []string{"a1b2c3", "d4e5f6"}}},
+			},
+			want: &argocdApplication.ApplicationManifestQuery{
+				SourcePositions: []int64{1, 2},
+				Revisions:       []string{"a1b2c3", "d4e5f6"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			query := &argocdApplication.ApplicationManifestQuery{}
+			setManifestsRevisions(query, tc.app)
+			if diff := cmp.Diff(tc.want, query, cmpopts.IgnoreUnexported(argocdApplication.ApplicationManifestQuery{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func kindOf(obj client.Object) string {
+	if _, ok := obj.(*corev1.Secret); ok {
+		return "Secret"
+	}
+	return "ConfigMap"
+}
+
+// describe returns kind and name of the object and checks the written manifests.
+func describe(t *testing.T, obj client.Object) string {
+	t.Helper()
+	if obj.GetNamespace() != "audit" {
+		t.Errorf("r: want namespace audit, got %s", obj.GetNamespace())
+	}
+	var data []byte
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		data = o.BinaryData[manifestsKey]
+	case *corev1.Secret:
+		data = o.Data[manifestsKey]
+	}
+	if data != nil {
+		if got := decompress(t, data); got != testManifests[0]+"\n---\n"+testManifests[1] {
+			t.Errorf("r: unexpected manifests %q", got)
+		}
+		if got := obj.GetAnnotations()[annotationManifestsRevision]; got != testExportRevision {
+			t.Errorf("r: want revision %s, got %s", testExportRevision, got)
+		}
+	}
+	return kindOf(obj) + "/" + obj.GetName()
+}
+
+func TestSplitChunks(t *testing.T) {
+	cases := map[string]struct {
+		data []byte
+		want [][]byte
+	}{
+		"Empty": {
+			data: []byte{},
+			want: [][]byte{{}},
+		},
+		"Single": {
+			data: []byte("abc"),
+			want: [][]byte{[]byte("abc")},
+		},
+		"Exact": {
+			data: []byte("abcdef"),
+			want: [][]byte{[]byte("abc"), []byte("def")},
+		},
+		"Remainder": {
+			data: []byte("abcdefg"),
+			want: [][]byte{[]byte("abc"), []byte("def"), []byte("g")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, splitChunks(tc.data, 3)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package applications
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+)
+
+// maxSummarizedResources limits the number of resources listed in a summary.
+const maxSummarizedResources = 20
+
+const (
+	// typeResourcesObserved indicates whether the resources that were opted in
+	// to the status could be observed.
+	typeResourcesObserved xpv1.ConditionType = "ResourcesObserved"
+
+	reasonResourcesObserved     xpv1.ConditionReason = "Observed"
+	reasonObserveResourceFailed xpv1.ConditionReason = "ObserveFailed"
+)
+
+// observeResources adds the summaries of the application resources that were
+// opted in to the status. Failing to observe them does not fail the
+// observation of the application. The summaries of the last observation are
+// kept and the error is reported by the ResourcesObserved condition instead.
+func (e *external) observeResources(ctx context.Context, cr *v1alpha1.Application, last *v1alpha1.ArgoApplicationStatus) {
+	observeTree := ptr.Deref(cr.Spec.ForProvider.ObserveResourceTree, false)
+	observeManaged := ptr.Deref(cr.Spec.ForProvider.ObserveManagedResources, false)
+	if !observeTree && !observeManaged {
+		return
+	}
+
+	query := &application.ResourcesQuery{
+		ApplicationName: ptr.To(meta.GetExternalName(cr)),
+		AppNamespace:    cr.Spec.ForProvider.AppNamespace,
+	}
+	if cr.Spec.ForProvider.Project != "" {
+		query.Project = &cr.Spec.ForProvider.Project
+	}
+
+	var errs []error
+	if observeTree {
+		cr.Status.AtProvider.ResourceTree = last.ResourceTree
+		tree, err := e.client.ResourceTree(ctx, query)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, errResourceTreeFailed))
+		} else {
+			cr.Status.AtProvider.ResourceTree = generateResourceTreeSummary(tree)
+		}
+	}
+	if observeManaged {
+		cr.Status.AtProvider.ManagedResources = last.ManagedResources
+		resources, err := e.client.ManagedResources(ctx, query)
+		switch {
+		case err != nil:
+			errs = append(errs, errors.Wrap(err, errManagedResourcesFailed))
+		case resources != nil:
+			cr.Status.AtProvider.ManagedResources = generateManagedResourcesSummary(resources.Items)
+		default:
+			cr.Status.AtProvider.ManagedResources = generateManagedResourcesSummary(nil)
+		}
+	}
+
+	if err := kerrors.NewAggregate(errs); err != nil {
+		cr.Status.SetConditions(xpv1.Condition{
+			Type:               typeResourcesObserved,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             reasonObserveResourceFailed,
+			Message:            err.Error(),
+		})
+		return
+	}
+	cr.Status.SetConditions(xpv1.Condition{
+		Type:               typeResourcesObserved,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonResourcesObserved,
+	})
+}
+
+// generateResourceTreeSummary counts the resources of the tree by health and
+// lists the unhealthy and orphaned ones.
+func generateResourceTreeSummary(tree *argocdv1alpha1.ApplicationTree) *v1alpha1.ResourceTreeSummary {
+	if tree == nil {
+		return nil
+	}
+	s := &v1alpha1.ResourceTreeSummary{
+		Nodes:    int64(len(tree.Nodes)),
+		Orphaned: int64(len(tree.OrphanedNodes)),
+	}
+	var unhealthy []v1alpha1.ResourceSummary
+	for i := range tree.Nodes {
+		n := &tree.Nodes[i]
+		if n.Health == nil {
+			continue
+		}
+		if s.Health == nil {
+			s.Health = map[string]int64{}
+		}
+		s.Health[string(n.Health.Status)]++
+		if isUnhealthy(n.Health.Status) {
+			unhealthy = append(unhealthy, generateResourceSummary(n.ResourceRef, n.Health))
+		}
+	}
+	s.Unhealthy = boundResourceSummaries(unhealthy)
+
+	orphaned := make([]v1alpha1.ResourceSummary, len(tree.OrphanedNodes))
+	for i := range tree.OrphanedNodes {
+		orphaned[i] = generateResourceSummary(tree.OrphanedNodes[i].ResourceRef, tree.OrphanedNodes[i].Health)
+	}
+	s.OrphanedResources = boundResourceSummaries(orphaned)
+	return s
+}
+
+// generateManagedResourcesSummary counts the managed resources and lists the
+// ones that differ from their target state. Hooks are not counted.
+func generateManagedResourcesSummary(diffs []*argocdv1alpha1.ResourceDiff) *v1alpha1.ManagedResourcesSummary {
+	s := &v1alpha1.ManagedResourcesSummary{}
+	var modified []v1alpha1.ResourceSummary
+	for _, d := range diffs {
+		if d == nil || d.Hook {
+			continue
+		}
+		s.Resources++
+		if d.Modified {
+			s.Modified++
+			modified = append(modified, generateResourceSummary(argocdv1alpha1.ResourceRef{
+				Group:     d.Group,
+				Kind:      d.Kind,
+				Namespace: d.Namespace,
+				Name:      d.Name,
+			}, nil))
+		}
+	}
+	s.ModifiedResources = boundResourceSummaries(modified)
+	return s
+}
+
+func generateResourceSummary(ref argocdv1alpha1.ResourceRef, h *argocdv1alpha1.HealthStatus) v1alpha1.ResourceSummary {
+	s := v1alpha1.ResourceSummary{
+		Group:     clients.StringToPtr(ref.Group),
+		Kind:      ref.Kind,
+		Namespace: clients.StringToPtr(ref.Namespace),
+		Name:      ref.Name,
+	}
+	if h != nil {
+		s.Health = clients.StringToPtr(string(h.Status))
+		s.Message = clients.StringToPtr(h.Message)
+	}
+	return s
+}
+
+// boundResourceSummaries sorts the summaries, so the status does not change
+// with the order returned by ArgoCD, and limits their number.
+func boundResourceSummaries(s []v1alpha1.ResourceSummary) []v1alpha1.ResourceSummary {
+	if len(s) == 0 {
+		return nil
+	}
+	slices.SortFunc(s, func(a, b v1alpha1.ResourceSummary) int {
+		return cmp.Or(
+			cmp.Compare(clients.StringValue(a.Group), clients.StringValue(b.Group)),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(clients.StringValue(a.Namespace), clients.StringValue(b.Namespace)),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return s[:min(len(s), maxSummarizedResources)]
+}
+
+func isUnhealthy(status health.HealthStatusCode) bool {
+	switch status {
+	case health.HealthStatusDegraded, health.HealthStatusMissing, health.HealthStatusUnknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,221 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	argocdApplication "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applications/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/applications"
+)
+
+func resourceNode(kind, name string, status health.HealthStatusCode, message string) argocdv1alpha1.ResourceNode {
+	n := argocdv1alpha1.ResourceNode{
+		ResourceRef: argocdv1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: kind, Namespace: "default", Name: name},
+	}
+	if status != "" {
+		n.Health = &argocdv1alpha1.HealthStatus{Status: status, Message: message}
+	}
+	return n
+}
+
+func TestGenerateResourceTreeSummary(t *testing.T) {
+	cases := map[string]struct {
+		tree *argocdv1alpha1.ApplicationTree
+		want *v1alpha1.ResourceTreeSummary
+	}{
+		"Nil": {},
+		"Empty": {
+			tree: &argocdv1alpha1.ApplicationTree{},
+			want: &v1alpha1.ResourceTreeSummary{},
+		},
+		"Summarized": {
+			tree: &argocdv1alpha1.ApplicationTree{
+				Nodes: []argocdv1alpha1.ResourceNode{
+					resourceNode("Deployment", "web", health.HealthStatusDegraded, "Deployment exceeded its progress deadline"),
+					resourceNode("ReplicaSet", "web-5d8f", health.HealthStatusHealthy, ""),
+					resourceNode("ConfigMap", "web-config", "", ""),
+					resourceNode("Deployment", "api", health.HealthStatusProgressing, ""),
+					resourceNode("Deployment", "db", health.HealthStatusMissing, ""),
+				},
+				OrphanedNodes: []argocdv1alpha1.ResourceNode{
+					resourceNode("Secret", "old-secret", "", ""),
+				},
+			},
+			want: &v1alpha1.ResourceTreeSummary{
+				Nodes: 5,
+				Health: map[string]int64{
+					"Degraded":    1,
+					"Healthy":     1,
+					"Progressing": 1,
+					"Missing":     1,
+				},
+				Unhealthy: []v1alpha1.ResourceSummary{
+					{Group: ptr.To("apps"), Kind: "Deployment", Namespace: ptr.To("default"), Name: "db", Health: ptr.To("Missing")},
+					{Group: ptr.To("apps"), Kind: "Deployment", Namespace: ptr.To("default"), Name: "web", Health: ptr.To("Degraded"), Message: ptr.To("Deployment exceeded its progress deadline")},
+				},
+				Orphaned: 1,
+				OrphanedResources: []v1alpha1.ResourceSummary{
+					{Group: ptr.To("apps"), Kind: "Secret", Namespace: ptr.To("default"), Name: "old-secret"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateResourceTreeSummary(tc.tree)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateResourceTreeSummaryBounded(t *testing.T) {
+	tree := &argocdv1alpha1.ApplicationTree{}
+	for i := 0; i < maxSummarizedResources+5; i++ {
+		tree.Nodes = append(tree.Nodes, resourceNode("Pod", fmt.Sprintf("web-%02d", i), health.HealthStatusDegraded, ""))
+	}
+
+	got := generateResourceTreeSummary(tree)
+	if got.Nodes != maxSummarizedResources+5 {
+		t.Errorf("r: want %d nodes, got %d", maxSummarizedResources+5, got.Nodes)
+	}
+	if len(got.Unhealthy) != maxSummarizedResources {
+		t.Errorf("r: want %d unhealthy resources, got %d", maxSummarizedResources, len(got.Unhealthy))
+	}
+}
+
+func TestGenerateManagedResourcesSummary(t *testing.T) {
+	diffs := []*argocdv1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "web", Modified: true},
+		{Kind: "Service", Namespace: "default", Name: "web"},
+		{Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate", Hook: true, Modified: true},
+	}
+	want := &v1alpha1.ManagedResourcesSummary{
+		Resources: 2,
+		Modified:  1,
+		ModifiedResources: []v1alpha1.ResourceSummary{
+			{Group: ptr.To("apps"), Kind: "Deployment", Namespace: ptr.To("default"), Name: "web"},
+		},
+	}
+
+	got := generateManagedResourcesSummary(diffs)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestObserveResources(t *testing.T) {
+	query := &argocdApplication.ResourcesQuery{
+		ApplicationName: &testApplicationExternalName,
+		Project:         &testProjectName,
+	}
+	lastTree := &v1alpha1.ResourceTreeSummary{Nodes: 3}
+	lastManaged := &v1alpha1.ManagedResourcesSummary{Resources: 2}
+	observed := xpv1.Condition{Type: typeResourcesObserved, Status: corev1.ConditionTrue, Reason: reasonResourcesObserved}
+	failed := func(err error) xpv1.Condition {
+		return xpv1.Condition{Type: typeResourcesObserved, Status: corev1.ConditionFalse, Reason: reasonObserveResourceFailed, Message: err.Error()}
+	}
+
+	type want struct {
+		status     v1alpha1.ArgoApplicationStatus
+		conditions []xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		params v1alpha1.ApplicationParameters
+		mock   mockModifier
+		want   want
+	}{
+		"Disabled": {
+			params: v1alpha1.ApplicationParameters{Project: testProjectName},
+			mock:   func(mcs *mockclient.MockServiceClient) {},
+		},
+		"Enabled": {
+			params: v1alpha1.ApplicationParameters{
+				Project:                 testProjectName,
+				ObserveResourceTree:     ptr.To(true),
+				ObserveManagedResources: ptr.To(true),
+			},
+			mock: func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().ResourceTree(context.Background(), query).Return(&argocdv1alpha1.ApplicationTree{}, nil)
+				mcs.EXPECT().ManagedResources(context.Background(), query).Return(&argocdApplication.ManagedResourcesResponse{}, nil)
+			},
+			want: want{
+				status: v1alpha1.ArgoApplicationStatus{
+					ResourceTree:     &v1alpha1.ResourceTreeSummary{},
+					ManagedResources: &v1alpha1.ManagedResourcesSummary{},
+				},
+				conditions: []xpv1.Condition{observed},
+			},
+		},
+		"NoManagedResourcesResponse": {
+			params: v1alpha1.ApplicationParameters{Project: testProjectName, ObserveManagedResources: ptr.To(true)},
+			mock: func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().ManagedResources(context.Background(), query).Return(nil, nil)
+			},
+			want: want{
+				status:     v1alpha1.ArgoApplicationStatus{ManagedResources: &v1alpha1.ManagedResourcesSummary{}},
+				conditions: []xpv1.Condition{observed},
+			},
+		},
+		"ResourceTreeFailed": {
+			params: v1alpha1.ApplicationParameters{
+				Project:                 testProjectName,
+				ObserveResourceTree:     ptr.To(true),
+				ObserveManagedResources: ptr.To(true),
+			},
+			mock: func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().ResourceTree(context.Background(), query).Return(nil, errBoom)
+				mcs.EXPECT().ManagedResources(context.Background(), query).Return(&argocdApplication.ManagedResourcesResponse{}, nil)
+			},
+			want: want{
+				status: v1alpha1.ArgoApplicationStatus{
+					ResourceTree:     lastTree,
+					ManagedResources: &v1alpha1.ManagedResourcesSummary{},
+				},
+				conditions: []xpv1.Condition{failed(errors.Wrap(errBoom, errResourceTreeFailed))},
+			},
+		},
+		"ManagedResourcesFailed": {
+			params: v1alpha1.ApplicationParameters{Project: testProjectName, ObserveManagedResources: ptr.To(true)},
+			mock: func(mcs *mockclient.MockServiceClient) {
+				mcs.EXPECT().ManagedResources(context.Background(), query).Return(nil, errBoom)
+			},
+			want: want{
+				status:     v1alpha1.ArgoApplicationStatus{ManagedResources: lastManaged},
+				conditions: []xpv1.Condition{failed(errors.Wrap(errBoom, errManagedResourcesFailed))},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := Application(withExternalName(testApplicationExternalName), withSpec(tc.params))
+			e := &external{client: withMockClient(t, tc.mock)}
+			e.observeResources(context.Background(), cr, &v1alpha1.ArgoApplicationStatus{
+				ResourceTree:     lastTree,
+				ManagedResources: lastManaged,
+			})
+
+			if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package applicationsetdiscovery
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applicationsets/v1alpha1"
+	providerv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/v1alpha1"
+	namespacedv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/namespace/applicationsets/v1alpha1"
+	namespacedproviderv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/namespace/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	appsets "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applicationsets"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotApplicationSetDiscovery = "managed resource is not a ArgoCD ApplicationSetDiscovery custom resource"
+	errListApplicationSetsFailed  = "cannot list ArgoCD application sets"
+	errListManagedFailed          = "cannot list ApplicationSet managed resources"
+	errGetProviderConfigFailed    = "cannot get provider config"
+
+	// maxUnmanagedApplicationSets limits the number of unmanaged application
+	// sets listed in the status.
+	maxUnmanagedApplicationSets = 100
+)
+
+// Setup adds a controller that discovers application sets.
+func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ApplicationSetDiscoveryKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: appsets.NewApplicationSetServiceClient,
+		}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.ApplicationSetDiscoveryList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ApplicationSetDiscovery{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ApplicationSetDiscoveryGroupVersionKind),
+			opts...))
+}
+
+type connector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, appsets.ServiceClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationSetDiscovery)
+	if !ok {
+		return nil, errors.New(errNotApplicationSetDiscovery)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &external{kube: c.kube, client: argocdClient, conn: conn}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client appsets.ServiceClient
+	conn   io.Closer
+}
+
+// Observe lists the application sets. ApplicationSetDiscovery is
+// observe-only, so the resource always exists and is up to date until it is
+// deleted.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ApplicationSetDiscovery)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApplicationSetDiscovery)
+	}
+
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	if !needsRefresh(&cr.Spec.ForProvider, &cr.Status.AtProvider, time.Now()) {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	list, err := e.client.List(ctx, &applicationset.ApplicationSetListQuery{
+		Projects:        cr.Spec.ForProvider.Projects,
+		Selector:        clients.StringValue(cr.Spec.ForProvider.Selector),
+		AppsetNamespace: clients.StringValue(cr.Spec.ForProvider.AppsetNamespace),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListApplicationSetsFailed)
+	}
+	managedSets, err := e.managedApplicationSets(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListManagedFailed)
+	}
+
+	obs := generateDiscoveryObservation(list.Items, managedSets)
+	now := metav1.Now()
+	obs.LastRefreshTime = &now
+
+	cr.Status.AtProvider = obs
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+// managedApplicationSets returns the application sets managed by
+// ApplicationSet managed resources that use the same provider config as the
+// discovery. ApplicationSets in preview mode do not manage anything and are
+// skipped. A cluster scoped discovery considers cluster scoped resources and
+// namespaced resources in all namespaces. As namespaced resources cannot
+// reference the provider config of the discovery, they are considered if
+// their provider config points to the same ArgoCD server. A namespaced
+// discovery only considers resources within its namespace.
+func (e *external) managedApplicationSets(ctx context.Context, cr *v1alpha1.ApplicationSetDiscovery) (map[types.NamespacedName]bool, error) {
+	managedSets := map[types.NamespacedName]bool{}
+	ref := providerConfigRef(cr)
+	if cr.GetNamespace() == "" {
+		l := &v1alpha1.ApplicationSetList{}
+		if err := e.kube.List(ctx, l); err != nil {
+			return nil, err
+		}
+		for i := range l.Items {
+			mr := &l.Items[i]
+			if providerConfigRef(mr) == ref {
+				addManaged(managedSets, mr, mr.Spec.ForProvider.Preview, mr.Spec.ForProvider.AppsetNamespace)
+			}
+		}
+	}
+
+	l := &namespacedv1alpha1.ApplicationSetList{}
+	if err := e.kube.List(ctx, l, client.InNamespace(cr.GetNamespace())); err != nil {
+		return nil, err
+	}
+	servers := map[types.NamespacedName]string{}
+	for i := range l.Items {
+		mr := &l.Items[i]
+		same := providerConfigRef(mr) == ref
+		if cr.GetNamespace() == "" {
+			var err error
+			if same, err = e.sameServer(ctx, ref, mr, servers); err != nil {
+				return nil, err
+			}
+		}
+		if same {
+			addManaged(managedSets, mr, mr.Spec.ForProvider.Preview, mr.Spec.ForProvider.AppsetNamespace)
+		}
+	}
+	return managedSets, nil
+}
+
+func addManaged(managedSets map[types.NamespacedName]bool, mr metav1.Object, preview *bool, appsetNamespace *string) {
+	if ptr.Deref(preview, false) {
+		return
+	}
+	if name := meta.GetExternalName(mr); name != "" {
+		managedSets[types.NamespacedName{
+			Namespace: clients.StringValue(appsetNamespace),
+			Name:      name,
+		}] = true
+	}
+}
+
+// providerConfigRef returns the full provider config reference of the
+// managed resource. References of cluster scoped resources only carry a
+// name, as they always refer to a cluster scoped ProviderConfig.
+func providerConfigRef(mg resource.Managed) xpv1.ProviderConfigReference {
+	switch mg := mg.(type) {
+	case interface {
+		GetProviderConfigReference() *xpv1.ProviderConfigReference
+	}:
+		if ref := mg.GetProviderConfigReference(); ref != nil {
+			return *ref
+		}
+	case interface{ GetProviderConfigReference() *xpv1.Reference }:
+		if ref := mg.GetProviderConfigReference(); ref != nil {
+			return xpv1.ProviderConfigReference{Kind: providerv1alpha1.ProviderConfigKind, Name: ref.Name}
+		}
+	}
+	return xpv1.ProviderConfigReference{}
+}
+
+// sameServer reports whether the provider config of the namespaced resource
+// points to the same ArgoCD server as the cluster scoped provider config ref.
+// The server addresses are cached by provider config in servers.
+func (e *external) sameServer(ctx context.Context, ref xpv1.ProviderConfigReference, mr resource.Managed, servers map[types.NamespacedName]string) (bool, error) {
+	mrRef := providerConfigRef(mr)
+	if ref.Name == "" || mrRef.Name == "" {
+		return ref.Name == mrRef.Name, nil
+	}
+	key := types.NamespacedName{Name: ref.Name}
+	if _, ok := servers[key]; !ok {
+		pc := &providerv1alpha1.ProviderConfig{}
+		if err := e.kube.Get(ctx, key, pc); err != nil {
+			return false, errors.Wrap(err, errGetProviderConfigFailed)
+		}
+		servers[key] = pc.Spec.ServerAddr
+	}
+	mrKey := types.NamespacedName{Namespace: mr.GetNamespace(), Name: mrRef.Name}
+	if _, ok := servers[mrKey]; !ok {
+		pc := &namespacedproviderv1alpha1.ProviderConfig{}
+		if err := e.kube.Get(ctx, mrKey, pc); err != nil {
+			return false, errors.Wrap(err, errGetProviderConfigFailed)
+		}
+		servers[mrKey] = pc.Spec.ServerAddr
+	}
+	return servers[key] == servers[mrKey], nil
+}
+
+// generateDiscoveryObservation splits the application sets into managed and
+// unmanaged ones. ApplicationSet managed resources without an appset
+// namespace refer to the namespace of the ArgoCD control plane, which is not
+// known to the provider, so they match application sets of that name in any
+// namespace.
+func generateDiscoveryObservation(items []argocdv1alpha1.ApplicationSet, managedSets map[types.NamespacedName]bool) v1alpha1.ApplicationSetDiscoveryObservation {
+	obs := v1alpha1.ApplicationSetDiscoveryObservation{
+		ApplicationSets: int64(len(items)),
+	}
+	var unmanaged []v1alpha1.DiscoveredApplicationSet
+	for _, appset := range items {
+		if managedSets[types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}] ||
+			managedSets[types.NamespacedName{Name: appset.Name}] {
+			obs.Managed++
+			continue
+		}
+		unmanaged = append(unmanaged, v1alpha1.DiscoveredApplicationSet{
+			Name:      appset.Name,
+			Namespace: clients.StringToPtr(appset.Namespace),
+			Project:   clients.StringToPtr(appset.Spec.Template.Spec.Project),
+		})
+	}
+	obs.Unmanaged = int64(len(unmanaged))
+
+	sort.Slice(unmanaged, func(i, j int) bool {
+		ni, nj := clients.StringValue(unmanaged[i].Namespace), clients.StringValue(unmanaged[j].Namespace)
+		if ni != nj {
+			return ni < nj
+		}
+		return unmanaged[i].Name < unmanaged[j].Name
+	})
+	if len(unmanaged) > maxUnmanagedApplicationSets {
+		unmanaged = unmanaged[:maxUnmanagedApplicationSets]
+	}
+	obs.UnmanagedApplicationSets = unmanaged
+	return obs
+}
+
+// needsRefresh reports whether the application sets have to be listed again.
+// They are listed on every poll unless a refresh interval is set.
+func needsRefresh(p *v1alpha1.ApplicationSetDiscoveryParameters, o *v1alpha1.ApplicationSetDiscoveryObservation, now time.Time) bool {
+	if p.RefreshInterval == nil || o.LastRefreshTime == nil {
+		return true
+	}
+	return now.Sub(o.LastRefreshTime.Time) >= p.RefreshInterval.Duration
+}
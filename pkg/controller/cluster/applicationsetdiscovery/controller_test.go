@@ -0,0 +1,339 @@
+package applicationsetdiscovery
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applicationsets/v1alpha1"
+	namespacedv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/namespace/applicationsets/v1alpha1"
+	appsets "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applicationsets"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/applicationsets"
+)
+
+var (
+	errBoom          = errors.New("boom")
+	testAppsetNs     = "argocd"
+	testOtherAppsets = "team-a"
+	testProject      = "default"
+)
+
+type args struct {
+	client appsets.ServiceClient
+	kube   client.Client
+	cr     *v1alpha1.ApplicationSetDiscovery
+}
+
+type mockModifier func(client *mockclient.MockServiceClient)
+
+func withMockClient(t *testing.T, mod mockModifier) *mockclient.MockServiceClient {
+	ctrl := gomock.NewController(t)
+	mock := mockclient.NewMockServiceClient(ctrl)
+	mod(mock)
+	return mock
+}
+
+type discoveryModifier func(*v1alpha1.ApplicationSetDiscovery)
+
+func discovery(m ...discoveryModifier) *v1alpha1.ApplicationSetDiscovery {
+	cr := &v1alpha1.ApplicationSetDiscovery{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withSpec(p v1alpha1.ApplicationSetDiscoveryParameters) discoveryModifier {
+	return func(r *v1alpha1.ApplicationSetDiscovery) { r.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.ApplicationSetDiscoveryObservation) discoveryModifier {
+	return func(r *v1alpha1.ApplicationSetDiscovery) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) discoveryModifier {
+	return func(r *v1alpha1.ApplicationSetDiscovery) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDeletionTimestamp() discoveryModifier {
+	return func(r *v1alpha1.ApplicationSetDiscovery) { r.SetDeletionTimestamp(&metav1.Time{Time: time.Now()}) }
+}
+
+func argoApplicationSet(namespace, name string) argocdv1alpha1.ApplicationSet {
+	a := argocdv1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	a.Spec.Template.Spec.Project = testProject
+	return a
+}
+
+func managedApplicationSet(externalName string, p v1alpha1.ApplicationSetParameters) v1alpha1.ApplicationSet {
+	mr := v1alpha1.ApplicationSet{}
+	mr.Spec.ForProvider = p
+	meta.SetExternalName(&mr, externalName)
+	return mr
+}
+
+func withManaged(items ...v1alpha1.ApplicationSet) client.Client {
+	return &test.MockClient{
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			if l, ok := obj.(*v1alpha1.ApplicationSetList); ok {
+				l.Items = items
+			}
+			return nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApplicationSetDiscovery
+		result managed.ExternalObservation
+		err    error
+	}
+
+	recently := metav1.Now()
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Discovered": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().List(
+						context.Background(),
+						&applicationset.ApplicationSetListQuery{Projects: []string{testProject}},
+					).Return(&argocdv1alpha1.ApplicationSetList{Items: []argocdv1alpha1.ApplicationSet{
+						argoApplicationSet(testAppsetNs, "managed"),
+						argoApplicationSet(testOtherAppsets, "managed-in-namespace"),
+						argoApplicationSet(testOtherAppsets, "shadow"),
+						argoApplicationSet(testAppsetNs, "previewed"),
+					}}, nil)
+				}),
+				kube: withManaged(
+					managedApplicationSet("managed", v1alpha1.ApplicationSetParameters{}),
+					managedApplicationSet("managed-in-namespace", v1alpha1.ApplicationSetParameters{AppsetNamespace: &testOtherAppsets}),
+					managedApplicationSet("shadow", v1alpha1.ApplicationSetParameters{AppsetNamespace: &testAppsetNs}),
+					managedApplicationSet("previewed", v1alpha1.ApplicationSetParameters{Preview: ptr.To(true)}),
+				),
+				cr: discovery(
+					withSpec(v1alpha1.ApplicationSetDiscoveryParameters{Projects: []string{testProject}}),
+				),
+			},
+			want: want{
+				cr: discovery(
+					withSpec(v1alpha1.ApplicationSetDiscoveryParameters{Projects: []string{testProject}}),
+					withObservation(v1alpha1.ApplicationSetDiscoveryObservation{
+						ApplicationSets: 4,
+						Managed:         2,
+						Unmanaged:       2,
+						UnmanagedApplicationSets: []v1alpha1.DiscoveredApplicationSet{
+							{Name: "previewed", Namespace: &testAppsetNs, Project: &testProject},
+							{Name: "shadow", Namespace: &testOtherAppsets, Project: &testProject},
+						},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"WithinRefreshInterval": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {}),
+				cr: discovery(
+					withSpec(v1alpha1.ApplicationSetDiscoveryParameters{
+						RefreshInterval: &metav1.Duration{Duration: time.Hour},
+					}),
+					withObservation(v1alpha1.ApplicationSetDiscoveryObservation{
+						ApplicationSets: 1,
+						Managed:         1,
+						LastRefreshTime: &recently,
+					}),
+				),
+			},
+			want: want{
+				cr: discovery(
+					withSpec(v1alpha1.ApplicationSetDiscoveryParameters{
+						RefreshInterval: &metav1.Duration{Duration: time.Hour},
+					}),
+					withObservation(v1alpha1.ApplicationSetDiscoveryObservation{
+						ApplicationSets: 1,
+						Managed:         1,
+						LastRefreshTime: &recently,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {}),
+				cr:     discovery(withDeletionTimestamp()),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ListFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().List(
+						context.Background(),
+						&applicationset.ApplicationSetListQuery{},
+					).Return(nil, errBoom)
+				}),
+				cr: discovery(),
+			},
+			want: want{
+				cr:  discovery(),
+				err: errors.Wrap(errBoom, errListApplicationSetsFailed),
+			},
+		},
+		"ListManagedFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().List(
+						context.Background(),
+						&applicationset.ApplicationSetListQuery{},
+					).Return(&argocdv1alpha1.ApplicationSetList{}, nil)
+				}),
+				kube: &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+				cr:   discovery(),
+			},
+			want: want{
+				cr:  discovery(),
+				err: errors.Wrap(errBoom, errListManagedFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.cr != nil {
+				if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.ApplicationSetDiscoveryObservation{}, "LastRefreshTime")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateDiscoveryObservationBounded(t *testing.T) {
+	items := make([]argocdv1alpha1.ApplicationSet, maxUnmanagedApplicationSets+10)
+	for i := range items {
+		items[i] = argoApplicationSet(testAppsetNs, fmt.Sprintf("appset-%03d", i))
+	}
+
+	got := generateDiscoveryObservation(items, nil)
+	if got.Unmanaged != maxUnmanagedApplicationSets+10 {
+		t.Errorf("r: want %d unmanaged, got %d", maxUnmanagedApplicationSets+10, got.Unmanaged)
+	}
+	if len(got.UnmanagedApplicationSets) != maxUnmanagedApplicationSets {
+		t.Errorf("r: want %d items, got %d", maxUnmanagedApplicationSets, len(got.UnmanagedApplicationSets))
+	}
+}
+
+func TestSameServer(t *testing.T) {
+	servers := map[types.NamespacedName]string{
+		{Name: "default"}:                     "argocd.example.com",
+		{Namespace: "team-a", Name: "argocd"}: "argocd.example.com",
+		{Namespace: "team-a", Name: "other"}:  "other.example.com",
+	}
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			server, ok := servers[key]
+			if !ok {
+				return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			return runtime.DefaultUnstructuredConverter.FromUnstructured(map[string]any{
+				"spec": map[string]any{"serverAddr": server},
+			}, obj)
+		},
+	}
+	namespaced := func(providerConfig string) *namespacedv1alpha1.ApplicationSet {
+		mr := &namespacedv1alpha1.ApplicationSet{}
+		mr.SetNamespace("team-a")
+		if providerConfig != "" {
+			mr.Spec.ProviderConfigReference = &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: providerConfig}
+		}
+		return mr
+	}
+
+	type want struct {
+		same bool
+		err  error
+	}
+
+	cases := map[string]struct {
+		ref xpv1.ProviderConfigReference
+		mr  *namespacedv1alpha1.ApplicationSet
+		want
+	}{
+		"SameServer": {
+			ref:  xpv1.ProviderConfigReference{Name: "default"},
+			mr:   namespaced("argocd"),
+			want: want{same: true},
+		},
+		"OtherServer": {
+			ref: xpv1.ProviderConfigReference{Name: "default"},
+			mr:  namespaced("other"),
+		},
+		"NoProviderConfig": {
+			ref:  xpv1.ProviderConfigReference{},
+			mr:   namespaced(""),
+			want: want{same: true},
+		},
+		"GetFailed": {
+			ref:  xpv1.ProviderConfigReference{Name: "default"},
+			mr:   namespaced("missing"),
+			want: want{err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{}, "missing"), errGetProviderConfigFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: kube}
+			same, err := e.sameServer(context.Background(), tc.ref, tc.mr, map[types.NamespacedName]string{})
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.same, same); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
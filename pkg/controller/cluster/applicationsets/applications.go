@@ -0,0 +1,145 @@
+package applicationsets
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argoio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applicationsets/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+)
+
+const (
+	errCreateApplicationsClient = "cannot create argocd applications client"
+	errListApplications         = "failed to list generated Applications with ArgoCD instance"
+
+	// maxSummarizedApplications limits the number of applications listed in
+	// the summary of the generated applications.
+	maxSummarizedApplications = 20
+)
+
+// observeApplications adds the summary of the generated applications to the
+// status if it was opted in. If healthy applications are required, the
+// application set is unavailable as long as any of them is not healthy.
+func (e *external) observeApplications(ctx context.Context, cr *v1alpha1.ApplicationSet, appset *argov1alpha1.ApplicationSet) error {
+	requireHealthy := ptr.Deref(cr.Spec.ForProvider.RequireHealthyApplications, false)
+	if !requireHealthy && !ptr.Deref(cr.Spec.ForProvider.ObserveApplications, false) {
+		return nil
+	}
+
+	resources, err := e.generatedApplications(ctx, appset)
+	if err != nil {
+		return err
+	}
+	s := generateApplicationsSummary(resources)
+	cr.Status.AtProvider.GeneratedApplications = s
+
+	if unhealthy := s.Applications - s.Health[string(health.HealthStatusHealthy)]; requireHealthy && unhealthy > 0 {
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("%d of %d generated applications are not healthy", unhealthy, s.Applications)))
+	}
+	return nil
+}
+
+// generatedApplications returns the status of the applications generated by
+// the application set. The ApplicationSet controller of ArgoCD can keep it in
+// the status resources of the application set, which are empty on default
+// installations. The applications owned by the application set are listed
+// then.
+func (e *external) generatedApplications(ctx context.Context, appset *argov1alpha1.ApplicationSet) ([]argov1alpha1.ResourceStatus, error) {
+	if len(appset.Status.Resources) > 0 {
+		return appset.Status.Resources, nil
+	}
+
+	conn, client, err := e.newApplicationsClientFn()
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateApplicationsClient)
+	}
+	defer argoio.Close(conn)
+
+	list, err := client.List(ctx, &application.ApplicationQuery{
+		AppNamespace: clients.StringToPtr(appset.Namespace),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListApplications)
+	}
+
+	var resources []argov1alpha1.ResourceStatus
+	for i := range list.Items {
+		app := &list.Items[i]
+		if !isOwnedBy(app, appset) {
+			continue
+		}
+		resources = append(resources, argov1alpha1.ResourceStatus{
+			Group:     argov1alpha1.ApplicationSchemaGroupVersionKind.Group,
+			Version:   argov1alpha1.ApplicationSchemaGroupVersionKind.Version,
+			Kind:      argov1alpha1.ApplicationSchemaGroupVersionKind.Kind,
+			Name:      app.Name,
+			Namespace: app.Namespace,
+			Status:    app.Status.Sync.Status,
+			Health:    &argov1alpha1.HealthStatus{Status: app.Status.Health.Status, Message: app.Status.Health.Message},
+		})
+	}
+	return resources, nil
+}
+
+// isOwnedBy reports whether the application was generated by the application set.
+func isOwnedBy(app *argov1alpha1.Application, appset *argov1alpha1.ApplicationSet) bool {
+	for _, ref := range app.OwnerReferences {
+		if ref.Kind == argov1alpha1.ApplicationSetSchemaGroupVersionKind.Kind && ref.Name == appset.Name &&
+			(appset.UID == "" || ref.UID == appset.UID) {
+			return true
+		}
+	}
+	return false
+}
+
+// generateApplicationsSummary counts the generated applications by health and
+// sync status and lists the ones that are not healthy.
+func generateApplicationsSummary(resources []argov1alpha1.ResourceStatus) *v1alpha1.GeneratedApplicationsSummary {
+	s := &v1alpha1.GeneratedApplicationsSummary{}
+	var unhealthy []v1alpha1.GeneratedApplicationSummary
+	for _, res := range resources {
+		if res.Kind != argov1alpha1.ApplicationSchemaGroupVersionKind.Kind {
+			continue
+		}
+		h := ptr.Deref(res.Health, argov1alpha1.HealthStatus{})
+		s.Applications++
+		if s.Health == nil {
+			s.Health = map[string]int64{}
+			s.Sync = map[string]int64{}
+		}
+		s.Health[string(h.Status)]++
+		s.Sync[string(res.Status)]++
+		if h.Status != health.HealthStatusHealthy {
+			unhealthy = append(unhealthy, v1alpha1.GeneratedApplicationSummary{
+				Name:      res.Name,
+				Namespace: clients.StringToPtr(res.Namespace),
+				Health:    clients.StringToPtr(string(h.Status)),
+				Sync:      clients.StringToPtr(string(res.Status)),
+				Message:   clients.StringToPtr(h.Message),
+			})
+		}
+	}
+
+	// sort the applications, so the status does not change with the order
+	// returned by ArgoCD
+	slices.SortFunc(unhealthy, func(a, b v1alpha1.GeneratedApplicationSummary) int {
+		return cmp.Or(
+			cmp.Compare(clients.StringValue(a.Namespace), clients.StringValue(b.Namespace)),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	if len(unhealthy) > 0 {
+		s.Unhealthy = unhealthy[:min(len(unhealthy), maxSummarizedApplications)]
+	}
+	return s
+}
@@ -0,0 +1,193 @@
+package applicationsets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argoio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applicationsets/v1alpha1"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applications"
+	mockapps "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/applications"
+)
+
+func withMockApplicationsClient(t *testing.T, mod func(*mockapps.MockServiceClient)) func() (argoio.Closer, applications.ServiceClient, error) {
+	ctrl := gomock.NewController(t)
+	mock := mockapps.NewMockServiceClient(ctrl)
+	mod(mock)
+	return func() (argoio.Closer, applications.ServiceClient, error) {
+		return argoio.NewCloser(func() error { return nil }), mock, nil
+	}
+}
+
+func generatedApplication(name string, h health.HealthStatusCode, s argov1alpha1.SyncStatusCode) argov1alpha1.ResourceStatus {
+	return argov1alpha1.ResourceStatus{
+		Group:     argov1alpha1.ApplicationSchemaGroupVersionKind.Group,
+		Version:   argov1alpha1.ApplicationSchemaGroupVersionKind.Version,
+		Kind:      argov1alpha1.ApplicationSchemaGroupVersionKind.Kind,
+		Name:      name,
+		Namespace: testApplicationSetNamespace,
+		Status:    s,
+		Health:    &argov1alpha1.HealthStatus{Status: h},
+	}
+}
+
+func ownedApplication(name string, owner string, h health.HealthStatusCode, s argov1alpha1.SyncStatusCode) argov1alpha1.Application {
+	return argov1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testApplicationSetNamespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				Kind: argov1alpha1.ApplicationSetSchemaGroupVersionKind.Kind,
+				Name: owner,
+			}},
+		},
+		Status: argov1alpha1.ApplicationStatus{
+			Health: argov1alpha1.HealthStatus{Status: h},
+			Sync:   argov1alpha1.SyncStatus{Status: s},
+		},
+	}
+}
+
+func TestObserveApplications(t *testing.T) {
+	type args struct {
+		newApplicationsClientFn func() (argoio.Closer, applications.ServiceClient, error)
+		cr                      *v1alpha1.ApplicationSet
+		appset                  *argov1alpha1.ApplicationSet
+	}
+	type want struct {
+		cr  *v1alpha1.ApplicationSet
+		err error
+	}
+
+	appset := &argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: testApplicationSetExternalName, Namespace: testApplicationSetNamespace},
+		Status: argov1alpha1.ApplicationSetStatus{Resources: []argov1alpha1.ResourceStatus{
+			generatedApplication("dev", health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeSynced),
+			generatedApplication("prod", health.HealthStatusDegraded, argov1alpha1.SyncStatusCodeOutOfSync),
+		}},
+	}
+	// the status resources are empty on default installations of ArgoCD
+	appsetWithoutResources := &argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: testApplicationSetExternalName, Namespace: testApplicationSetNamespace},
+	}
+	listed := &argov1alpha1.ApplicationList{Items: []argov1alpha1.Application{
+		ownedApplication("dev", testApplicationSetExternalName, health.HealthStatusHealthy, argov1alpha1.SyncStatusCodeSynced),
+		ownedApplication("prod", testApplicationSetExternalName, health.HealthStatusDegraded, argov1alpha1.SyncStatusCodeOutOfSync),
+		ownedApplication("other", "other-appset", health.HealthStatusMissing, argov1alpha1.SyncStatusCodeOutOfSync),
+	}}
+	summary := &v1alpha1.GeneratedApplicationsSummary{
+		Applications: 2,
+		Health:       map[string]int64{"Healthy": 1, "Degraded": 1},
+		Sync:         map[string]int64{"Synced": 1, "OutOfSync": 1},
+		Unhealthy: []v1alpha1.GeneratedApplicationSummary{{
+			Name:      "prod",
+			Namespace: ptr.To(testApplicationSetNamespace),
+			Health:    ptr.To("Degraded"),
+			Sync:      ptr.To("OutOfSync"),
+		}},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Disabled": {
+			args: args{
+				cr:     ApplicationSet(withConditions(xpv1.Available())),
+				appset: appset,
+			},
+			want: want{
+				cr: ApplicationSet(withConditions(xpv1.Available())),
+			},
+		},
+		"Observed": {
+			args: args{
+				cr: ApplicationSet(
+					withSpec(v1alpha1.ApplicationSetParameters{ObserveApplications: ptr.To(true)}),
+					withConditions(xpv1.Available()),
+				),
+				appset: appset,
+			},
+			want: want{
+				cr: ApplicationSet(
+					withSpec(v1alpha1.ApplicationSetParameters{ObserveApplications: ptr.To(true)}),
+					withObservation(v1alpha1.ArgoApplicationSetStatus{GeneratedApplications: summary}),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"ObservedWithoutStatusResources": {
+			args: args{
+				newApplicationsClientFn: withMockApplicationsClient(t, func(mcs *mockapps.MockServiceClient) {
+					mcs.EXPECT().List(gomock.Any(), &application.ApplicationQuery{AppNamespace: ptr.To(testApplicationSetNamespace)}).Return(listed, nil)
+				}),
+				cr: ApplicationSet(
+					withSpec(v1alpha1.ApplicationSetParameters{ObserveApplications: ptr.To(true)}),
+					withConditions(xpv1.Available()),
+				),
+				appset: appsetWithoutResources,
+			},
+			want: want{
+				cr: ApplicationSet(
+					withSpec(v1alpha1.ApplicationSetParameters{ObserveApplications: ptr.To(true)}),
+					withObservation(v1alpha1.ArgoApplicationSetStatus{GeneratedApplications: summary}),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"RequireHealthy": {
+			args: args{
+				cr: ApplicationSet(
+					withSpec(v1alpha1.ApplicationSetParameters{RequireHealthyApplications: ptr.To(true)}),
+					withConditions(xpv1.Available()),
+				),
+				appset: appset,
+			},
+			want: want{
+				cr: ApplicationSet(
+					withSpec(v1alpha1.ApplicationSetParameters{RequireHealthyApplications: ptr.To(true)}),
+					withObservation(v1alpha1.ArgoApplicationSetStatus{GeneratedApplications: summary}),
+					withConditions(xpv1.Unavailable().WithMessage("1 of 2 generated applications are not healthy")),
+				),
+			},
+		},
+		"ListFailed": {
+			args: args{
+				newApplicationsClientFn: withMockApplicationsClient(t, func(mcs *mockapps.MockServiceClient) {
+					mcs.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errBoom)
+				}),
+				cr:     ApplicationSet(withSpec(v1alpha1.ApplicationSetParameters{ObserveApplications: ptr.To(true)})),
+				appset: appsetWithoutResources,
+			},
+			want: want{
+				cr:  ApplicationSet(withSpec(v1alpha1.ApplicationSetParameters{ObserveApplications: ptr.To(true)})),
+				err: errors.Wrap(errBoom, errListApplications),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{newApplicationsClientFn: tc.args.newApplicationsClientFn}
+			err := e.observeApplications(context.Background(), tc.args.cr, tc.args.appset)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package applicationsets
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applicationsets/v1alpha1"
+)
+
+const (
+	errCreateArgoCDKubeClient       = "cannot create client of the cluster ArgoCD is running in"
+	errSecretNamespaceRequired      = "namespace of the generator secret is required"
+	errSecretNamespaceMismatch      = "generator secrets can only be mirrored from the namespace of the application set"
+	errListGeneratorSecrets         = "cannot list mirrored generator secrets"
+	errFmtGetGeneratorSecret        = "cannot get generator secret %s/%s"
+	errFmtWriteGeneratorSecret      = "cannot write mirrored generator secret %s/%s"
+	errFmtDeleteGeneratorSecret     = "cannot delete mirrored generator secret %s/%s"
+	errFmtGeneratorSecretNotManaged = "secret %s/%s already exists and is not mirrored for this application set"
+
+	// labelPartOf marks secrets the ApplicationSet controller of ArgoCD is
+	// allowed to read.
+	labelPartOf  = "app.kubernetes.io/part-of"
+	partOfArgoCD = "argocd"
+
+	labelGeneratorSecret     = "argocd.crossplane.io/generator-secret"
+	annotationApplicationSet = "argocd.crossplane.io/applicationset"
+)
+
+// usesGeneratorSecrets reports whether the application set mirrors generator
+// secrets, or did so before and the mirrored secrets have to be cleaned up.
+func usesGeneratorSecrets(cr *v1alpha1.ApplicationSet) bool {
+	return len(cr.Spec.ForProvider.GeneratorSecrets) > 0 || len(cr.Status.AtProvider.GeneratorSecrets) > 0
+}
+
+// observeGeneratorSecrets records the mirrored generator secrets in the
+// status and reports whether they are in sync with their sources.
+func (e *external) observeGeneratorSecrets(ctx context.Context, cr *v1alpha1.ApplicationSet) (bool, error) {
+	if !usesGeneratorSecrets(cr) {
+		return true, nil
+	}
+	argoKube, namespace, err := e.argoCDKube(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	desired, err := e.desiredGeneratorSecrets(ctx, cr, namespace)
+	if err != nil {
+		return false, err
+	}
+	mirrored, err := listGeneratorSecrets(ctx, argoKube, cr, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	cr.Status.AtProvider.GeneratorSecrets = slices.Sorted(maps.Keys(mirrored))
+
+	if len(desired) != len(mirrored) {
+		return false, nil
+	}
+	for _, d := range desired {
+		m, ok := mirrored[d.Name]
+		if !ok || !maps.EqualFunc(d.Data, m.Data, bytes.Equal) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// syncGeneratorSecrets creates or updates the mirrored generator secrets and
+// deletes the ones that are no longer referenced.
+func (e *external) syncGeneratorSecrets(ctx context.Context, cr *v1alpha1.ApplicationSet) error {
+	if !usesGeneratorSecrets(cr) {
+		return nil
+	}
+	argoKube, namespace, err := e.argoCDKube(ctx, cr)
+	if err != nil {
+		return err
+	}
+	desired, err := e.desiredGeneratorSecrets(ctx, cr, namespace)
+	if err != nil {
+		return err
+	}
+	mirrored, err := listGeneratorSecrets(ctx, argoKube, cr, namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range desired {
+		if m, ok := mirrored[d.Name]; ok {
+			m.Labels = d.Labels
+			m.Annotations = d.Annotations
+			m.Data = d.Data
+			err = argoKube.Update(ctx, m)
+		} else {
+			err = argoKube.Create(ctx, d)
+			if kerrors.IsAlreadyExists(err) {
+				return errors.Errorf(errFmtGeneratorSecretNotManaged, namespace, d.Name)
+			}
+		}
+		if err != nil {
+			return errors.Wrapf(err, errFmtWriteGeneratorSecret, namespace, d.Name)
+		}
+		delete(mirrored, d.Name)
+	}
+	return deleteSecrets(ctx, argoKube, mirrored)
+}
+
+// deleteGeneratorSecrets deletes all secrets mirrored for the application set.
+func (e *external) deleteGeneratorSecrets(ctx context.Context, cr *v1alpha1.ApplicationSet) error {
+	if !usesGeneratorSecrets(cr) {
+		return nil
+	}
+	argoKube, namespace, err := e.argoCDKube(ctx, cr)
+	if err != nil {
+		return err
+	}
+	mirrored, err := listGeneratorSecrets(ctx, argoKube, cr, namespace)
+	if err != nil {
+		return err
+	}
+	return deleteSecrets(ctx, argoKube, mirrored)
+}
+
+// argoCDKube returns a client of the cluster ArgoCD is running in and the
+// namespace the generator secrets are mirrored into, which is the namespace
+// of the application set.
+func (e *external) argoCDKube(ctx context.Context, cr *v1alpha1.ApplicationSet) (client.Client, string, error) {
+	argoKube, namespace, err := e.newArgoCDKubeFn(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errCreateArgoCDKubeClient)
+	}
+	return argoKube, ptr.Deref(cr.Spec.ForProvider.AppsetNamespace, namespace), nil
+}
+
+// desiredGeneratorSecrets reads the referenced secrets local to the provider
+// and returns the secrets to mirror into the namespace.
+func (e *external) desiredGeneratorSecrets(ctx context.Context, cr *v1alpha1.ApplicationSet, namespace string) ([]*corev1.Secret, error) {
+	desired := make([]*corev1.Secret, 0, len(cr.Spec.ForProvider.GeneratorSecrets))
+	for _, gs := range cr.Spec.ForProvider.GeneratorSecrets {
+		sourceNamespace, err := generatorSecretNamespace(cr, gs.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+		source := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: sourceNamespace, Name: gs.SecretRef.Name}, source); err != nil {
+			return nil, errors.Wrapf(err, errFmtGetGeneratorSecret, sourceNamespace, gs.SecretRef.Name)
+		}
+		desired = append(desired, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      gs.Name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					labelPartOf:          partOfArgoCD,
+					labelGeneratorSecret: "true",
+				},
+				Annotations: map[string]string{
+					annotationApplicationSet: meta.GetExternalName(cr),
+				},
+			},
+			Data: source.Data,
+		})
+	}
+	return desired, nil
+}
+
+// listGeneratorSecrets returns the secrets mirrored for the application set by name.
+func listGeneratorSecrets(ctx context.Context, argoKube client.Client, cr *v1alpha1.ApplicationSet, namespace string) (map[string]*corev1.Secret, error) {
+	l := &corev1.SecretList{}
+	if err := argoKube.List(ctx, l, client.InNamespace(namespace), client.MatchingLabels{labelGeneratorSecret: "true"}); err != nil {
+		return nil, errors.Wrap(err, errListGeneratorSecrets)
+	}
+	mirrored := map[string]*corev1.Secret{}
+	for i := range l.Items {
+		s := &l.Items[i]
+		if s.Annotations[annotationApplicationSet] == meta.GetExternalName(cr) {
+			mirrored[s.Name] = s
+		}
+	}
+	return mirrored, nil
+}
+
+func deleteSecrets(ctx context.Context, argoKube client.Client, secrets map[string]*corev1.Secret) error {
+	for _, s := range secrets {
+		if err := client.IgnoreNotFound(argoKube.Delete(ctx, s)); err != nil {
+			return errors.Wrapf(err, errFmtDeleteGeneratorSecret, s.Namespace, s.Name)
+		}
+	}
+	return nil
+}
+
+// generatorSecretNamespace returns the namespace of the source secret.
+// Namespaced application sets may only mirror secrets of their own namespace.
+func generatorSecretNamespace(cr *v1alpha1.ApplicationSet, ref v1alpha1.GeneratorSecretSource) (string, error) {
+	namespace := ptr.Deref(ref.Namespace, "")
+	if cr.GetNamespace() != "" {
+		if namespace != "" && namespace != cr.GetNamespace() {
+			return "", errors.New(errSecretNamespaceMismatch)
+		}
+		return cr.GetNamespace(), nil
+	}
+	if namespace == "" {
+		return "", errors.New(errSecretNamespaceRequired)
+	}
+	return namespace, nil
+}
@@ -0,0 +1,393 @@
+package applicationsets
+
+import (
+	"context"
+	"testing"
+
+	argoapplicationset "github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applicationsets/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/applicationsets"
+)
+
+const (
+	testArgoCDNamespace = "argocd"
+	testSecretName      = "github-token"
+)
+
+// generatorSecretsKube records the secrets written to the cluster ArgoCD is
+// running in.
+type generatorSecretsKube struct {
+	mirrored  []corev1.Secret
+	written   []*corev1.Secret
+	deleted   []string
+	errWrite  error
+	errDelete error
+}
+
+func (k *generatorSecretsKube) client() client.Client {
+	return &test.MockClient{
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*corev1.SecretList).Items = k.mirrored
+			return nil
+		},
+		MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+			k.written = append(k.written, obj.(*corev1.Secret))
+			return k.errWrite
+		},
+		MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+			k.written = append(k.written, obj.(*corev1.Secret))
+			return k.errWrite
+		},
+		MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+			k.deleted = append(k.deleted, obj.GetName())
+			return k.errDelete
+		},
+	}
+}
+
+func localSecretKube(data map[string][]byte) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = data
+			return nil
+		},
+	}
+}
+
+func mirroredSecret(name string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testArgoCDNamespace,
+			Labels: map[string]string{
+				labelPartOf:          partOfArgoCD,
+				labelGeneratorSecret: "true",
+			},
+			Annotations: map[string]string{
+				annotationApplicationSet: testApplicationSetExternalName,
+			},
+		},
+		Data: data,
+	}
+}
+
+func withGeneratorSecrets() ApplicationSetModifier {
+	return func(r *v1alpha1.ApplicationSet) {
+		r.Spec.ForProvider.GeneratorSecrets = []v1alpha1.GeneratorSecret{{
+			Name:      testSecretName,
+			SecretRef: v1alpha1.GeneratorSecretSource{Name: "team-token", Namespace: ptr.To("crossplane-system")},
+		}}
+	}
+}
+
+func TestSyncGeneratorSecrets(t *testing.T) {
+	token := map[string][]byte{"token": []byte("secret")}
+	stale := map[string][]byte{"token": []byte("stale")}
+
+	type args struct {
+		argoKube *generatorSecretsKube
+		kubeErr  error
+		cr       *v1alpha1.ApplicationSet
+	}
+	type want struct {
+		written []*corev1.Secret
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Created": {
+			args: args{
+				argoKube: &generatorSecretsKube{},
+				cr:       ApplicationSet(withExternalName(testApplicationSetExternalName), withGeneratorSecrets()),
+			},
+			want: want{
+				written: []*corev1.Secret{mirroredSecret(testSecretName, token)},
+			},
+		},
+		"Updated": {
+			args: args{
+				argoKube: &generatorSecretsKube{mirrored: []corev1.Secret{*mirroredSecret(testSecretName, stale)}},
+				cr:       ApplicationSet(withExternalName(testApplicationSetExternalName), withGeneratorSecrets()),
+			},
+			want: want{
+				written: []*corev1.Secret{mirroredSecret(testSecretName, token)},
+			},
+		},
+		"StaleDeleted": {
+			args: args{
+				argoKube: &generatorSecretsKube{mirrored: []corev1.Secret{*mirroredSecret("old-token", token)}},
+				cr: ApplicationSet(
+					withExternalName(testApplicationSetExternalName),
+					withObservation(v1alpha1.ArgoApplicationSetStatus{GeneratorSecrets: []string{"old-token"}}),
+				),
+			},
+			want: want{
+				deleted: []string{"old-token"},
+			},
+		},
+		"NotManaged": {
+			args: args{
+				argoKube: &generatorSecretsKube{errWrite: kerrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, testSecretName)},
+				cr:       ApplicationSet(withExternalName(testApplicationSetExternalName), withGeneratorSecrets()),
+			},
+			want: want{
+				written: []*corev1.Secret{mirroredSecret(testSecretName, token)},
+				err:     errors.Errorf(errFmtGeneratorSecretNotManaged, testArgoCDNamespace, testSecretName),
+			},
+		},
+		"NoKubernetesConfig": {
+			args: args{
+				argoKube: &generatorSecretsKube{},
+				kubeErr:  errBoom,
+				cr:       ApplicationSet(withExternalName(testApplicationSetExternalName), withGeneratorSecrets()),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateArgoCDKubeClient),
+			},
+		},
+		"Unused": {
+			args: args{
+				argoKube: &generatorSecretsKube{},
+				kubeErr:  errBoom,
+				cr:       ApplicationSet(withExternalName(testApplicationSetExternalName)),
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				kube: localSecretKube(token),
+				newArgoCDKubeFn: func(context.Context) (client.Client, string, error) {
+					return tc.args.argoKube.client(), testArgoCDNamespace, tc.args.kubeErr
+				},
+			}
+			err := e.syncGeneratorSecrets(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.written, tc.args.argoKube.written); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, tc.args.argoKube.deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserveGeneratorSecrets(t *testing.T) {
+	token := map[string][]byte{"token": []byte("secret")}
+
+	cases := map[string]struct {
+		mirrored []corev1.Secret
+		want     bool
+	}{
+		"InSync": {
+			mirrored: []corev1.Secret{*mirroredSecret(testSecretName, token)},
+			want:     true,
+		},
+		"Changed": {
+			mirrored: []corev1.Secret{*mirroredSecret(testSecretName, map[string][]byte{"token": []byte("stale")})},
+		},
+		"Missing": {},
+		"Stale": {
+			mirrored: []corev1.Secret{*mirroredSecret(testSecretName, token), *mirroredSecret("old-token", token)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			argoKube := &generatorSecretsKube{mirrored: tc.mirrored}
+			e := &external{
+				kube: localSecretKube(token),
+				newArgoCDKubeFn: func(context.Context) (client.Client, string, error) {
+					return argoKube.client(), testArgoCDNamespace, nil
+				},
+			}
+			got, err := e.observeGeneratorSecrets(context.Background(), ApplicationSet(withExternalName(testApplicationSetExternalName), withGeneratorSecrets()))
+			if err != nil {
+				t.Fatalf("r: unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("r: want up to date %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGeneratorSecretsCleanup(t *testing.T) {
+	token := map[string][]byte{"token": []byte("secret")}
+	mirrored := func() []corev1.Secret { return []corev1.Secret{*mirroredSecret(testSecretName, token)} }
+	withMirrored := withObservation(v1alpha1.ArgoApplicationSetStatus{GeneratorSecrets: []string{testSecretName}})
+
+	type args struct {
+		client   *mockclient.MockServiceClient
+		argoKube *generatorSecretsKube
+		cr       *v1alpha1.ApplicationSet
+	}
+	type want struct {
+		upToDate bool
+		secrets  []string
+		deleted  []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		delete bool
+		args
+		want
+	}{
+		// the status of the mirrored secrets survives the observation, so
+		// removing all generator secrets from the spec prunes them
+		"ObserveRemoved": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&argocdv1alpha1.ApplicationSet{}, nil)
+				}),
+				argoKube: &generatorSecretsKube{mirrored: mirrored()},
+				cr:       ApplicationSet(withExternalName(testApplicationSetExternalName), withMirrored),
+			},
+			want: want{
+				secrets: []string{testSecretName},
+			},
+		},
+		"ObserveInSync": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&argocdv1alpha1.ApplicationSet{}, nil)
+				}),
+				argoKube: &generatorSecretsKube{mirrored: mirrored()},
+				cr:       ApplicationSet(withExternalName(testApplicationSetExternalName), withGeneratorSecrets(), withMirrored),
+			},
+			want: want{
+				upToDate: true,
+				secrets:  []string{testSecretName},
+			},
+		},
+		"Delete": {
+			delete: true,
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(&argoapplicationset.ApplicationSetResponse{}, nil)
+				}),
+				argoKube: &generatorSecretsKube{mirrored: mirrored()},
+				cr:       ApplicationSet(withExternalName(testApplicationSetExternalName), withMirrored),
+			},
+			want: want{
+				secrets: []string{testSecretName},
+				deleted: []string{testSecretName},
+			},
+		},
+		// the application set is kept until the secrets are deleted, as
+		// they would leak once it is gone
+		"DeleteSecretsFailed": {
+			delete: true,
+			args: args{
+				client:   withMockClient(t, func(mcs *mockclient.MockServiceClient) {}),
+				argoKube: &generatorSecretsKube{mirrored: mirrored(), errDelete: errBoom},
+				cr:       ApplicationSet(withExternalName(testApplicationSetExternalName), withMirrored),
+			},
+			want: want{
+				secrets: []string{testSecretName},
+				deleted: []string{testSecretName},
+				err:     errors.Wrapf(errBoom, errFmtDeleteGeneratorSecret, testArgoCDNamespace, testSecretName),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.args.client,
+				kube:   localSecretKube(token),
+				newArgoCDKubeFn: func(context.Context) (client.Client, string, error) {
+					return tc.args.argoKube.client(), testArgoCDNamespace, nil
+				},
+			}
+			var upToDate bool
+			var err error
+			if tc.delete {
+				_, err = e.Delete(context.Background(), tc.args.cr)
+			} else {
+				var o managed.ExternalObservation
+				o, err = e.Observe(context.Background(), tc.args.cr)
+				upToDate = o.ResourceUpToDate
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.secrets, tc.args.cr.Status.AtProvider.GeneratorSecrets); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, tc.args.argoKube.deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorSecretNamespace(t *testing.T) {
+	cases := map[string]struct {
+		namespace string
+		ref       v1alpha1.GeneratorSecretSource
+		want      string
+		err       error
+	}{
+		"Cluster": {
+			ref:  v1alpha1.GeneratorSecretSource{Name: testSecretName, Namespace: ptr.To("crossplane-system")},
+			want: "crossplane-system",
+		},
+		"ClusterNamespaceRequired": {
+			ref: v1alpha1.GeneratorSecretSource{Name: testSecretName},
+			err: errors.New(errSecretNamespaceRequired),
+		},
+		"Namespaced": {
+			namespace: "team-a",
+			ref:       v1alpha1.GeneratorSecretSource{Name: testSecretName},
+			want:      "team-a",
+		},
+		"NamespacedMismatch": {
+			namespace: "team-a",
+			ref:       v1alpha1.GeneratorSecretSource{Name: testSecretName, Namespace: ptr.To("team-b")},
+			err:       errors.New(errSecretNamespaceMismatch),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := ApplicationSet()
+			cr.SetNamespace(tc.namespace)
+			got, err := generatorSecretNamespace(cr, tc.ref)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if got != tc.want {
+				t.Errorf("r: want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
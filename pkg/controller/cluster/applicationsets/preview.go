@@ -0,0 +1,111 @@
+package applicationsets
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applicationsets/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	appsets "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applicationsets"
+)
+
+const (
+	errGenerateFailed = "failed to generate applications of ApplicationSet with ArgoCD instance"
+
+	// maxPreviewApplications limits the number of applications listed in the preview.
+	maxPreviewApplications = 100
+)
+
+// preview observes an application set in preview mode. The generated
+// applications are stored in the status and the application set is reported
+// as existing and up to date, so it is never created or updated. A deleted
+// managed resource is only reported as existing if the application set was
+// created in ArgoCD before preview mode was enabled, so it is deleted along
+// with its mirrored generator secrets.
+func (e *external) preview(ctx context.Context, cr *v1alpha1.ApplicationSet) (managed.ExternalObservation, error) {
+	if meta.WasDeleted(cr) {
+		exists, err := e.applicationSetExists(ctx, cr)
+		return managed.ExternalObservation{ResourceExists: exists}, err
+	}
+
+	req := &applicationset.ApplicationSetGenerateRequest{
+		ApplicationSet: e.generateCreateApplicationSetRequest(cr).Applicationset,
+	}
+	res, err := e.client.Generate(ctx, req)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGenerateFailed)
+	}
+
+	// the mirrored generator secrets of an application set created before
+	// preview mode was enabled are still cleaned up on deletion
+	cr.Status.AtProvider = v1alpha1.ArgoApplicationSetStatus{
+		Preview:          generatePreview(res.Applications),
+		GeneratorSecrets: cr.Status.AtProvider.GeneratorSecrets,
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// applicationSetExists reports whether the application set exists in ArgoCD.
+func (e *external) applicationSetExists(ctx context.Context, cr *v1alpha1.ApplicationSet) (bool, error) {
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return false, nil
+	}
+	_, err := e.client.Get(ctx, &applicationset.ApplicationSetGetQuery{
+		Name:            name,
+		AppsetNamespace: ptr.Deref(cr.Spec.ForProvider.AppsetNamespace, ""),
+	})
+	if appsets.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetApplicationSet)
+	}
+	return true, nil
+}
+
+func generatePreview(apps []*argov1alpha1.Application) *v1alpha1.ApplicationSetPreview {
+	p := &v1alpha1.ApplicationSetPreview{
+		Applications: int64(len(apps)),
+	}
+	for _, app := range apps {
+		if len(p.Items) == maxPreviewApplications {
+			break
+		}
+		if app == nil {
+			continue
+		}
+		item := v1alpha1.PreviewApplication{
+			Name:      app.Name,
+			Namespace: clients.StringToPtr(app.Namespace),
+			Project:   clients.StringToPtr(app.Spec.Project),
+			Destination: v1alpha1.PreviewDestination{
+				Server:    clients.StringToPtr(app.Spec.Destination.Server),
+				Name:      clients.StringToPtr(app.Spec.Destination.Name),
+				Namespace: clients.StringToPtr(app.Spec.Destination.Namespace),
+			},
+		}
+		for _, s := range app.Spec.GetSources() {
+			item.Sources = append(item.Sources, v1alpha1.PreviewSource{
+				RepoURL:        s.RepoURL,
+				Path:           clients.StringToPtr(s.Path),
+				Chart:          clients.StringToPtr(s.Chart),
+				TargetRevision: clients.StringToPtr(s.TargetRevision),
+			})
+		}
+		p.Items = append(p.Items, item)
+	}
+	return p
+}
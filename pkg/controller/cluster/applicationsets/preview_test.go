@@ -0,0 +1,191 @@
+package applicationsets
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	argoapplicationset "github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/applicationsets/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/applicationsets"
+)
+
+func TestObservePreview(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApplicationSet
+		result managed.ExternalObservation
+		err    error
+	}
+
+	params := v1alpha1.ApplicationSetParameters{
+		Template: v1alpha1.ApplicationSetTemplate{
+			ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: testTemplateName},
+		},
+		Preview: ptr.To(true),
+	}
+	generated := &argocdv1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-dev"},
+		Spec: argocdv1alpha1.ApplicationSpec{
+			Project: testProjectName,
+			Source: &argocdv1alpha1.ApplicationSource{
+				RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+				Path:           "guestbook",
+				TargetRevision: "HEAD",
+			},
+			Destination: argocdv1alpha1.ApplicationDestination{Name: "dev", Namespace: "guestbook"},
+		},
+	}
+
+	generatorSecrets := []string{"token"}
+	deleted := func(r *v1alpha1.ApplicationSet) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Generated": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Generate(context.Background(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, req *argoapplicationset.ApplicationSetGenerateRequest, _ ...any) (*argoapplicationset.ApplicationSetGenerateResponse, error) {
+							if req.ApplicationSet.Name != testApplicationSetExternalName || req.ApplicationSet.Spec.Template.Name != testTemplateName {
+								t.Errorf("unexpected generate request: %v", req.ApplicationSet)
+							}
+							return &argoapplicationset.ApplicationSetGenerateResponse{
+								Applications: []*argocdv1alpha1.Application{generated},
+							}, nil
+						})
+				}),
+				cr: ApplicationSet(withExternalName(testApplicationSetExternalName), withSpec(params)),
+			},
+			want: want{
+				cr: ApplicationSet(
+					withExternalName(testApplicationSetExternalName),
+					withSpec(params),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.ArgoApplicationSetStatus{
+						Preview: &v1alpha1.ApplicationSetPreview{
+							Applications: 1,
+							Items: []v1alpha1.PreviewApplication{{
+								Name:    "guestbook-dev",
+								Project: ptr.To(testProjectName),
+								Destination: v1alpha1.PreviewDestination{
+									Name:      ptr.To("dev"),
+									Namespace: ptr.To("guestbook"),
+								},
+								Sources: []v1alpha1.PreviewSource{{
+									RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+									Path:           ptr.To("guestbook"),
+									TargetRevision: ptr.To("HEAD"),
+								}},
+							}},
+						},
+					}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"KeepGeneratorSecrets": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Generate(context.Background(), gomock.Any()).Return(&argoapplicationset.ApplicationSetGenerateResponse{}, nil)
+				}),
+				cr: ApplicationSet(withExternalName(testApplicationSetExternalName), withSpec(params),
+					withObservation(v1alpha1.ArgoApplicationSetStatus{GeneratorSecrets: generatorSecrets})),
+			},
+			want: want{
+				cr: ApplicationSet(
+					withExternalName(testApplicationSetExternalName),
+					withSpec(params),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.ArgoApplicationSetStatus{
+						Preview:          &v1alpha1.ApplicationSetPreview{},
+						GeneratorSecrets: generatorSecrets,
+					}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Get(context.Background(), &argoapplicationset.ApplicationSetGetQuery{Name: testApplicationSetExternalName}).Return(nil, notFoundErr())
+				}),
+				cr: ApplicationSet(withExternalName(testApplicationSetExternalName), withSpec(params), deleted),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DeletedAfterCreation": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Get(context.Background(), &argoapplicationset.ApplicationSetGetQuery{Name: testApplicationSetExternalName}).Return(&argocdv1alpha1.ApplicationSet{}, nil)
+				}),
+				cr: ApplicationSet(withExternalName(testApplicationSetExternalName), withSpec(params), deleted),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"GenerateFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Generate(context.Background(), gomock.Any()).Return(nil, errBoom)
+				}),
+				cr: ApplicationSet(withExternalName(testApplicationSetExternalName), withSpec(params)),
+			},
+			want: want{
+				cr:  ApplicationSet(withExternalName(testApplicationSetExternalName), withSpec(params)),
+				err: errors.Wrap(errBoom, errGenerateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.cr != nil {
+				if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePreviewBounded(t *testing.T) {
+	apps := make([]*argocdv1alpha1.Application, maxPreviewApplications+10)
+	for i := range apps {
+		apps[i] = &argocdv1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("app-%d", i)}}
+	}
+
+	got := generatePreview(apps)
+	if got.Applications != maxPreviewApplications+10 {
+		t.Errorf("r: want %d applications, got %d", maxPreviewApplications+10, got.Applications)
+	}
+	if len(got.Items) != maxPreviewApplications {
+		t.Errorf("r: want %d items, got %d", maxPreviewApplications, len(got.Items))
+	}
+}
@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"testing"
+	"time"
 
 	argocdCluster "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
 	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -28,12 +29,16 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/crossplane-contrib/provider-argocd/apis/cluster/cluster/v1alpha1"
 	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/cluster"
 	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/metrics"
 )
 
 var (
@@ -43,6 +48,8 @@ var (
 	testClusterServer       = "https://example.com/"
 	testNamespaces          = [1]string{"default"}
 	testUsername            = "testuser"
+	testRefreshedAt         = metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	testRefreshRequestedAt  = metav1.NewTime(testRefreshedAt.Add(time.Hour))
 )
 
 type args struct {
@@ -365,6 +372,26 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+func TestObserveDeletesMetrics(t *testing.T) {
+	cr := Cluster(withExternalName(testClusterExternalName))
+	metrics.RecordCluster(cr, &argocdv1alpha1.ClusterInfo{})
+
+	e := &external{client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+		mcs.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errNotFound)
+	})}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := testutil.GatherAndCount(ctrlmetrics.Registry, "provider_argocd_cluster_connection_status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(0, n); diff != "" {
+		t.Errorf("series: -want, +got:\n%s", diff)
+	}
+}
+
 func TestCreate(t *testing.T) {
 	type want struct {
 		cr     *v1alpha1.Cluster
@@ -579,6 +606,79 @@ func TestUpdate(t *testing.T) {
 				err:    errors.Wrap(errBoom, errUpdateFailed),
 			},
 		},
+		"SuccessfulRefresh": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Update(
+						context.Background(),
+						gomock.Any(), // FIXME cluster.ClusterUpdateRequest objects can't be matched by gomock
+					).Return(&argocdv1alpha1.Cluster{}, nil)
+					mcs.EXPECT().InvalidateCache(
+						context.Background(),
+						&argocdCluster.ClusterQuery{
+							Name:   testClusterExternalName,
+							Server: testClusterServer,
+						},
+					).Return(&argocdv1alpha1.Cluster{}, nil)
+				}),
+				cr: Cluster(
+					withSpec(v1alpha1.ClusterParameters{
+						Server:             ptr.To(testClusterServer),
+						Name:               ptr.To(testClusterExternalName),
+						RefreshRequestedAt: &testRefreshRequestedAt,
+					}),
+					withExternalName(testClusterExternalName),
+					withObservation(v1alpha1.ClusterObservation{RefreshRequestedAt: &testRefreshedAt}),
+				),
+			},
+			want: want{
+				cr: Cluster(
+					withSpec(v1alpha1.ClusterParameters{
+						Server:             ptr.To(testClusterServer),
+						Name:               ptr.To(testClusterExternalName),
+						RefreshRequestedAt: &testRefreshRequestedAt,
+					}),
+					withExternalName(testClusterExternalName),
+					withObservation(v1alpha1.ClusterObservation{RefreshRequestedAt: &testRefreshedAt}),
+				),
+				result: managed.ExternalUpdate{},
+				err:    nil,
+			},
+		},
+		"InvalidateCacheFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockServiceClient) {
+					mcs.EXPECT().Update(
+						context.Background(),
+						gomock.Any(), // FIXME cluster.ClusterUpdateRequest objects can't be matched by gomock
+					).Return(&argocdv1alpha1.Cluster{}, nil)
+					mcs.EXPECT().InvalidateCache(
+						context.Background(),
+						gomock.Any(),
+					).Return(nil, errBoom)
+				}),
+				cr: Cluster(
+					withSpec(v1alpha1.ClusterParameters{
+						Server:             ptr.To(testClusterServer),
+						Name:               ptr.To(testClusterExternalName),
+						RefreshRequestedAt: &testRefreshRequestedAt,
+					}),
+					withExternalName(testClusterExternalName),
+				),
+			},
+			want: want{
+				cr: Cluster(
+					withSpec(v1alpha1.ClusterParameters{
+						Server:             ptr.To(testClusterServer),
+						Name:               ptr.To(testClusterExternalName),
+						RefreshRequestedAt: &testRefreshRequestedAt,
+					}),
+					withExternalName(testClusterExternalName),
+				),
+				result: managed.ExternalUpdate{},
+				err:    errors.Wrap(errBoom, errInvalidateCache),
+			},
+		},
 	}
 
 	for name, tc := range cases {
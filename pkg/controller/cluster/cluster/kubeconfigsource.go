@@ -0,0 +1,168 @@
+package cluster
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/cluster/v1alpha1"
+)
+
+const (
+	errKubeconfigSourceNameOrLabels = "either name or matchLabels of the kubeconfig source is required"
+	errKubeconfigSourceNamespace    = "kubeconfig sources can only be referenced in the namespace of the cluster"
+	errListKubeconfigSources        = "cannot list kubeconfig sources"
+	errNoConnectionSecret           = "kubeconfig source does not write a connection secret"
+	errConnectionSecretNamespace    = "connection secret of the kubeconfig source is not in the namespace of the cluster"
+	errFmtGetKubeconfigSource       = "cannot get kubeconfig source %s %s"
+	errFmtNoKubeconfigSource        = "no %s matches the labels of the kubeconfig source"
+	errFmtGetConnectionSecret       = "cannot get connection secret %s/%s of the kubeconfig source"
+	errFmtNoKubeconfigKey           = "none of the keys %s is found in the connection secret of the kubeconfig source"
+	errFmtNoKubeconfigContext       = "context %s does not exist in the kubeconfig"
+)
+
+// defaultKubeconfigKeys are the keys of a connection secret a kubeconfig is
+// looked up in if no keys are given.
+var defaultKubeconfigKeys = []string{"kubeconfig", "value"}
+
+// kubeconfigResourceVersion returns the resource version of the secret the
+// kubeconfig of the cluster is read from, so that rotated kubeconfigs are
+// detected.
+func (e *external) kubeconfigResourceVersion(ctx context.Context, cr *v1alpha1.Cluster) (string, error) {
+	if src := cr.Spec.ForProvider.Config.KubeconfigFrom; src != nil {
+		s, err := e.connectionSecretOf(ctx, cr.GetNamespace(), src)
+		if err != nil {
+			return "", err
+		}
+		return s.GetResourceVersion(), nil
+	}
+	return e.getSecretResourceVersion(ctx, cr.Spec.ForProvider.Config.KubeconfigSecretRef)
+}
+
+// extractKubeconfigFromSource extracts login information from the kubeconfig
+// in the connection secret of the kubeconfig source.
+func (e *external) extractKubeconfigFromSource(ctx context.Context, namespace string, p *v1alpha1.ClusterParameters, r *argocdv1alpha1.Cluster) error {
+	src := p.Config.KubeconfigFrom
+	s, err := e.connectionSecretOf(ctx, namespace, src)
+	if err != nil {
+		return err
+	}
+	kubeconfig, err := kubeconfigFromSecret(s, src.Keys)
+	if err != nil {
+		return err
+	}
+	restConfig, err := restConfigForContext(kubeconfig, ptr.Deref(src.Context, ""))
+	if err != nil {
+		return errors.Wrap(err, errParseKubeconfig)
+	}
+	applyKubeconfig(restConfig, p, r)
+	return nil
+}
+
+// connectionSecretOf returns the connection secret of the resource selected
+// by the kubeconfig source. Namespaced clusters may only use resources and
+// secrets of their own namespace.
+func (e *external) connectionSecretOf(ctx context.Context, namespace string, src *v1alpha1.KubeconfigSource) (*corev1.Secret, error) {
+	sourceNamespace := ptr.Deref(src.Namespace, namespace)
+	if namespace != "" && sourceNamespace != namespace {
+		return nil, errors.New(errKubeconfigSourceNamespace)
+	}
+	u, err := e.getKubeconfigSource(ctx, sourceNamespace, src)
+	if err != nil {
+		return nil, err
+	}
+
+	name, _, _ := unstructured.NestedString(u.Object, "spec", "writeConnectionSecretToRef", "name")
+	if name == "" {
+		return nil, errors.New(errNoConnectionSecret)
+	}
+	secretNamespace, _, _ := unstructured.NestedString(u.Object, "spec", "writeConnectionSecretToRef", "namespace")
+	if secretNamespace == "" {
+		secretNamespace = u.GetNamespace()
+	}
+	if namespace != "" && secretNamespace != namespace {
+		return nil, errors.New(errConnectionSecretNamespace)
+	}
+
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: secretNamespace, Name: name}, s); err != nil {
+		return nil, errors.Wrapf(err, errFmtGetConnectionSecret, secretNamespace, name)
+	}
+	return s, nil
+}
+
+// getKubeconfigSource returns the resource selected by the kubeconfig source,
+// either by name or the first resource by name that matches the labels.
+func (e *external) getKubeconfigSource(ctx context.Context, namespace string, src *v1alpha1.KubeconfigSource) (*unstructured.Unstructured, error) {
+	gvk := schema.FromAPIVersionAndKind(src.APIVersion, src.Kind)
+
+	if src.Name != nil {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: *src.Name}, u); err != nil {
+			return nil, errors.Wrapf(err, errFmtGetKubeconfigSource, src.Kind, *src.Name)
+		}
+		return u, nil
+	}
+	if len(src.MatchLabels) == 0 {
+		return nil, errors.New(errKubeconfigSourceNameOrLabels)
+	}
+
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	opts := []client.ListOption{client.MatchingLabels(src.MatchLabels)}
+	if namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	if err := e.kube.List(ctx, l, opts...); err != nil {
+		return nil, errors.Wrap(err, errListKubeconfigSources)
+	}
+	if len(l.Items) == 0 {
+		return nil, errors.Errorf(errFmtNoKubeconfigSource, src.Kind)
+	}
+	u := slices.MinFunc(l.Items, func(a, b unstructured.Unstructured) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	return &u, nil
+}
+
+// kubeconfigFromSecret returns the value of the first of the keys present in
+// the secret.
+func kubeconfigFromSecret(s *corev1.Secret, keys []string) ([]byte, error) {
+	if len(keys) == 0 {
+		keys = defaultKubeconfigKeys
+	}
+	for _, k := range keys {
+		if v := s.Data[k]; len(v) > 0 {
+			return v, nil
+		}
+	}
+	return nil, errors.Errorf(errFmtNoKubeconfigKey, strings.Join(keys, ", "))
+}
+
+// restConfigForContext returns a REST Config for the given context of the
+// kubeconfig, or its current context if none is given.
+func restConfigForContext(kubeconfig []byte, kubeContext string) (*rest.Config, error) {
+	if kubeContext == "" {
+		return newRESTConfigForKubeconfig(kubeconfig)
+	}
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := cfg.Contexts[kubeContext]; !ok {
+		return nil, errors.Errorf(errFmtNoKubeconfigContext, kubeContext)
+	}
+	return clientcmd.NewNonInteractiveClientConfig(*cfg, kubeContext, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+}
@@ -0,0 +1,173 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/cluster/v1alpha1"
+)
+
+const testConnectionSecret = "cluster-conn"
+
+// withKubeconfigSource returns a MockGetFn serving a resource that writes its
+// connection secret to testConnectionSecret and the secret with the data.
+func withKubeconfigSource(secretNamespace string, data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *unstructured.Unstructured:
+			o.SetName(key.Name)
+			o.SetNamespace(key.Namespace)
+			ref := map[string]any{"name": testConnectionSecret}
+			if secretNamespace != "" {
+				ref["namespace"] = secretNamespace
+			}
+			return unstructured.SetNestedMap(o.Object, ref, "spec", "writeConnectionSecretToRef")
+		case *corev1.Secret:
+			o.SetResourceVersion("1")
+			o.Data = data
+		}
+		return nil
+	}
+}
+
+func TestExtractKubeconfigFromSource(t *testing.T) {
+	kubeconfig := map[string][]byte{"kubeconfig": []byte(testAdminKubeconfig)}
+	source := func(mod ...func(*v1alpha1.KubeconfigSource)) *v1alpha1.KubeconfigSource {
+		src := &v1alpha1.KubeconfigSource{
+			APIVersion: "eks.aws.upbound.io/v1beta1",
+			Kind:       "Cluster",
+			Name:       ptr.To("example"),
+			Namespace:  ptr.To("crossplane-system"),
+		}
+		for _, m := range mod {
+			m(src)
+		}
+		return src
+	}
+
+	type args struct {
+		kube      client.Client
+		namespace string
+		src       *v1alpha1.KubeconfigSource
+	}
+	type want struct {
+		server string
+		token  string
+		err    error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ByName": {
+			args: args{
+				kube: &test.MockClient{MockGet: withKubeconfigSource("", kubeconfig)},
+				src:  source(),
+			},
+			want: want{server: "https://example.com", token: "admin-token"},
+		},
+		"ByLabels": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: withKubeconfigSource("", kubeconfig),
+					MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+						l := obj.(*unstructured.UnstructuredList)
+						for _, name := range []string{"b", "a"} {
+							u := unstructured.Unstructured{Object: map[string]any{}}
+							u.SetName(name)
+							_ = unstructured.SetNestedField(u.Object, testConnectionSecret, "spec", "writeConnectionSecretToRef", "name")
+							_ = unstructured.SetNestedField(u.Object, "crossplane-system", "spec", "writeConnectionSecretToRef", "namespace")
+							l.Items = append(l.Items, u)
+						}
+						return nil
+					},
+				},
+				src: source(func(s *v1alpha1.KubeconfigSource) {
+					s.Name = nil
+					s.MatchLabels = map[string]string{"env": "prod"}
+				}),
+			},
+			want: want{server: "https://example.com", token: "admin-token"},
+		},
+		"FallbackKey": {
+			args: args{
+				kube: &test.MockClient{MockGet: withKubeconfigSource("", map[string][]byte{"value": []byte(testAdminKubeconfig)})},
+				src:  source(),
+			},
+			want: want{server: "https://example.com", token: "admin-token"},
+		},
+		"NoKey": {
+			args: args{
+				kube: &test.MockClient{MockGet: withKubeconfigSource("", map[string][]byte{})},
+				src:  source(),
+			},
+			want: want{err: errors.Errorf(errFmtNoKubeconfigKey, "kubeconfig, value")},
+		},
+		"UnknownContext": {
+			args: args{
+				kube: &test.MockClient{MockGet: withKubeconfigSource("", kubeconfig)},
+				src:  source(func(s *v1alpha1.KubeconfigSource) { s.Context = ptr.To("other") }),
+			},
+			want: want{err: errors.Wrap(errors.Errorf(errFmtNoKubeconfigContext, "other"), errParseKubeconfig)},
+		},
+		"NameOrLabelsRequired": {
+			args: args{
+				kube: &test.MockClient{},
+				src:  source(func(s *v1alpha1.KubeconfigSource) { s.Name = nil }),
+			},
+			want: want{err: errors.New(errKubeconfigSourceNameOrLabels)},
+		},
+		"NamespacedSourceInOtherNamespace": {
+			args: args{
+				kube:      &test.MockClient{},
+				namespace: "team-a",
+				src:       source(),
+			},
+			want: want{err: errors.New(errKubeconfigSourceNamespace)},
+		},
+		"NamespacedSecretInOtherNamespace": {
+			args: args{
+				kube:      &test.MockClient{MockGet: withKubeconfigSource("crossplane-system", kubeconfig)},
+				namespace: "team-a",
+				src:       source(func(s *v1alpha1.KubeconfigSource) { s.Namespace = nil }),
+			},
+			want: want{err: errors.New(errConnectionSecretNamespace)},
+		},
+		"GetSourceFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				src:  source(),
+			},
+			want: want{err: errors.Wrapf(errBoom, errFmtGetKubeconfigSource, "Cluster", "example")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.args.kube}
+			p := &v1alpha1.ClusterParameters{Config: v1alpha1.ClusterConfig{KubeconfigFrom: tc.args.src}}
+			r := &argocdv1alpha1.Cluster{}
+
+			err := e.extractKubeconfigFromSource(context.Background(), tc.args.namespace, p, r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.server, r.Server); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, r.Config.BearerToken); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
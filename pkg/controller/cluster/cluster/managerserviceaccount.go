@@ -0,0 +1,413 @@
+package cluster
+
+import (
+	"context"
+	"slices"
+
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/cluster/v1alpha1"
+)
+
+const (
+	errCreateManagerKubeClient   = "cannot create client of the cluster to bootstrap the manager service account in"
+	errListManagerTokens         = "cannot list tokens of the manager service account"
+	errListManagerRoles          = "cannot list roles of the manager service account"
+	errManagerTokenNotPopulated  = "token of the manager service account is not populated yet"
+	errFmtApplyManagerObject     = "cannot apply %s %s of the manager service account"
+	errFmtDeleteManagerObject    = "cannot delete %s %s of the manager service account"
+	errFmtCreateManagerToken     = "cannot create token of the manager service account %s"
+	errFmtDeleteManagerToken     = "cannot delete token %s of the manager service account"
+	errFmtManagerKubeconfigEmpty = "key %s of the admin kubeconfig secret is empty"
+
+	defaultManagerNamespace = "kube-system"
+
+	// The manager objects are named differently from the ones created by
+	// `argocd cluster add`, so they never collide with clusters registered
+	// by the ArgoCD CLI.
+	managerServiceAccountName = "crossplane-argocd-manager"
+	managerRoleName           = "crossplane-argocd-manager-role"
+	managerRoleBindingName    = "crossplane-argocd-manager-role-binding"
+
+	// labelManagerServiceAccount marks the objects created for the manager
+	// service account, so they can be found again when the namespaces of
+	// the cluster change or the cluster is deleted.
+	labelManagerServiceAccount = "argocd.crossplane.io/manager-service-account"
+)
+
+// newKubeClient creates a client of the cluster the manager service account
+// is bootstrapped in.
+func newKubeClient(cfg *rest.Config) (client.Client, error) {
+	return client.New(cfg, client.Options{})
+}
+
+// bootstrapManagerServiceAccount creates or updates the manager service
+// account and its RBAC in the cluster and configures ArgoCD to connect to the
+// cluster with the token of the service account.
+func (e *external) bootstrapManagerServiceAccount(ctx context.Context, p *v1alpha1.ClusterParameters, r *argocdv1alpha1.Cluster) error {
+	kube, restConfig, err := e.managerKube(ctx, p.ManagerServiceAccount)
+	if err != nil {
+		return err
+	}
+	namespace := managerNamespace(p.ManagerServiceAccount)
+
+	if err := applyManagerRBAC(ctx, kube, namespace, p.Namespaces); err != nil {
+		return err
+	}
+	token, err := ensureManagerToken(ctx, kube, namespace)
+	if err != nil {
+		return err
+	}
+
+	if restConfig.Host != "" {
+		if p.Name == nil {
+			p.Name = &restConfig.Host
+			r.Name = restConfig.Host
+		}
+		p.Server = &restConfig.Host
+		r.Server = restConfig.Host
+	}
+	r.Config.BearerToken = token
+	r.Config.TLSClientConfig = argocdv1alpha1.TLSClientConfig{
+		Insecure:   restConfig.TLSClientConfig.Insecure,
+		CAData:     restConfig.CAData,
+		ServerName: restConfig.TLSClientConfig.ServerName,
+	}
+	return nil
+}
+
+// observeManagerServiceAccount records the current token of the manager
+// service account and reports whether it exists and does not have to be
+// rotated. The last handled rotation request is kept from the last
+// observation.
+func (e *external) observeManagerServiceAccount(ctx context.Context, cr *v1alpha1.Cluster, last *v1alpha1.ManagerServiceAccountObservation) (bool, error) {
+	m := cr.Spec.ForProvider.ManagerServiceAccount
+	if m == nil {
+		return true, nil
+	}
+	kube, _, err := e.managerKube(ctx, m)
+	if err != nil {
+		return false, err
+	}
+	namespace := managerNamespace(m)
+	tokens, err := listManagerTokens(ctx, kube, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	o := &v1alpha1.ManagerServiceAccountObservation{
+		Namespace: namespace,
+		Name:      managerServiceAccountName,
+	}
+	if last != nil {
+		o.LastTokenRotationRequestedAt = last.LastTokenRotationRequestedAt
+	}
+	cr.Status.AtProvider.ManagerServiceAccount = o
+	if len(tokens) == 0 {
+		return false, nil
+	}
+	o.TokenSecretName = ptr.To(tokens[0].Name)
+	o.TokenCreatedAt = ptr.To(tokens[0].CreationTimestamp)
+	return len(tokens) == 1 && !isTokenRotationRequested(m, o), nil
+}
+
+// rotateManagerToken creates a new token for the manager service account if
+// a rotation was requested since the last one and records the request as
+// handled. ArgoCD is switched to the new token once it is populated.
+func (e *external) rotateManagerToken(ctx context.Context, cr *v1alpha1.Cluster) error {
+	m := cr.Spec.ForProvider.ManagerServiceAccount
+	if m == nil {
+		return nil
+	}
+	o := cr.Status.AtProvider.ManagerServiceAccount
+	if o == nil {
+		o = &v1alpha1.ManagerServiceAccountObservation{}
+		cr.Status.AtProvider.ManagerServiceAccount = o
+	}
+	if !isTokenRotationRequested(m, o) {
+		return nil
+	}
+	kube, _, err := e.managerKube(ctx, m)
+	if err != nil {
+		return err
+	}
+	if err := createManagerToken(ctx, kube, managerNamespace(m)); err != nil {
+		return err
+	}
+	o.LastTokenRotationRequestedAt = m.RotateTokenRequestedAt
+	return nil
+}
+
+// removeManagerServiceAccount deletes the manager service account, its RBAC
+// and its tokens from the cluster.
+func (e *external) removeManagerServiceAccount(ctx context.Context, p *v1alpha1.ClusterParameters) error {
+	if p.ManagerServiceAccount == nil {
+		return nil
+	}
+	kube, _, err := e.managerKube(ctx, p.ManagerServiceAccount)
+	if err != nil {
+		return err
+	}
+	namespace := managerNamespace(p.ManagerServiceAccount)
+
+	if err := pruneManagerRoles(ctx, kube, nil); err != nil {
+		return err
+	}
+	tokens, err := listManagerTokens(ctx, kube, namespace)
+	if err != nil {
+		return err
+	}
+	if err := deleteManagerTokens(ctx, kube, tokens); err != nil {
+		return err
+	}
+	objs := []client.Object{
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: managerRoleBindingName}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: managerRoleName}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: managerServiceAccountName, Namespace: namespace}},
+	}
+	for _, obj := range objs {
+		if err := client.IgnoreNotFound(kube.Delete(ctx, obj)); err != nil {
+			return errors.Wrapf(err, errFmtDeleteManagerObject, kindOf(obj), obj.GetName())
+		}
+	}
+	return nil
+}
+
+// managerKube returns a client of the cluster created from the admin
+// kubeconfig and the REST config the client was created from.
+func (e *external) managerKube(ctx context.Context, m *v1alpha1.ManagerServiceAccount) (client.Client, *rest.Config, error) {
+	kubeconfig, err := e.getPayload(ctx, &m.AdminKubeconfigSecretRef)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(kubeconfig) == 0 {
+		return nil, nil, errors.Errorf(errFmtManagerKubeconfigEmpty, m.AdminKubeconfigSecretRef.Key)
+	}
+	restConfig, err := newRESTConfigForKubeconfig(kubeconfig)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errParseKubeconfig)
+	}
+	kube, err := e.newKubeClientFn(restConfig)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errCreateManagerKubeClient)
+	}
+	return kube, restConfig, nil
+}
+
+func managerNamespace(m *v1alpha1.ManagerServiceAccount) string {
+	return ptr.Deref(m.Namespace, defaultManagerNamespace)
+}
+
+// applyManagerRBAC creates the manager service account and grants it the same
+// permissions as `argocd cluster add`: cluster admin if no namespaces are
+// given, admin of each of the namespaces otherwise.
+func applyManagerRBAC(ctx context.Context, kube client.Client, namespace string, namespaces []string) error {
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: managerServiceAccountName, Namespace: namespace}}
+	if err := applyManagerObject(ctx, kube, sa, func() {}); err != nil {
+		return err
+	}
+	subject := rbacv1.Subject{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      managerServiceAccountName,
+		Namespace: namespace,
+	}
+
+	if len(namespaces) == 0 {
+		role := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: managerRoleName}}
+		if err := applyManagerObject(ctx, kube, role, func() {
+			role.Rules = clusterauth.ArgoCDManagerClusterPolicyRules
+		}); err != nil {
+			return err
+		}
+		binding := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: managerRoleBindingName}}
+		if err := applyManagerObject(ctx, kube, binding, func() {
+			binding.RoleRef = rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: managerRoleName}
+			binding.Subjects = []rbacv1.Subject{subject}
+		}); err != nil {
+			return err
+		}
+		return pruneManagerRoles(ctx, kube, nil)
+	}
+
+	for _, ns := range namespaces {
+		role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: managerRoleName, Namespace: ns}}
+		if err := applyManagerObject(ctx, kube, role, func() {
+			role.Rules = clusterauth.ArgoCDManagerNamespacePolicyRules
+		}); err != nil {
+			return err
+		}
+		binding := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: managerRoleBindingName, Namespace: ns}}
+		if err := applyManagerObject(ctx, kube, binding, func() {
+			binding.RoleRef = rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: managerRoleName}
+			binding.Subjects = []rbacv1.Subject{subject}
+		}); err != nil {
+			return err
+		}
+	}
+	// the manager is no cluster admin once namespaces are given
+	for _, obj := range []client.Object{
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: managerRoleBindingName}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: managerRoleName}},
+	} {
+		if err := client.IgnoreNotFound(kube.Delete(ctx, obj)); err != nil {
+			return errors.Wrapf(err, errFmtDeleteManagerObject, kindOf(obj), obj.GetName())
+		}
+	}
+	return pruneManagerRoles(ctx, kube, namespaces)
+}
+
+func applyManagerObject(ctx context.Context, kube client.Client, obj client.Object, mutate func()) error {
+	_, err := controllerutil.CreateOrUpdate(ctx, kube, obj, func() error {
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[labelManagerServiceAccount] = "true"
+		obj.SetLabels(labels)
+		mutate()
+		return nil
+	})
+	return errors.Wrapf(err, errFmtApplyManagerObject, kindOf(obj), obj.GetName())
+}
+
+// pruneManagerRoles deletes the roles and role bindings of the manager in
+// all namespaces that are not in the given list.
+func pruneManagerRoles(ctx context.Context, kube client.Client, namespaces []string) error {
+	bindings := &rbacv1.RoleBindingList{}
+	if err := kube.List(ctx, bindings, client.MatchingLabels{labelManagerServiceAccount: "true"}); err != nil {
+		return errors.Wrap(err, errListManagerRoles)
+	}
+	roles := &rbacv1.RoleList{}
+	if err := kube.List(ctx, roles, client.MatchingLabels{labelManagerServiceAccount: "true"}); err != nil {
+		return errors.Wrap(err, errListManagerRoles)
+	}
+	var objs []client.Object
+	for i := range bindings.Items {
+		objs = append(objs, &bindings.Items[i])
+	}
+	for i := range roles.Items {
+		objs = append(objs, &roles.Items[i])
+	}
+	for _, obj := range objs {
+		if slices.Contains(namespaces, obj.GetNamespace()) {
+			continue
+		}
+		if err := client.IgnoreNotFound(kube.Delete(ctx, obj)); err != nil {
+			return errors.Wrapf(err, errFmtDeleteManagerObject, kindOf(obj), obj.GetNamespace()+"/"+obj.GetName())
+		}
+	}
+	return nil
+}
+
+// ensureManagerToken returns the most recent token of the manager service
+// account. A new token is created if there is none. The previous tokens are
+// kept until ArgoCD has been configured with the most recent token, see
+// pruneManagerTokens.
+func ensureManagerToken(ctx context.Context, kube client.Client, namespace string) (string, error) {
+	tokens, err := listManagerTokens(ctx, kube, namespace)
+	if err != nil {
+		return "", err
+	}
+	if len(tokens) == 0 {
+		if err := createManagerToken(ctx, kube, namespace); err != nil {
+			return "", err
+		}
+		// the token is populated asynchronously by the token controller of
+		// the cluster, it is picked up by the next reconciliation
+		return "", errors.New(errManagerTokenNotPopulated)
+	}
+
+	token := tokens[0].Data[corev1.ServiceAccountTokenKey]
+	if len(token) == 0 {
+		return "", errors.New(errManagerTokenNotPopulated)
+	}
+	return string(token), nil
+}
+
+func createManagerToken(ctx context.Context, kube client.Client, namespace string) error {
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: managerServiceAccountName + "-token-",
+			Namespace:    namespace,
+			Labels:       map[string]string{labelManagerServiceAccount: "true"},
+			Annotations:  map[string]string{corev1.ServiceAccountNameKey: managerServiceAccountName},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}
+	return errors.Wrapf(kube.Create(ctx, s), errFmtCreateManagerToken, managerServiceAccountName)
+}
+
+// pruneManagerTokens deletes all but the most recent token of the manager
+// service account. It must only be called once ArgoCD has been configured
+// with the most recent token, otherwise ArgoCD would be left without a valid
+// token.
+func (e *external) pruneManagerTokens(ctx context.Context, p *v1alpha1.ClusterParameters) error {
+	if p.ManagerServiceAccount == nil {
+		return nil
+	}
+	kube, _, err := e.managerKube(ctx, p.ManagerServiceAccount)
+	if err != nil {
+		return err
+	}
+	tokens, err := listManagerTokens(ctx, kube, managerNamespace(p.ManagerServiceAccount))
+	if err != nil || len(tokens) < 2 {
+		return err
+	}
+	return deleteManagerTokens(ctx, kube, tokens[1:])
+}
+
+// listManagerTokens returns the token secrets of the manager service account,
+// the most recent one first.
+func listManagerTokens(ctx context.Context, kube client.Client, namespace string) ([]corev1.Secret, error) {
+	l := &corev1.SecretList{}
+	if err := kube.List(ctx, l, client.InNamespace(namespace), client.MatchingLabels{labelManagerServiceAccount: "true"}); err != nil {
+		return nil, errors.Wrap(err, errListManagerTokens)
+	}
+	tokens := l.Items
+	slices.SortStableFunc(tokens, func(a, b corev1.Secret) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+	return tokens, nil
+}
+
+func deleteManagerTokens(ctx context.Context, kube client.Client, tokens []corev1.Secret) error {
+	for i := range tokens {
+		if err := client.IgnoreNotFound(kube.Delete(ctx, &tokens[i])); err != nil {
+			return errors.Wrapf(err, errFmtDeleteManagerToken, tokens[i].Name)
+		}
+	}
+	return nil
+}
+
+// isTokenRotationRequested compares the requested rotation with the last
+// handled one rather than with the creation time of the token, so that a
+// request in the future or clocks that disagree do not rotate repeatedly.
+func isTokenRotationRequested(m *v1alpha1.ManagerServiceAccount, o *v1alpha1.ManagerServiceAccountObservation) bool {
+	last := o.LastTokenRotationRequestedAt
+	return m.RotateTokenRequestedAt != nil && (last == nil || last.Before(m.RotateTokenRequestedAt))
+}
+
+func kindOf(obj client.Object) string {
+	switch obj.(type) {
+	case *corev1.ServiceAccount:
+		return "ServiceAccount"
+	case *rbacv1.ClusterRole:
+		return "ClusterRole"
+	case *rbacv1.ClusterRoleBinding:
+		return "ClusterRoleBinding"
+	case *rbacv1.Role:
+		return "Role"
+	case *rbacv1.RoleBinding:
+		return "RoleBinding"
+	}
+	return "object"
+}
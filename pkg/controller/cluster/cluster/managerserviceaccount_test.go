@@ -0,0 +1,396 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/cluster/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/cluster"
+)
+
+const testAdminKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://example.com
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: admin
+current-context: test
+users:
+- name: admin
+  user:
+    token: admin-token
+`
+
+var (
+	testTokenCreatedAt = metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	testRotateAt       = metav1.NewTime(testTokenCreatedAt.Add(time.Hour))
+)
+
+func managerToken(name string, createdAt metav1.Time, token string) corev1.Secret {
+	s := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         defaultManagerNamespace,
+			CreationTimestamp: createdAt,
+		},
+	}
+	if token != "" {
+		s.Data = map[string][]byte{corev1.ServiceAccountTokenKey: []byte(token)}
+	}
+	return s
+}
+
+func withManagerTokens(tokens ...corev1.Secret) test.MockListFn {
+	return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		if l, ok := obj.(*corev1.SecretList); ok {
+			l.Items = append([]corev1.Secret(nil), tokens...)
+		}
+		return nil
+	}
+}
+
+func TestEnsureManagerToken(t *testing.T) {
+	type args struct {
+		kube client.Client
+	}
+	type want struct {
+		token   string
+		created bool
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"CreateMissingToken": {
+			args: args{kube: &test.MockClient{MockList: withManagerTokens()}},
+			want: want{created: true, err: errors.New(errManagerTokenNotPopulated)},
+		},
+		"TokenNotPopulated": {
+			args: args{kube: &test.MockClient{MockList: withManagerTokens(managerToken("new", testTokenCreatedAt, ""))}},
+			want: want{err: errors.New(errManagerTokenNotPopulated)},
+		},
+		"KeepPreviousTokens": {
+			args: args{kube: &test.MockClient{MockList: withManagerTokens(
+				managerToken("old", testTokenCreatedAt, "old-token"),
+				managerToken("new", testRotateAt, "new-token"),
+			)}},
+			want: want{token: "new-token"},
+		},
+		"ListFailed": {
+			args: args{kube: &test.MockClient{MockList: test.NewMockListFn(errBoom)}},
+			want: want{err: errors.Wrap(errBoom, errListManagerTokens)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created bool
+			var deleted []string
+			kube := tc.args.kube.(*test.MockClient)
+			kube.MockCreate = func(_ context.Context, _ client.Object, _ ...client.CreateOption) error {
+				created = true
+				return nil
+			}
+			kube.MockDelete = func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+				deleted = append(deleted, obj.GetName())
+				return nil
+			}
+
+			token, err := ensureManagerToken(context.Background(), kube, defaultManagerNamespace)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, token); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("created: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func adminSecret(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	s := obj.(*corev1.Secret)
+	s.Data = map[string][]byte{"kubeconfig": []byte(testAdminKubeconfig)}
+	return nil
+}
+
+var testManager = &v1alpha1.ManagerServiceAccount{
+	AdminKubeconfigSecretRef: v1alpha1.SecretReference{Name: "admin", Namespace: "crossplane-system", Key: "kubeconfig"},
+}
+
+func TestPruneManagerTokens(t *testing.T) {
+	type want struct {
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		tokens []corev1.Secret
+		want   want
+	}{
+		"SingleToken": {
+			tokens: []corev1.Secret{managerToken("token", testTokenCreatedAt, "t")},
+		},
+		"DeletePreviousTokens": {
+			tokens: []corev1.Secret{
+				managerToken("old", testTokenCreatedAt, "old-token"),
+				managerToken("new", testRotateAt, "new-token"),
+			},
+			want: want{deleted: []string{"old"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			e := &external{
+				kube: &test.MockClient{MockGet: adminSecret},
+				newKubeClientFn: func(_ *rest.Config) (client.Client, error) {
+					return &test.MockClient{
+						MockList: withManagerTokens(tc.tokens...),
+						MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+							deleted = append(deleted, obj.GetName())
+							return nil
+						},
+					}, nil
+				},
+			}
+
+			err := e.pruneManagerTokens(context.Background(), &v1alpha1.ClusterParameters{ManagerServiceAccount: testManager})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteRemovesManagerServiceAccountFirst(t *testing.T) {
+	// the cluster must not be deleted from ArgoCD while the manager service
+	// account cannot be removed, otherwise it is leaked
+	e := &external{
+		client: withMockClient(t, func(*mockclient.MockServiceClient) {}),
+		kube:   &test.MockClient{MockGet: adminSecret},
+		newKubeClientFn: func(_ *rest.Config) (client.Client, error) {
+			return &test.MockClient{MockList: test.NewMockListFn(errBoom)}, nil
+		},
+	}
+	cr := Cluster(withSpec(v1alpha1.ClusterParameters{
+		Server:                ptr.To(testClusterServer),
+		ManagerServiceAccount: testManager,
+	}))
+
+	_, err := e.Delete(context.Background(), cr)
+	if diff := cmp.Diff(errors.Wrap(errBoom, errListManagerRoles), err, test.EquateErrors()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestObserveManagerServiceAccount(t *testing.T) {
+	manager := testManager
+
+	type args struct {
+		manager *v1alpha1.ManagerServiceAccount
+		last    *v1alpha1.ManagerServiceAccountObservation
+		tokens  []corev1.Secret
+	}
+	type want struct {
+		upToDate bool
+		obs      *v1alpha1.ManagerServiceAccountObservation
+		err      error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotConfigured": {
+			want: want{upToDate: true},
+		},
+		"NoToken": {
+			args: args{manager: manager},
+			want: want{
+				obs: &v1alpha1.ManagerServiceAccountObservation{Namespace: defaultManagerNamespace, Name: managerServiceAccountName},
+			},
+		},
+		"UpToDate": {
+			args: args{manager: manager, tokens: []corev1.Secret{managerToken("token", testTokenCreatedAt, "t")}},
+			want: want{
+				upToDate: true,
+				obs: &v1alpha1.ManagerServiceAccountObservation{
+					Namespace:       defaultManagerNamespace,
+					Name:            managerServiceAccountName,
+					TokenSecretName: ptr.To("token"),
+					TokenCreatedAt:  &testTokenCreatedAt,
+				},
+			},
+		},
+		"RotationRequested": {
+			args: args{
+				manager: &v1alpha1.ManagerServiceAccount{
+					AdminKubeconfigSecretRef: manager.AdminKubeconfigSecretRef,
+					RotateTokenRequestedAt:   &testRotateAt,
+				},
+				tokens: []corev1.Secret{managerToken("token", testTokenCreatedAt, "t")},
+			},
+			want: want{
+				obs: &v1alpha1.ManagerServiceAccountObservation{
+					Namespace:       defaultManagerNamespace,
+					Name:            managerServiceAccountName,
+					TokenSecretName: ptr.To("token"),
+					TokenCreatedAt:  &testTokenCreatedAt,
+				},
+			},
+		},
+		"RotationHandled": {
+			args: args{
+				manager: &v1alpha1.ManagerServiceAccount{
+					AdminKubeconfigSecretRef: manager.AdminKubeconfigSecretRef,
+					RotateTokenRequestedAt:   &testRotateAt,
+				},
+				last:   &v1alpha1.ManagerServiceAccountObservation{LastTokenRotationRequestedAt: &testRotateAt},
+				tokens: []corev1.Secret{managerToken("token", testTokenCreatedAt, "t")},
+			},
+			want: want{
+				upToDate: true,
+				obs: &v1alpha1.ManagerServiceAccountObservation{
+					Namespace:                    defaultManagerNamespace,
+					Name:                         managerServiceAccountName,
+					TokenSecretName:              ptr.To("token"),
+					TokenCreatedAt:               &testTokenCreatedAt,
+					LastTokenRotationRequestedAt: &testRotateAt,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				kube: &test.MockClient{MockGet: adminSecret},
+				newKubeClientFn: func(cfg *rest.Config) (client.Client, error) {
+					if cfg.Host != "https://example.com" {
+						t.Errorf("unexpected host %s", cfg.Host)
+					}
+					return &test.MockClient{MockList: withManagerTokens(tc.args.tokens...)}, nil
+				},
+			}
+			cr := Cluster(withSpec(v1alpha1.ClusterParameters{ManagerServiceAccount: tc.args.manager}))
+
+			upToDate, err := e.observeManagerServiceAccount(context.Background(), cr, tc.args.last)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider.ManagerServiceAccount); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRotateManagerToken(t *testing.T) {
+	future := metav1.NewTime(time.Now().Add(24 * time.Hour))
+	withRotateAt := func(at *metav1.Time) *v1alpha1.ManagerServiceAccount {
+		return &v1alpha1.ManagerServiceAccount{
+			AdminKubeconfigSecretRef: testManager.AdminKubeconfigSecretRef,
+			RotateTokenRequestedAt:   at,
+		}
+	}
+
+	type args struct {
+		manager *v1alpha1.ManagerServiceAccount
+		last    *metav1.Time
+	}
+	type want struct {
+		created int
+		last    *metav1.Time
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotRequested": {
+			args: args{manager: testManager},
+		},
+		"Requested": {
+			args: args{manager: withRotateAt(&testRotateAt)},
+			want: want{created: 1, last: &testRotateAt},
+		},
+		"RequestedAgain": {
+			args: args{manager: withRotateAt(&testRotateAt), last: &testTokenCreatedAt},
+			want: want{created: 1, last: &testRotateAt},
+		},
+		"AlreadyHandled": {
+			args: args{manager: withRotateAt(&testRotateAt), last: &testRotateAt},
+			want: want{last: &testRotateAt},
+		},
+		"FutureRequestHandledOnce": {
+			// a request in the future must not create a token on every
+			// reconciliation until that time is reached
+			args: args{manager: withRotateAt(&future)},
+			want: want{created: 1, last: &future},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created int
+			e := &external{
+				kube: &test.MockClient{MockGet: adminSecret},
+				newKubeClientFn: func(_ *rest.Config) (client.Client, error) {
+					return &test.MockClient{
+						MockCreate: func(_ context.Context, _ client.Object, _ ...client.CreateOption) error {
+							created++
+							return nil
+						},
+					}, nil
+				},
+			}
+			cr := Cluster(withSpec(v1alpha1.ClusterParameters{ManagerServiceAccount: tc.args.manager}))
+			cr.Status.AtProvider.ManagerServiceAccount = &v1alpha1.ManagerServiceAccountObservation{LastTokenRotationRequestedAt: tc.args.last}
+
+			// the second call runs with the status recorded by the first one
+			// like the next reconciliation does
+			for range 2 {
+				if err := e.rotateManagerToken(context.Background(), cr); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("created: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.last, cr.Status.AtProvider.ManagerServiceAccount.LastTokenRotationRequestedAt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package gpgkeys
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/gpg"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/gpgkeys"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotGPGKey        = "managed resource is not a ArgoCD GPGKey custom resource"
+	errGetFailed        = "cannot get ArgoCD GPGKey"
+	errCreateFailed     = "cannot create ArgoCD GPGKey"
+	errDeleteFailed     = "cannot delete ArgoCD GPGKey"
+	errGetSecretFailed  = "cannot get Kubernetes secret"
+	errFmtKeyNotFound   = "key %s is not found in referenced Kubernetes secret"
+	errNoPublicKeyData  = "neither publicKeyData nor publicKeyDataRef is set"
+	errNoKeyCreated     = "ArgoCD did not return the ID of the created GPGKey"
+	errPublicKeyDataSet = "only one of publicKeyData and publicKeyDataRef may be set"
+	errParseKeyFailed   = "cannot parse the ASCII-armored public key"
+	errNoPublicKey      = "the ASCII-armored data does not contain a public key"
+	errFmtKeyExists     = "GPG key %s already exists in ArgoCD, set it as external name to manage the existing key"
+	errFmtKeyChanged    = "GPG keys are immutable, the public key %s does not match the managed key %s, create a new GPGKey to rotate it"
+)
+
+// Setup adds a controller that reconciles GPG keys.
+func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.GPGKeyKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: gpgkeys.NewGPGKeyServiceClient,
+		}),
+		// The key ID is only known once the key was created.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.GPGKeyList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.GPGKey{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.GPGKeyGroupVersionKind),
+			opts...))
+}
+
+type connector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, gpgkey.GPGKeyServiceClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GPGKey)
+	if !ok {
+		return nil, errors.New(errNotGPGKey)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &external{kube: c.kube, client: argocdClient, conn: conn}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client gpgkeys.GPGKeyServiceClient
+	conn   io.Closer
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GPGKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGPGKey)
+	}
+
+	// An external name that is no key ID, like the name of the managed
+	// resource, is rejected by ArgoCD and cannot refer to an existing key.
+	keyID := meta.GetExternalName(cr)
+	if gpg.KeyID(keyID) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	key, err := e.client.Get(ctx, &gpgkey.GnuPGPublicKeyQuery{KeyID: keyID})
+	if gpgkeys.IsErrorGPGKeyNotFound(err) {
+		return managed.ExternalObservation{}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	cr.Status.AtProvider = generateGPGKeyObservation(key)
+	cr.Status.SetConditions(xpv1.Available())
+
+	// GPG keys cannot be modified in ArgoCD, a key with different data has a
+	// different key ID. Such a change is reported by Update.
+	desiredID, err := e.getPublicKeyID(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: strings.EqualFold(desiredID, key.KeyID),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GPGKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGPGKey)
+	}
+
+	data, err := e.getPublicKeyData(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	resp, err := e.client.Create(ctx, &gpgkey.GnuPGPublicKeyCreateRequest{
+		Publickey: &argocdv1alpha1.GnuPGPublicKey{KeyData: data},
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	// Keys which are already known to ArgoCD are reported as skipped. They
+	// are not adopted, as deleting this resource would delete a key it did
+	// not create.
+	switch {
+	case resp.GetCreated() != nil && len(resp.GetCreated().Items) > 0:
+		meta.SetExternalName(cr, resp.GetCreated().Items[0].KeyID)
+	case len(resp.GetSkipped()) > 0:
+		return managed.ExternalCreation{}, errors.Errorf(errFmtKeyExists, resp.GetSkipped()[0])
+	default:
+		return managed.ExternalCreation{}, errors.New(errNoKeyCreated)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GPGKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGPGKey)
+	}
+
+	desiredID, err := e.getPublicKeyID(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, errors.Errorf(errFmtKeyChanged, desiredID, meta.GetExternalName(cr))
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.GPGKey)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotGPGKey)
+	}
+
+	_, err := e.client.Delete(ctx, &gpgkey.GnuPGPublicKeyQuery{KeyID: meta.GetExternalName(cr)})
+	if gpgkeys.IsErrorGPGKeyNotFound(err) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+func generateGPGKeyObservation(k *argocdv1alpha1.GnuPGPublicKey) v1alpha1.GPGKeyObservation {
+	return v1alpha1.GPGKeyObservation{
+		KeyID:       ptr.To(k.KeyID),
+		Fingerprint: ptr.To(k.Fingerprint),
+		Owner:       ptr.To(k.Owner),
+		Trust:       ptr.To(k.Trust),
+		SubType:     ptr.To(k.SubType),
+	}
+}
+
+// getPublicKeyID returns the key ID of the primary key of the configured
+// public key, in the format used by ArgoCD.
+func (e *external) getPublicKeyID(ctx context.Context, p *v1alpha1.GPGKeyParameters) (string, error) {
+	data, err := e.getPublicKeyData(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	keys, err := openpgp.ReadArmoredKeyRing(strings.NewReader(data))
+	if err != nil {
+		return "", errors.Wrap(err, errParseKeyFailed)
+	}
+	if len(keys) == 0 || keys[0].PrimaryKey == nil {
+		return "", errors.New(errNoPublicKey)
+	}
+	return keys[0].PrimaryKey.KeyIdString(), nil
+}
+
+// getPublicKeyData returns the ASCII-armored key either inline or from the referenced secret
+func (e *external) getPublicKeyData(ctx context.Context, p *v1alpha1.GPGKeyParameters) (string, error) {
+	switch {
+	case p.PublicKeyData != nil && p.PublicKeyDataRef != nil:
+		return "", errors.New(errPublicKeyDataSet)
+	case p.PublicKeyData != nil:
+		return *p.PublicKeyData, nil
+	case p.PublicKeyDataRef == nil:
+		return "", errors.New(errNoPublicKeyData)
+	}
+
+	ref := p.PublicKeyDataRef
+	nn := types.NamespacedName{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+	sc := &corev1.Secret{}
+	if err := e.kube.Get(ctx, nn, sc); err != nil {
+		return "", errors.Wrap(err, errGetSecretFailed)
+	}
+	val, ok := sc.Data[ref.Key]
+	if !ok {
+		return "", errors.New(fmt.Sprintf(errFmtKeyNotFound, ref.Key))
+	}
+	return string(val), nil
+}
@@ -0,0 +1,517 @@
+package gpgkeys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/gpgkeys"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/gpgkeys"
+)
+
+var (
+	testKeyID       = "3EA3B9417E29E243"
+	testFingerprint = "D057D66AC919BD97CFF157413EA3B9417E29E243"
+	testOwner       = "Test Key <test@example.com>"
+	testTrust       = "unknown"
+	testSubType     = "ed25519"
+	testKeyData     = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatVJJBYJKwYBBAHaRw8BAQdAARgS+CGrH4zPeN8TI9JvwX5VxU+IQaT+pwDG
+cAvQmda0G1Rlc3QgS2V5IDx0ZXN0QGV4YW1wbGUuY29tPoiQBBMWCAA4FiEE0FfW
+askZvZfP8VdBPqO5QX4p4kMFAmrVSSQCGwMFCwkIBwIGFQoJCAsCBBYCAwECHgEC
+F4AACgkQPqO5QX4p4kMCKwD6AiTfz4Nlv7ruKFq9fRH0F9s+3WC3M1wQBDgrbwyM
+5QIA/Av1yW/JMFQxQQLQej03lg0JbJFWlPGezUcGec7W3BQE
+=LX8g
+-----END PGP PUBLIC KEY BLOCK-----`
+	testOtherKeyID = "4824995C1B97C2EB"
+	testOtherKey   = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatVJJBYJKwYBBAHaRw8BAQdA2ja25HRM3sRqc4cTMYTy+I7OdD2gurROSIwc
+dBFLHUe0HU90aGVyIEtleSA8b3RoZXJAZXhhbXBsZS5jb20+iJAEExYIADgWIQTN
+oC2ryushpoWht3ZIJJlcG5fC6wUCatVJJAIbAwULCQgHAgYVCgkICwIEFgIDAQIe
+AQIXgAAKCRBIJJlcG5fC6xalAQD5witBb8Okiqq1uvbEvQH5p0aF9PlOmx1sJvcR
+ESQx4QEAiYfaJ1Uj8rhlfGRslSWpobbWFxKIVgnj+MMgWXtrLA4=
+=qHNv
+-----END PGP PUBLIC KEY BLOCK-----`
+	testSecretName = "gpg-key"
+	testSecretNS   = "crossplane-system"
+	testSecretKey  = "key"
+	errBoom        = errors.New("boom")
+	errKeyNotFound = fmt.Errorf("No such key: %s", testKeyID)
+)
+
+type args struct {
+	kube   client.Client
+	client gpgkeys.GPGKeyServiceClient
+	cr     *v1alpha1.GPGKey
+}
+
+type mockModifier func(*mockclient.MockGPGKeyServiceClient)
+
+func withMockClient(t *testing.T, mod mockModifier) *mockclient.MockGPGKeyServiceClient {
+	ctrl := gomock.NewController(t)
+	mock := mockclient.NewMockGPGKeyServiceClient(ctrl)
+	mod(mock)
+	return mock
+}
+
+func GPGKey(m ...GPGKeyModifier) *v1alpha1.GPGKey {
+	cr := &v1alpha1.GPGKey{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+type GPGKeyModifier func(*v1alpha1.GPGKey)
+
+func withName(v string) GPGKeyModifier {
+	return func(s *v1alpha1.GPGKey) {
+		s.SetName(v)
+	}
+}
+
+func withExternalName(v string) GPGKeyModifier {
+	return func(s *v1alpha1.GPGKey) {
+		meta.SetExternalName(s, v)
+	}
+}
+
+func withSpec(p v1alpha1.GPGKeyParameters) GPGKeyModifier {
+	return func(r *v1alpha1.GPGKey) { r.Spec.ForProvider = p }
+}
+
+func withObservation(p v1alpha1.GPGKeyObservation) GPGKeyModifier {
+	return func(r *v1alpha1.GPGKey) { r.Status.AtProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) GPGKeyModifier {
+	return func(r *v1alpha1.GPGKey) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GPGKey
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyQuery{KeyID: testKeyID},
+					).Return(&argocdv1alpha1.GnuPGPublicKey{
+						KeyID:       testKeyID,
+						Fingerprint: testFingerprint,
+						Owner:       testOwner,
+						Trust:       testTrust,
+						SubType:     testSubType,
+						KeyData:     testKeyData,
+					}, nil)
+				}),
+				cr: GPGKey(
+					withExternalName(testKeyID),
+					withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testKeyData}),
+				),
+			},
+			want: want{
+				cr: GPGKey(
+					withExternalName(testKeyID),
+					withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testKeyData}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.GPGKeyObservation{
+						KeyID:       &testKeyID,
+						Fingerprint: &testFingerprint,
+						Owner:       &testOwner,
+						Trust:       &testTrust,
+						SubType:     &testSubType,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"KeyDataChanged": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyQuery{KeyID: testKeyID},
+					).Return(&argocdv1alpha1.GnuPGPublicKey{
+						KeyID:       testKeyID,
+						Fingerprint: testFingerprint,
+						Owner:       testOwner,
+						Trust:       testTrust,
+						SubType:     testSubType,
+						KeyData:     testKeyData,
+					}, nil)
+				}),
+				cr: GPGKey(
+					withExternalName(testKeyID),
+					withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testOtherKey}),
+				),
+			},
+			want: want{
+				cr: GPGKey(
+					withExternalName(testKeyID),
+					withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testOtherKey}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.GPGKeyObservation{
+						KeyID:       &testKeyID,
+						Fingerprint: &testFingerprint,
+						Owner:       &testOwner,
+						Trust:       &testTrust,
+						SubType:     &testSubType,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {}),
+				cr:     GPGKey(),
+			},
+			want: want{
+				cr: GPGKey(),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"ExternalNameIsResourceName": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {}),
+				cr:     GPGKey(withName("example-signing-key"), withExternalName("example-signing-key")),
+			},
+			want: want{
+				cr: GPGKey(withName("example-signing-key"), withExternalName("example-signing-key")),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyQuery{KeyID: testKeyID},
+					).Return(nil, errKeyNotFound)
+				}),
+				cr: GPGKey(withExternalName(testKeyID)),
+			},
+			want: want{
+				cr: GPGKey(withExternalName(testKeyID)),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyQuery{KeyID: testKeyID},
+					).Return(nil, errBoom)
+				}),
+				cr: GPGKey(withExternalName(testKeyID)),
+			},
+			want: want{
+				cr:  GPGKey(withExternalName(testKeyID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GPGKey
+		result managed.ExternalCreation
+		err    error
+	}
+
+	secretRef := &v1alpha1.SecretReference{Name: testSecretName, Namespace: testSecretNS, Key: testSecretKey}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulInline": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Create(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyCreateRequest{
+							Publickey: &argocdv1alpha1.GnuPGPublicKey{KeyData: testKeyData},
+						},
+					).Return(&gpgkey.GnuPGPublicKeyCreateResponse{
+						Created: &argocdv1alpha1.GnuPGPublicKeyList{
+							Items: []argocdv1alpha1.GnuPGPublicKey{{KeyID: testKeyID}},
+						},
+					}, nil)
+				}),
+				cr: GPGKey(withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testKeyData})),
+			},
+			want: want{
+				cr: GPGKey(
+					withExternalName(testKeyID),
+					withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testKeyData}),
+				),
+			},
+		},
+		"SuccessfulFromSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{testSecretKey: []byte(testKeyData)}
+						return nil
+					},
+				},
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Create(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyCreateRequest{
+							Publickey: &argocdv1alpha1.GnuPGPublicKey{KeyData: testKeyData},
+						},
+					).Return(&gpgkey.GnuPGPublicKeyCreateResponse{
+						Created: &argocdv1alpha1.GnuPGPublicKeyList{
+							Items: []argocdv1alpha1.GnuPGPublicKey{{KeyID: testKeyID}},
+						},
+					}, nil)
+				}),
+				cr: GPGKey(withSpec(v1alpha1.GPGKeyParameters{PublicKeyDataRef: secretRef})),
+			},
+			want: want{
+				cr: GPGKey(
+					withExternalName(testKeyID),
+					withSpec(v1alpha1.GPGKeyParameters{PublicKeyDataRef: secretRef}),
+				),
+			},
+		},
+		"AlreadyExists": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Create(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyCreateRequest{
+							Publickey: &argocdv1alpha1.GnuPGPublicKey{KeyData: testKeyData},
+						},
+					).Return(&gpgkey.GnuPGPublicKeyCreateResponse{
+						Created: &argocdv1alpha1.GnuPGPublicKeyList{},
+						Skipped: []string{testKeyID},
+					}, nil)
+				}),
+				cr: GPGKey(withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testKeyData})),
+			},
+			want: want{
+				cr:  GPGKey(withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testKeyData})),
+				err: errors.Errorf(errFmtKeyExists, testKeyID),
+			},
+		},
+		"NoPublicKeyData": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {}),
+				cr:     GPGKey(),
+			},
+			want: want{
+				cr:  GPGKey(),
+				err: errors.New(errNoPublicKeyData),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Create(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyCreateRequest{
+							Publickey: &argocdv1alpha1.GnuPGPublicKey{KeyData: testKeyData},
+						},
+					).Return(nil, errBoom)
+				}),
+				cr: GPGKey(withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testKeyData})),
+			},
+			want: want{
+				cr:  GPGKey(withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testKeyData})),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"KeyDataChanged": {
+			args: args{
+				cr: GPGKey(
+					withExternalName(testKeyID),
+					withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: &testOtherKey}),
+				),
+			},
+			want: want{
+				err: errors.Errorf(errFmtKeyChanged, testOtherKeyID, testKeyID),
+			},
+		},
+		"InvalidKeyData": {
+			args: args{
+				cr: GPGKey(
+					withExternalName(testKeyID),
+					withSpec(v1alpha1.GPGKeyParameters{PublicKeyData: ptr.To("invalid")}),
+				),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("openpgp: invalid argument: no armored data found"), errParseKeyFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.GPGKey
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Delete(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyQuery{KeyID: testKeyID},
+					).Return(&gpgkey.GnuPGPublicKeyResponse{}, nil)
+				}),
+				cr: GPGKey(withExternalName(testKeyID)),
+			},
+			want: want{
+				cr: GPGKey(withExternalName(testKeyID)),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Delete(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyQuery{KeyID: testKeyID},
+					).Return(nil, errKeyNotFound)
+				}),
+				cr: GPGKey(withExternalName(testKeyID)),
+			},
+			want: want{
+				cr: GPGKey(withExternalName(testKeyID)),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockGPGKeyServiceClient) {
+					mcs.EXPECT().Delete(
+						context.Background(),
+						&gpgkey.GnuPGPublicKeyQuery{KeyID: testKeyID},
+					).Return(nil, errBoom)
+				}),
+				cr: GPGKey(withExternalName(testKeyID)),
+			},
+			want: want{
+				cr:  GPGKey(withExternalName(testKeyID)),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
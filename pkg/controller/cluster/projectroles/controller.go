@@ -0,0 +1,263 @@
+package projectroles
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/projectroles/v1alpha1"
+	projectsv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/projects/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/projects"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotProjectRole     = "resource is not an ArgoCD Project Role"
+	errGetProjectFailed   = "failed to get ArgoCD Project, check if project exists and permissions are correct"
+	errUpdateProjectRole  = "failed to update ArgoCD Project Role"
+	errDeleteProjectRole  = "failed to delete ArgoCD Project Role"
+	errProjectNotResolved = "project of ArgoCD Project Role is not set"
+)
+
+// Setup adds a controller that reconciles project roles.
+func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectRoleKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: projects.NewProjectServiceClient,
+		}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.ProjectRoleList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ProjectRole{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProjectRoleGroupVersionKind),
+			opts...))
+}
+
+type connector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, project.ProjectServiceClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectRole)
+	if !ok {
+		return nil, errors.New(errNotProjectRole)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &external{kube: c.kube, client: argocdClient, conn: conn}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ProjectServiceClient
+	conn   io.Closer
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectRole)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	if cr.Spec.ForProvider.Project == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectNotResolved)
+	}
+
+	proj, err := e.client.Get(ctx, &project.ProjectQuery{Name: *cr.Spec.ForProvider.Project})
+	if projects.IsErrorProjectNotFound(err) {
+		return managed.ExternalObservation{}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetProjectFailed)
+	}
+
+	role, _, err := proj.GetRoleByName(meta.GetExternalName(cr))
+	if err != nil {
+		// GetRoleByName only fails if the role does not exist.
+		return managed.ExternalObservation{}, nil //nolint:nilerr
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitializeProjectRole(&cr.Spec.ForProvider, role)
+
+	cr.Status.AtProvider = generateProjectRoleObservation(role)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isProjectRoleUpToDate(&cr.Spec.ForProvider, role),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectRole)
+	}
+
+	return managed.ExternalCreation{}, errors.Wrap(e.upsertRole(ctx, cr), errUpdateProjectRole)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProjectRole)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.upsertRole(ctx, cr), errUpdateProjectRole)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ProjectRole)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotProjectRole)
+	}
+	if cr.Spec.ForProvider.Project == nil {
+		return managed.ExternalDelete{}, nil
+	}
+
+	name := meta.GetExternalName(cr)
+	err := retry.OnError(retry.DefaultRetry, projects.IsErrorConflict, func() error {
+		proj, err := e.client.Get(ctx, &project.ProjectQuery{Name: *cr.Spec.ForProvider.Project})
+		if err != nil {
+			return err
+		}
+		_, i, err := proj.GetRoleByName(name)
+		if err != nil {
+			// role is already gone
+			return nil //nolint:nilerr
+		}
+		proj.Spec.Roles = append(proj.Spec.Roles[:i], proj.Spec.Roles[i+1:]...)
+		_, err = e.client.Update(ctx, &project.ProjectUpdateRequest{Project: proj})
+		return err
+	})
+	if projects.IsErrorProjectNotFound(err) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteProjectRole)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+// upsertRole adds or replaces the role in the project. The project is read
+// and written using its resource version, so concurrent changes by other
+// ProjectRoles or the Project itself are retried instead of being overwritten.
+func (e *external) upsertRole(ctx context.Context, cr *v1alpha1.ProjectRole) error {
+	if cr.Spec.ForProvider.Project == nil {
+		return errors.New(errProjectNotResolved)
+	}
+
+	name := meta.GetExternalName(cr)
+	return retry.OnError(retry.DefaultRetry, projects.IsErrorConflict, func() error {
+		proj, err := e.client.Get(ctx, &project.ProjectQuery{Name: *cr.Spec.ForProvider.Project})
+		if err != nil {
+			return errors.Wrap(err, errGetProjectFailed)
+		}
+		role := generateProjectRole(name, &cr.Spec.ForProvider)
+		if existing, i, err := proj.GetRoleByName(name); err == nil {
+			// tokens are managed by ArgoCD and must be preserved
+			role.JWTTokens = existing.JWTTokens
+			proj.Spec.Roles[i] = role
+		} else {
+			proj.Spec.Roles = append(proj.Spec.Roles, role)
+		}
+		_, err = e.client.Update(ctx, &project.ProjectUpdateRequest{Project: proj})
+		return err
+	})
+}
+
+func generateProjectRole(name string, p *v1alpha1.ProjectRoleParameters) argocdv1alpha1.ProjectRole {
+	return argocdv1alpha1.ProjectRole{
+		Name:        name,
+		Description: clients.StringValue(p.Description),
+		Policies:    p.Policies,
+		Groups:      p.Groups,
+	}
+}
+
+func generateProjectRoleObservation(r *argocdv1alpha1.ProjectRole) v1alpha1.ProjectRoleObservation {
+	o := v1alpha1.ProjectRoleObservation{}
+	if r.JWTTokens != nil {
+		o.JWTTokens = make([]projectsv1alpha1.JWTToken, len(r.JWTTokens))
+		for i, t := range r.JWTTokens {
+			o.JWTTokens[i] = projectsv1alpha1.JWTToken{
+				IssuedAt:  t.IssuedAt,
+				ExpiresAt: ptr.To(t.ExpiresAt),
+				ID:        ptr.To(t.ID),
+			}
+		}
+	}
+	return o
+}
+
+func lateInitializeProjectRole(p *v1alpha1.ProjectRoleParameters, r *argocdv1alpha1.ProjectRole) {
+	p.Description = clients.LateInitializeStringPtr(p.Description, r.Description)
+	if p.Policies == nil && r.Policies != nil {
+		p.Policies = r.Policies
+	}
+	if p.Groups == nil && r.Groups != nil {
+		p.Groups = r.Groups
+	}
+}
+
+func isProjectRoleUpToDate(p *v1alpha1.ProjectRoleParameters, r *argocdv1alpha1.ProjectRole) bool {
+	switch {
+	case clients.StringValue(p.Description) != r.Description,
+		!cmp.Equal(p.Policies, r.Policies, cmpopts.EquateEmpty()),
+		!cmp.Equal(p.Groups, r.Groups, cmpopts.EquateEmpty()):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,708 @@
+package projectroles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/projectroles/v1alpha1"
+	projectsv1alpha1 "github.com/crossplane-contrib/provider-argocd/apis/cluster/projects/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/projects"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/projects"
+)
+
+var (
+	testProjectName           = "test-project"
+	testRoleName              = "test-role"
+	testOtherRoleName         = "other-role"
+	testDescription           = "test description"
+	testPolicy                = "p, proj:test-project:test-role, applications, get, test-project/*, allow"
+	testGroup                 = "test-group"
+	testIssuedAt        int64 = 1
+	testExpiresAt       int64 = 2
+	testTokenID               = "test-token"
+	testResourceVersion       = "1"
+	errBoom                   = errors.New("boom")
+	errProjectNotFound        = errors.New("code = NotFound desc = appprojects")
+	errConflict               = status.Error(codes.Aborted, "the object has been modified")
+)
+
+type args struct {
+	client projects.ProjectServiceClient
+	cr     *v1alpha1.ProjectRole
+}
+
+type mockModifier func(*mockclient.MockProjectServiceClient)
+
+func withMockClient(t *testing.T, mod mockModifier) *mockclient.MockProjectServiceClient {
+	ctrl := gomock.NewController(t)
+	mock := mockclient.NewMockProjectServiceClient(ctrl)
+	mod(mock)
+	return mock
+}
+
+func ProjectRole(m ...ProjectRoleModifier) *v1alpha1.ProjectRole {
+	cr := &v1alpha1.ProjectRole{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+type ProjectRoleModifier func(*v1alpha1.ProjectRole)
+
+func withExternalName(v string) ProjectRoleModifier {
+	return func(s *v1alpha1.ProjectRole) {
+		meta.SetExternalName(s, v)
+	}
+}
+
+func withSpec(p v1alpha1.ProjectRoleParameters) ProjectRoleModifier {
+	return func(r *v1alpha1.ProjectRole) { r.Spec.ForProvider = p }
+}
+
+func withObservation(p v1alpha1.ProjectRoleObservation) ProjectRoleModifier {
+	return func(r *v1alpha1.ProjectRole) { r.Status.AtProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) ProjectRoleModifier {
+	return func(r *v1alpha1.ProjectRole) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func appProject(roles ...argocdv1alpha1.ProjectRole) *argocdv1alpha1.AppProject {
+	return &argocdv1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            testProjectName,
+			ResourceVersion: testResourceVersion,
+		},
+		Spec: argocdv1alpha1.AppProjectSpec{
+			Roles: roles,
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProjectRole
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(argocdv1alpha1.ProjectRole{
+						Name:        testRoleName,
+						Description: testDescription,
+						Policies:    []string{testPolicy},
+						Groups:      []string{testGroup},
+						JWTTokens: []argocdv1alpha1.JWTToken{
+							{IssuedAt: testIssuedAt, ExpiresAt: testExpiresAt, ID: testTokenID},
+						},
+					}), nil)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project:     &testProjectName,
+						Description: &testDescription,
+						Policies:    []string{testPolicy},
+						Groups:      []string{testGroup},
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project:     &testProjectName,
+						Description: &testDescription,
+						Policies:    []string{testPolicy},
+						Groups:      []string{testGroup},
+					}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.ProjectRoleObservation{
+						JWTTokens: []projectsv1alpha1.JWTToken{
+							{IssuedAt: testIssuedAt, ExpiresAt: &testExpiresAt, ID: &testTokenID},
+						},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SuccessfulLateInitialize": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(argocdv1alpha1.ProjectRole{
+						Name:        testRoleName,
+						Description: testDescription,
+						Policies:    []string{testPolicy},
+					}), nil)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project:     &testProjectName,
+						Description: &testDescription,
+						Policies:    []string{testPolicy},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(argocdv1alpha1.ProjectRole{
+						Name:     testRoleName,
+						Policies: []string{testPolicy},
+					}), nil)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project:  &testProjectName,
+						Policies: []string{},
+						Groups:   []string{testGroup},
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project:  &testProjectName,
+						Policies: []string{},
+						Groups:   []string{testGroup},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RoleNotFound": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(argocdv1alpha1.ProjectRole{
+						Name: testOtherRoleName,
+					}), nil)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"ProjectNotFound": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(nil, errProjectNotFound)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"GetProjectFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(nil, errBoom)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+				err: errors.Wrap(errBoom, errGetProjectFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProjectRole
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreate": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(argocdv1alpha1.ProjectRole{
+						Name: testOtherRoleName,
+					}), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject(
+								argocdv1alpha1.ProjectRole{Name: testOtherRoleName},
+								argocdv1alpha1.ProjectRole{
+									Name:        testRoleName,
+									Description: testDescription,
+									Policies:    []string{testPolicy},
+								},
+							),
+						},
+					).Return(&argocdv1alpha1.AppProject{}, nil)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project:     &testProjectName,
+						Description: &testDescription,
+						Policies:    []string{testPolicy},
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project:     &testProjectName,
+						Description: &testDescription,
+						Policies:    []string{testPolicy},
+					}),
+				),
+			},
+		},
+		"RetryOnConflict": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(), nil).Times(2)
+					gomock.InOrder(
+						mcs.EXPECT().Update(
+							context.Background(),
+							&project.ProjectUpdateRequest{
+								Project: appProject(argocdv1alpha1.ProjectRole{Name: testRoleName}),
+							},
+						).Return(nil, errConflict),
+						mcs.EXPECT().Update(
+							context.Background(),
+							&project.ProjectUpdateRequest{
+								Project: appProject(argocdv1alpha1.ProjectRole{Name: testRoleName}),
+							},
+						).Return(&argocdv1alpha1.AppProject{}, nil),
+					)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject(argocdv1alpha1.ProjectRole{Name: testRoleName}),
+						},
+					).Return(nil, errBoom)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+				err: errors.Wrap(errBoom, errUpdateProjectRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProjectRole
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulUpdatePreservesTokens": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(argocdv1alpha1.ProjectRole{
+						Name:     testRoleName,
+						Policies: []string{testPolicy},
+						JWTTokens: []argocdv1alpha1.JWTToken{
+							{IssuedAt: testIssuedAt, ID: testTokenID},
+						},
+					}), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject(argocdv1alpha1.ProjectRole{
+								Name:   testRoleName,
+								Groups: []string{testGroup},
+								JWTTokens: []argocdv1alpha1.JWTToken{
+									{IssuedAt: testIssuedAt, ID: testTokenID},
+								},
+							}),
+						},
+					).Return(&argocdv1alpha1.AppProject{}, nil)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+						Groups:  []string{testGroup},
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+						Groups:  []string{testGroup},
+					}),
+				),
+			},
+		},
+		"GetProjectFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(nil, errBoom)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+				err: errors.Wrap(errors.Wrap(errBoom, errGetProjectFailed), errUpdateProjectRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ProjectRole
+		err error
+		res managed.ExternalDelete
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDelete": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(
+						argocdv1alpha1.ProjectRole{Name: testRoleName},
+						argocdv1alpha1.ProjectRole{Name: testOtherRoleName},
+					), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject(argocdv1alpha1.ProjectRole{Name: testOtherRoleName}),
+						},
+					).Return(&argocdv1alpha1.AppProject{}, nil)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+		},
+		"RoleAlreadyDeleted": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(argocdv1alpha1.ProjectRole{Name: testOtherRoleName}), nil)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+		},
+		"ProjectNotFound": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(nil, errProjectNotFound)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(argocdv1alpha1.ProjectRole{Name: testRoleName}), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject([]argocdv1alpha1.ProjectRole{}...),
+						},
+					).Return(nil, errBoom)
+				}),
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+			},
+			want: want{
+				cr: ProjectRole(
+					withExternalName(testRoleName),
+					withSpec(v1alpha1.ProjectRoleParameters{
+						Project: &testProjectName,
+					}),
+				),
+				err: errors.Wrap(errBoom, errDeleteProjectRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			got, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.res, got, test.EquateErrors()); diff != "" {
+				t.Errorf("res: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
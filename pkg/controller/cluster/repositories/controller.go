@@ -18,7 +18,6 @@ package repositories
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
@@ -33,8 +32,6 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -147,71 +144,41 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err
 	}
 
-	resourceVersions, err := e.getSecretResource(ctx, cr)
+	resourceVersions, err := GetSecretResourceVersions(ctx, e.kube, RepositorySecretRefs(&cr.Spec.ForProvider))
 
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
 	current := cr.Spec.ForProvider.DeepCopy()
-	lateInitializeRepository(&cr.Spec.ForProvider, observedRepository)
+	LateInitializeRepository(&cr.Spec.ForProvider, observedRepository)
 
 	currentStatusAtProvider := cr.Status.AtProvider.DeepCopy()
-	cr.Status.AtProvider = generateRepositoryObservation(observedRepository, resourceVersions)
+	cr.Status.AtProvider = GenerateRepositoryObservation(observedRepository, resourceVersions)
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isRepositoryUpToDate(cr, currentStatusAtProvider, observedRepository),
+		ResourceUpToDate:        IsRepositoryUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider, currentStatusAtProvider, observedRepository),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
 
-func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) { //nolint:gocyclo
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.Repository)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotRepository)
 	}
 
-	repoCreateRequest := generateCreateRepositoryOptions(&cr.Spec.ForProvider)
-
-	if cr.Spec.ForProvider.PasswordRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.PasswordRef)
-		if err != nil {
-			return managed.ExternalCreation{}, err
-		}
-		repoCreateRequest.Repo.Password = string(payload)
-	}
-	if cr.Spec.ForProvider.SSHPrivateKeyRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.SSHPrivateKeyRef)
-		if err != nil {
-			return managed.ExternalCreation{}, err
-		}
-		repoCreateRequest.Repo.SSHPrivateKey = string(payload)
-	}
-	if cr.Spec.ForProvider.TLSClientCertDataRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.TLSClientCertDataRef)
-		if err != nil {
-			return managed.ExternalCreation{}, err
-		}
-		repoCreateRequest.Repo.TLSClientCertData = string(payload)
-	}
-	if cr.Spec.ForProvider.TLSClientCertKeyRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.TLSClientCertKeyRef)
-		if err != nil {
-			return managed.ExternalCreation{}, err
-		}
-		repoCreateRequest.Repo.TLSClientCertKey = string(payload)
-	}
-	if cr.Spec.ForProvider.GithubAppPrivateKeyRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.GithubAppPrivateKeyRef)
-		if err != nil {
-			return managed.ExternalCreation{}, err
-		}
-		repoCreateRequest.Repo.GithubAppPrivateKey = string(payload)
-	}
-
-	_, err := e.client.CreateRepository(ctx, repoCreateRequest)
+	repoCreateRequest := GenerateCreateRepositoryOptions(&cr.Spec.ForProvider)
+
+	payloads, err := GetSecretPayloads(ctx, e.kube, RepositorySecretRefs(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	SetRepositorySecrets(repoCreateRequest.Repo, payloads)
+
+	_, err = e.client.CreateRepository(ctx, repoCreateRequest)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
@@ -221,51 +188,21 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalCreation{}, errors.Wrap(nil, errKubeUpdateFailed)
 }
 
-func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { //nolint:gocyclo
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mg.(*v1alpha1.Repository)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotRepository)
 	}
 
-	repoUpdateRequest := generateUpdateRepositoryOptions(&cr.Spec.ForProvider)
-
-	if cr.Spec.ForProvider.PasswordRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.PasswordRef)
-		if err != nil {
-			return managed.ExternalUpdate{}, err
-		}
-		repoUpdateRequest.Repo.Password = string(payload)
-	}
-	if cr.Spec.ForProvider.SSHPrivateKeyRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.SSHPrivateKeyRef)
-		if err != nil {
-			return managed.ExternalUpdate{}, err
-		}
-		repoUpdateRequest.Repo.SSHPrivateKey = string(payload)
-	}
-	if cr.Spec.ForProvider.TLSClientCertDataRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.TLSClientCertDataRef)
-		if err != nil {
-			return managed.ExternalUpdate{}, err
-		}
-		repoUpdateRequest.Repo.TLSClientCertData = string(payload)
-	}
-	if cr.Spec.ForProvider.TLSClientCertKeyRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.TLSClientCertKeyRef)
-		if err != nil {
-			return managed.ExternalUpdate{}, err
-		}
-		repoUpdateRequest.Repo.TLSClientCertKey = string(payload)
-	}
-	if cr.Spec.ForProvider.GithubAppPrivateKeyRef != nil {
-		payload, err := e.getPayload(ctx, cr.Spec.ForProvider.GithubAppPrivateKeyRef)
-		if err != nil {
-			return managed.ExternalUpdate{}, err
-		}
-		repoUpdateRequest.Repo.GithubAppPrivateKey = string(payload)
-	}
-
-	_, err := e.client.UpdateRepository(ctx, repoUpdateRequest)
+	repoUpdateRequest := GenerateUpdateRepositoryOptions(&cr.Spec.ForProvider)
+
+	payloads, err := GetSecretPayloads(ctx, e.kube, RepositorySecretRefs(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	SetRepositorySecrets(repoUpdateRequest.Repo, payloads)
+
+	_, err = e.client.UpdateRepository(ctx, repoUpdateRequest)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 	}
@@ -291,7 +228,8 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
 }
 
-func lateInitializeRepository(p *v1alpha1.RepositoryParameters, r *argocdv1alpha1.Repository) {
+// LateInitializeRepository fills unset parameters with the values observed in ArgoCD.
+func LateInitializeRepository(p *v1alpha1.RepositoryParameters, r *argocdv1alpha1.Repository) {
 	if r == nil {
 		return
 	}
@@ -316,21 +254,18 @@ func lateInitializeRepository(p *v1alpha1.RepositoryParameters, r *argocdv1alpha
 	p.GithubAppID = clients.LateInitializeInt64Ptr(p.GithubAppID, r.GithubAppId)
 	p.GithubAppInstallationID = clients.LateInitializeInt64Ptr(p.GithubAppInstallationID, r.GithubAppInstallationId)
 	p.GitHubAppEnterpriseBaseURL = clients.LateInitializeStringPtr(p.GitHubAppEnterpriseBaseURL, r.GitHubAppEnterpriseBaseURL)
+	p.Proxy = clients.LateInitializeStringPtr(p.Proxy, r.Proxy)
+	p.NoProxy = clients.LateInitializeStringPtr(p.NoProxy, r.NoProxy)
+	if p.ForceHTTPBasicAuth == nil {
+		p.ForceHTTPBasicAuth = &r.ForceHttpBasicAuth
+	}
+	if p.UseAzureWorkloadIdentity == nil {
+		p.UseAzureWorkloadIdentity = &r.UseAzureWorkloadIdentity
+	}
 }
 
-type secretResourceVersion struct {
-	Password string
-
-	SSHPrivateKey string
-
-	TLSClientCertData string
-
-	TLSClientCertKey string
-
-	GithubAppPrivateKey string
-}
-
-func generateRepositoryObservation(r *argocdv1alpha1.Repository, secretResourceVersion secretResourceVersion) v1alpha1.RepositoryObservation {
+// GenerateRepositoryObservation builds the observation of a repository.
+func GenerateRepositoryObservation(r *argocdv1alpha1.Repository, secretResourceVersion SecretValues) v1alpha1.RepositoryObservation {
 	if r == nil {
 		return v1alpha1.RepositoryObservation{}
 	}
@@ -340,41 +275,20 @@ func generateRepositoryObservation(r *argocdv1alpha1.Repository, secretResourceV
 			Message:    r.ConnectionState.Message,
 			ModifiedAt: r.ConnectionState.ModifiedAt,
 		},
+		Password:             PasswordObservation(secretResourceVersion.Password),
+		SSHPrivateKey:        PasswordObservation(secretResourceVersion.SSHPrivateKey),
+		TLSClientCertData:    PasswordObservation(secretResourceVersion.TLSClientCertData),
+		TLSClientCertKey:     PasswordObservation(secretResourceVersion.TLSClientCertKey),
+		GithubAppPrivateKey:  PasswordObservation(secretResourceVersion.GithubAppPrivateKey),
+		GCPServiceAccountKey: PasswordObservation(secretResourceVersion.GCPServiceAccountKey),
+		BearerToken:          PasswordObservation(secretResourceVersion.BearerToken),
 	}
 
-	if secretResourceVersion.Password != "" {
-		o.Password = &v1alpha1.PasswordObservation{
-			Secret: v1alpha1.SecretObservation{ResourceVersion: secretResourceVersion.Password},
-		}
-	}
-
-	if secretResourceVersion.SSHPrivateKey != "" {
-		o.SSHPrivateKey = &v1alpha1.PasswordObservation{
-			Secret: v1alpha1.SecretObservation{ResourceVersion: secretResourceVersion.SSHPrivateKey},
-		}
-	}
-
-	if secretResourceVersion.TLSClientCertData != "" {
-		o.TLSClientCertData = &v1alpha1.PasswordObservation{
-			Secret: v1alpha1.SecretObservation{ResourceVersion: secretResourceVersion.TLSClientCertData},
-		}
-	}
-
-	if secretResourceVersion.TLSClientCertKey != "" {
-		o.TLSClientCertKey = &v1alpha1.PasswordObservation{
-			Secret: v1alpha1.SecretObservation{ResourceVersion: secretResourceVersion.TLSClientCertKey},
-		}
-	}
-
-	if secretResourceVersion.GithubAppPrivateKey != "" {
-		o.GithubAppPrivateKey = &v1alpha1.PasswordObservation{
-			Secret: v1alpha1.SecretObservation{ResourceVersion: secretResourceVersion.GithubAppPrivateKey},
-		}
-	}
 	return o
 }
 
-func generateCreateRepositoryOptions(p *v1alpha1.RepositoryParameters) *repository.RepoCreateRequest { //nolint:gocyclo
+// GenerateCreateRepositoryOptions builds the create request of a repository.
+func GenerateCreateRepositoryOptions(p *v1alpha1.RepositoryParameters) *repository.RepoCreateRequest { //nolint:gocyclo
 	repo := &argocdv1alpha1.Repository{
 		Repo: p.Repo,
 	}
@@ -411,6 +325,18 @@ func generateCreateRepositoryOptions(p *v1alpha1.RepositoryParameters) *reposito
 	if p.GitHubAppEnterpriseBaseURL != nil {
 		repo.GitHubAppEnterpriseBaseURL = *p.GitHubAppEnterpriseBaseURL
 	}
+	if p.Proxy != nil {
+		repo.Proxy = *p.Proxy
+	}
+	if p.NoProxy != nil {
+		repo.NoProxy = *p.NoProxy
+	}
+	if p.ForceHTTPBasicAuth != nil {
+		repo.ForceHttpBasicAuth = *p.ForceHTTPBasicAuth
+	}
+	if p.UseAzureWorkloadIdentity != nil {
+		repo.UseAzureWorkloadIdentity = *p.UseAzureWorkloadIdentity
+	}
 
 	repoCreateRequest := &repository.RepoCreateRequest{
 		Repo:      repo,
@@ -421,7 +347,8 @@ func generateCreateRepositoryOptions(p *v1alpha1.RepositoryParameters) *reposito
 	return repoCreateRequest
 }
 
-func generateUpdateRepositoryOptions(p *v1alpha1.RepositoryParameters) *repository.RepoUpdateRequest {
+// GenerateUpdateRepositoryOptions builds the update request of a repository.
+func GenerateUpdateRepositoryOptions(p *v1alpha1.RepositoryParameters) *repository.RepoUpdateRequest {
 	repo := &argocdv1alpha1.Repository{
 		Repo:           p.Repo,
 		Insecure:       *p.Insecure,
@@ -451,6 +378,18 @@ func generateUpdateRepositoryOptions(p *v1alpha1.RepositoryParameters) *reposito
 	if p.GitHubAppEnterpriseBaseURL != nil {
 		repo.GitHubAppEnterpriseBaseURL = *p.GitHubAppEnterpriseBaseURL
 	}
+	if p.Proxy != nil {
+		repo.Proxy = *p.Proxy
+	}
+	if p.NoProxy != nil {
+		repo.NoProxy = *p.NoProxy
+	}
+	if p.ForceHTTPBasicAuth != nil {
+		repo.ForceHttpBasicAuth = *p.ForceHTTPBasicAuth
+	}
+	if p.UseAzureWorkloadIdentity != nil {
+		repo.UseAzureWorkloadIdentity = *p.UseAzureWorkloadIdentity
+	}
 
 	o := &repository.RepoUpdateRequest{
 		Repo: repo,
@@ -458,8 +397,10 @@ func generateUpdateRepositoryOptions(p *v1alpha1.RepositoryParameters) *reposito
 	return o
 }
 
-func isRepositoryUpToDate(rr *v1alpha1.Repository, o *v1alpha1.RepositoryObservation, r *argocdv1alpha1.Repository) bool { //nolint:gocyclo
-	p := rr.Spec.ForProvider
+// IsRepositoryUpToDate compares the parameters with the observed repository.
+// Changes of referenced secrets are detected by comparing the observation ao
+// with the previous observation o.
+func IsRepositoryUpToDate(p *v1alpha1.RepositoryParameters, ao, o *v1alpha1.RepositoryObservation, r *argocdv1alpha1.Repository) bool { //nolint:gocyclo
 	if !cmp.Equal(p.Username, clients.StringToPtr(r.Username)) {
 		return false
 	}
@@ -490,93 +431,41 @@ func isRepositoryUpToDate(rr *v1alpha1.Repository, o *v1alpha1.RepositoryObserva
 	if !cmp.Equal(p.GitHubAppEnterpriseBaseURL, clients.StringToPtr(r.GitHubAppEnterpriseBaseURL)) {
 		return false
 	}
-	if !cmp.Equal(rr.Status.AtProvider.Password, o.Password) {
+	if !cmp.Equal(p.Proxy, clients.StringToPtr(r.Proxy)) {
 		return false
 	}
-	if !cmp.Equal(rr.Status.AtProvider.SSHPrivateKey, o.SSHPrivateKey) {
+	if !cmp.Equal(p.NoProxy, clients.StringToPtr(r.NoProxy)) {
 		return false
 	}
-	if !cmp.Equal(rr.Status.AtProvider.TLSClientCertData, o.TLSClientCertData) {
+	if !clients.IsBoolEqualToBoolPtr(p.ForceHTTPBasicAuth, r.ForceHttpBasicAuth) {
 		return false
 	}
-	if !cmp.Equal(rr.Status.AtProvider.TLSClientCertKey, o.TLSClientCertKey) {
+	if !clients.IsBoolEqualToBoolPtr(p.UseAzureWorkloadIdentity, r.UseAzureWorkloadIdentity) {
 		return false
 	}
-	if !cmp.Equal(rr.Status.AtProvider.GithubAppPrivateKey, o.GithubAppPrivateKey) {
+	if !cmp.Equal(ao.Password, o.Password) {
 		return false
 	}
-
-	return true
-}
-
-// fetch resource version from a SecretRef so that we can track any updates
-func (e *external) getSecretResourceVersion(ctx context.Context, ref *v1alpha1.SecretReference) (string, error) {
-	if ref == nil {
-		return "", nil
-	}
-	nn := types.NamespacedName{
-		Name:      ref.Name,
-		Namespace: ref.Namespace,
-	}
-	sc := &corev1.Secret{}
-	if err := e.kube.Get(ctx, nn, sc); err != nil {
-		return "", errors.Wrap(err, errGetSecretFailed)
-	}
-	return sc.GetResourceVersion(), nil
-}
-
-// fetch kubernetes secret payload
-func (e *external) getPayload(ctx context.Context, ref *v1alpha1.SecretReference) ([]byte, error) {
-
-	nn := types.NamespacedName{
-		Name:      ref.Name,
-		Namespace: ref.Namespace,
-	}
-	sc := &corev1.Secret{}
-	if err := e.kube.Get(ctx, nn, sc); err != nil {
-		return nil, errors.Wrap(err, errGetSecretFailed)
-	}
-	if ref.Key != "" {
-		val, ok := sc.Data[ref.Key]
-		if !ok {
-			return nil, errors.New(fmt.Sprintf(errFmtKeyNotFound, ref.Key))
-		}
-		return val, nil
+	if !cmp.Equal(ao.SSHPrivateKey, o.SSHPrivateKey) {
+		return false
 	}
-
-	return nil, nil
-}
-
-func (e *external) getSecretResource(ctx context.Context, cr *v1alpha1.Repository) (secretResourceVersion, error) {
-	passwordSecretResourceVersion, err := e.getSecretResourceVersion(ctx, cr.Spec.ForProvider.PasswordRef)
-	if err != nil {
-		return secretResourceVersion{}, err
+	if !cmp.Equal(ao.TLSClientCertData, o.TLSClientCertData) {
+		return false
 	}
-	sshPrivateKeyResourceVersion, err := e.getSecretResourceVersion(ctx, cr.Spec.ForProvider.SSHPrivateKeyRef)
-	if err != nil {
-		return secretResourceVersion{}, err
+	if !cmp.Equal(ao.TLSClientCertKey, o.TLSClientCertKey) {
+		return false
 	}
-	tlsClientCertDataResourceVersion, err := e.getSecretResourceVersion(ctx, cr.Spec.ForProvider.TLSClientCertDataRef)
-	if err != nil {
-		return secretResourceVersion{}, err
+	if !cmp.Equal(ao.GithubAppPrivateKey, o.GithubAppPrivateKey) {
+		return false
 	}
-	tlsClientCertKeyResourceVersion, err := e.getSecretResourceVersion(ctx, cr.Spec.ForProvider.TLSClientCertKeyRef)
-	if err != nil {
-		return secretResourceVersion{}, err
+	if !cmp.Equal(ao.GCPServiceAccountKey, o.GCPServiceAccountKey) {
+		return false
 	}
-	githubAppPrivateKeyResourceVersion, err := e.getSecretResourceVersion(ctx, cr.Spec.ForProvider.GithubAppPrivateKeyRef)
-	if err != nil {
-		return secretResourceVersion{}, err
+	if !cmp.Equal(ao.BearerToken, o.BearerToken) {
+		return false
 	}
 
-	return secretResourceVersion{
-		Password:            passwordSecretResourceVersion,
-		SSHPrivateKey:       sshPrivateKeyResourceVersion,
-		TLSClientCertData:   tlsClientCertDataResourceVersion,
-		TLSClientCertKey:    tlsClientCertKeyResourceVersion,
-		GithubAppPrivateKey: githubAppPrivateKeyResourceVersion,
-	}, nil
-
+	return true
 }
 
 func (e *external) Disconnect(ctx context.Context) error {
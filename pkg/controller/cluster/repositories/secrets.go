@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+)
+
+// SecretRefs holds the secret references shared by repositories, write
+// repositories and write repository credentials.
+type SecretRefs struct {
+	Password             *v1alpha1.SecretReference
+	SSHPrivateKey        *v1alpha1.SecretReference
+	TLSClientCertData    *v1alpha1.SecretReference
+	TLSClientCertKey     *v1alpha1.SecretReference
+	GithubAppPrivateKey  *v1alpha1.SecretReference
+	GCPServiceAccountKey *v1alpha1.SecretReference
+	BearerToken          *v1alpha1.SecretReference
+}
+
+// SecretValues holds the payloads or the resource versions of the secrets
+// referenced by SecretRefs. Unset references result in empty values.
+type SecretValues struct {
+	Password string
+
+	SSHPrivateKey string
+
+	TLSClientCertData string
+
+	TLSClientCertKey string
+
+	GithubAppPrivateKey string
+
+	GCPServiceAccountKey string
+
+	BearerToken string
+}
+
+// RepositorySecretRefs returns the secret references of repository parameters.
+func RepositorySecretRefs(p *v1alpha1.RepositoryParameters) SecretRefs {
+	return SecretRefs{
+		Password:             p.PasswordRef,
+		SSHPrivateKey:        p.SSHPrivateKeyRef,
+		TLSClientCertData:    p.TLSClientCertDataRef,
+		TLSClientCertKey:     p.TLSClientCertKeyRef,
+		GithubAppPrivateKey:  p.GithubAppPrivateKeyRef,
+		GCPServiceAccountKey: p.GCPServiceAccountKeyRef,
+		BearerToken:          p.BearerTokenRef,
+	}
+}
+
+// SetRepositorySecrets copies the secret payloads into the repository.
+func SetRepositorySecrets(r *argocdv1alpha1.Repository, v SecretValues) {
+	r.Password = v.Password
+	r.SSHPrivateKey = v.SSHPrivateKey
+	r.TLSClientCertData = v.TLSClientCertData
+	r.TLSClientCertKey = v.TLSClientCertKey
+	r.GithubAppPrivateKey = v.GithubAppPrivateKey
+	r.GCPServiceAccountKey = v.GCPServiceAccountKey
+	r.BearerToken = v.BearerToken
+}
+
+// PasswordObservation returns the observation of a secret with the given
+// resource version, or nil if no secret is referenced.
+func PasswordObservation(resourceVersion string) *v1alpha1.PasswordObservation {
+	if resourceVersion == "" {
+		return nil
+	}
+	return &v1alpha1.PasswordObservation{
+		Secret: v1alpha1.SecretObservation{ResourceVersion: resourceVersion},
+	}
+}
+
+// GetSecretPayloads fetches the payloads of all referenced secrets.
+func GetSecretPayloads(ctx context.Context, kube client.Client, refs SecretRefs) (SecretValues, error) {
+	v := SecretValues{}
+	for _, s := range []struct {
+		ref *v1alpha1.SecretReference
+		val *string
+	}{
+		{refs.Password, &v.Password},
+		{refs.SSHPrivateKey, &v.SSHPrivateKey},
+		{refs.TLSClientCertData, &v.TLSClientCertData},
+		{refs.TLSClientCertKey, &v.TLSClientCertKey},
+		{refs.GithubAppPrivateKey, &v.GithubAppPrivateKey},
+		{refs.GCPServiceAccountKey, &v.GCPServiceAccountKey},
+		{refs.BearerToken, &v.BearerToken},
+	} {
+		if s.ref == nil {
+			continue
+		}
+		payload, err := getPayload(ctx, kube, s.ref)
+		if err != nil {
+			return SecretValues{}, err
+		}
+		*s.val = string(payload)
+	}
+	return v, nil
+}
+
+// GetSecretResourceVersions fetches the resource versions of all referenced
+// secrets.
+func GetSecretResourceVersions(ctx context.Context, kube client.Client, refs SecretRefs) (SecretValues, error) {
+	v := SecretValues{}
+	for _, s := range []struct {
+		ref *v1alpha1.SecretReference
+		val *string
+	}{
+		{refs.Password, &v.Password},
+		{refs.SSHPrivateKey, &v.SSHPrivateKey},
+		{refs.TLSClientCertData, &v.TLSClientCertData},
+		{refs.TLSClientCertKey, &v.TLSClientCertKey},
+		{refs.GithubAppPrivateKey, &v.GithubAppPrivateKey},
+		{refs.GCPServiceAccountKey, &v.GCPServiceAccountKey},
+		{refs.BearerToken, &v.BearerToken},
+	} {
+		resourceVersion, err := getSecretResourceVersion(ctx, kube, s.ref)
+		if err != nil {
+			return SecretValues{}, err
+		}
+		*s.val = resourceVersion
+	}
+	return v, nil
+}
+
+// fetch resource version from a SecretRef so that we can track any updates
+func getSecretResourceVersion(ctx context.Context, kube client.Client, ref *v1alpha1.SecretReference) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	nn := types.NamespacedName{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+	sc := &corev1.Secret{}
+	if err := kube.Get(ctx, nn, sc); err != nil {
+		return "", errors.Wrap(err, errGetSecretFailed)
+	}
+	return sc.GetResourceVersion(), nil
+}
+
+// fetch kubernetes secret payload
+func getPayload(ctx context.Context, kube client.Client, ref *v1alpha1.SecretReference) ([]byte, error) {
+
+	nn := types.NamespacedName{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+	sc := &corev1.Secret{}
+	if err := kube.Get(ctx, nn, sc); err != nil {
+		return nil, errors.Wrap(err, errGetSecretFailed)
+	}
+	if ref.Key != "" {
+		val, ok := sc.Data[ref.Key]
+		if !ok {
+			return nil, errors.New(fmt.Sprintf(errFmtKeyNotFound, ref.Key))
+		}
+		return val, nil
+	}
+
+	return nil, nil
+}
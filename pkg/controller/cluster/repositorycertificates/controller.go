@@ -0,0 +1,348 @@
+package repositorycertificates
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	certutil "github.com/argoproj/argo-cd/v3/util/cert"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/certificates"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotRepositoryCertificate = "managed resource is not a ArgoCD RepositoryCertificate custom resource"
+	errListFailed               = "cannot list ArgoCD repository certificates"
+	errCreateFailed             = "cannot create ArgoCD repository certificate"
+	errUpdateFailed             = "cannot update ArgoCD repository certificate"
+	errDeleteFailed             = "cannot delete ArgoCD repository certificate"
+	errGetSecretFailed          = "cannot get Kubernetes secret"
+	errFmtKeyNotFound           = "key %s is not found in referenced Kubernetes secret"
+	errNoCertData               = "neither certData nor certDataRef is set"
+	errCertDataSet              = "only one of certData and certDataRef may be set"
+	errNoCertSubType            = "certSubType must be set for SSH known host entries"
+	errParseCertData            = "cannot parse certificate data"
+	errFmtServerNameGlob        = "serverName %s must not contain any of the glob characters %s"
+
+	// globChars are matched by ArgoCD in the host name pattern of queries.
+	globChars = `*?[]\`
+
+	certTypeSSH   = "ssh"
+	certTypeHTTPS = "https"
+)
+
+// Setup adds a controller that reconciles repository certificates.
+func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.RepositoryCertificateKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: certificates.NewCertificateServiceClient,
+		}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.RepositoryCertificateList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.RepositoryCertificate{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RepositoryCertificateGroupVersionKind),
+			opts...))
+}
+
+type connector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, certificate.CertificateServiceClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryCertificate)
+	if !ok {
+		return nil, errors.New(errNotRepositoryCertificate)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &external{kube: c.kube, client: argocdClient, conn: conn}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client certificates.CertificateServiceClient
+	conn   io.Closer
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryCertificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRepositoryCertificate)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	p := &cr.Spec.ForProvider
+	list, err := e.client.ListCertificates(ctx, generateQuery(p))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListFailed)
+	}
+
+	observed := generateRepositoryCertificateObservation(p, list)
+	if len(observed.Certificates) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = observed
+	cr.Status.SetConditions(xpv1.Available())
+
+	data, err := e.getCertData(ctx, p)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	desired, err := generateCertificateInfo(p, data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errParseCertData)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isCertificateUpToDate(desired, observed.Certificates),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryCertificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRepositoryCertificate)
+	}
+
+	if err := e.upsertCertificate(ctx, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.ServerName)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryCertificate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRepositoryCertificate)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.upsertCertificate(ctx, &cr.Spec.ForProvider), errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryCertificate)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotRepositoryCertificate)
+	}
+
+	p := &cr.Spec.ForProvider
+	if err := validateServerName(p.ServerName); err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	// Only the observed certificates are deleted, one query per sub type.
+	// Deleting certificates that do not exist is not an error, ArgoCD
+	// returns an empty list in that case.
+	deleted := map[string]bool{}
+	for _, c := range cr.Status.AtProvider.Certificates {
+		if deleted[c.CertSubType] {
+			continue
+		}
+		_, err := e.client.DeleteCertificate(ctx, &certificate.RepositoryCertificateQuery{
+			HostNamePattern: p.ServerName,
+			CertType:        p.CertType,
+			CertSubType:     c.CertSubType,
+		})
+		if err != nil {
+			return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+		}
+		deleted[c.CertSubType] = true
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+// upsertCertificate creates the certificate or replaces the data of an
+// existing certificate for the same server name and type.
+func (e *external) upsertCertificate(ctx context.Context, p *v1alpha1.RepositoryCertificateParameters) error {
+	if err := validateServerName(p.ServerName); err != nil {
+		return err
+	}
+	if p.CertType == certTypeSSH && p.CertSubType == nil {
+		return errors.New(errNoCertSubType)
+	}
+	data, err := e.getCertData(ctx, p)
+	if err != nil {
+		return err
+	}
+	_, err = e.client.CreateCertificate(ctx, &certificate.RepositoryCertificateCreateRequest{
+		Certificates: &argocdv1alpha1.RepositoryCertificateList{
+			Items: []argocdv1alpha1.RepositoryCertificate{{
+				ServerName:  p.ServerName,
+				CertType:    p.CertType,
+				CertSubType: clients.StringValue(p.CertSubType),
+				CertData:    []byte(data),
+			}},
+		},
+		Upsert: true,
+	})
+	return err
+}
+
+// validateServerName rejects server names that ArgoCD would match as a glob,
+// so that a query never matches certificates of other servers.
+func validateServerName(name string) error {
+	if strings.ContainsAny(name, globChars) {
+		return errors.Errorf(errFmtServerNameGlob, name, globChars)
+	}
+	return nil
+}
+
+func generateQuery(p *v1alpha1.RepositoryCertificateParameters) *certificate.RepositoryCertificateQuery {
+	q := &certificate.RepositoryCertificateQuery{
+		HostNamePattern: p.ServerName,
+		CertType:        p.CertType,
+	}
+	// the sub type is only matched for SSH known host entries
+	if p.CertType == certTypeSSH {
+		q.CertSubType = clients.StringValue(p.CertSubType)
+	}
+	return q
+}
+
+func generateRepositoryCertificateObservation(p *v1alpha1.RepositoryCertificateParameters, l *argocdv1alpha1.RepositoryCertificateList) v1alpha1.RepositoryCertificateObservation {
+	o := v1alpha1.RepositoryCertificateObservation{}
+	if l == nil {
+		return o
+	}
+	for _, c := range l.Items {
+		// the host name pattern is a glob, so only exact matches are ours
+		if c.ServerName != p.ServerName || c.CertType != p.CertType {
+			continue
+		}
+		o.Certificates = append(o.Certificates, v1alpha1.CertificateInfo{
+			CertSubType: c.CertSubType,
+			CertInfo:    c.CertInfo,
+		})
+	}
+	return o
+}
+
+// generateCertificateInfo computes the information ArgoCD reports for the
+// given certificate data, the SHA256 fingerprint of an SSH host key or the
+// subject of each TLS certificate.
+func generateCertificateInfo(p *v1alpha1.RepositoryCertificateParameters, data string) ([]v1alpha1.CertificateInfo, error) {
+	switch p.CertType {
+	case certTypeSSH:
+		fingerprint := certutil.SSHFingerprintSHA256FromString(fmt.Sprintf("%s %s", p.ServerName, data))
+		if fingerprint == "" {
+			return nil, errors.New("invalid SSH public host key")
+		}
+		return []v1alpha1.CertificateInfo{{
+			CertSubType: clients.StringValue(p.CertSubType),
+			CertInfo:    "SHA256:" + fingerprint,
+		}}, nil
+	case certTypeHTTPS:
+		pems, err := certutil.ParseTLSCertificatesFromData(data)
+		if err != nil {
+			return nil, err
+		}
+		infos := make([]v1alpha1.CertificateInfo, len(pems))
+		for i, pem := range pems {
+			x509Cert, err := certutil.DecodePEMCertificateToX509(pem)
+			if err != nil {
+				return nil, err
+			}
+			infos[i] = v1alpha1.CertificateInfo{
+				CertSubType: strings.ToLower(x509Cert.PublicKeyAlgorithm.String()),
+				CertInfo:    x509Cert.Subject.String(),
+			}
+		}
+		return infos, nil
+	}
+	return nil, errors.Errorf("unknown certificate type %s", p.CertType)
+}
+
+func isCertificateUpToDate(desired, observed []v1alpha1.CertificateInfo) bool {
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b v1alpha1.CertificateInfo) bool {
+		if a.CertInfo != b.CertInfo {
+			return a.CertInfo < b.CertInfo
+		}
+		return a.CertSubType < b.CertSubType
+	}))
+}
+
+// getCertData returns the certificate data either inline or from the referenced secret
+func (e *external) getCertData(ctx context.Context, p *v1alpha1.RepositoryCertificateParameters) (string, error) {
+	switch {
+	case p.CertData != nil && p.CertDataRef != nil:
+		return "", errors.New(errCertDataSet)
+	case p.CertData != nil:
+		return *p.CertData, nil
+	case p.CertDataRef == nil:
+		return "", errors.New(errNoCertData)
+	}
+
+	ref := p.CertDataRef
+	nn := types.NamespacedName{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+	sc := &corev1.Secret{}
+	if err := e.kube.Get(ctx, nn, sc); err != nil {
+		return "", errors.Wrap(err, errGetSecretFailed)
+	}
+	val, ok := sc.Data[ref.Key]
+	if !ok {
+		return "", errors.New(fmt.Sprintf(errFmtKeyNotFound, ref.Key))
+	}
+	return string(val), nil
+}
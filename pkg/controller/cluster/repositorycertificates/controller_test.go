@@ -0,0 +1,496 @@
+package repositorycertificates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/certificates"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/certificates"
+)
+
+var (
+	testServerName     = "git.example.com"
+	testSSHSubType     = "ssh-ed25519"
+	testSSHKey         = "AAAAC3NzaC1lZDI1NTE5AAAAIFHNxgErRq9fL7ropm9o3SoWVsH43EOah5kjzJZTLnX3"
+	testSSHFingerprint = "SHA256:xO02BIHm5wnPGAoNz11rvVN72EU2chll/Z1ELplHj4I"
+	testOtherSSHKey    = "AAAAC3NzaC1lZDI1NTE5AAAAIJTohf2U5TdAdFiLWUgCwljDMN6Y4FOk4z0kb48xP/lk"
+	testTLSCert        = `-----BEGIN CERTIFICATE-----
+MIIBizCCATGgAwIBAgIUfHDSqbNLQwCTC9Q+aC7/90cKiAkwCgYIKoZIzj0EAwIw
+GjEYMBYGA1UEAwwPZ2l0LmV4YW1wbGUuY29tMCAXDTI2MTAxODIwNDUwMVoYDzIx
+MjYwOTI0MjA0NTAxWjAaMRgwFgYDVQQDDA9naXQuZXhhbXBsZS5jb20wWTATBgcq
+hkjOPQIBBggqhkjOPQMBBwNCAARSgbP5L3QG4Wm7m8yztgaY5ZSBEftRJwi0a7ZF
+h52l1YAk6zfmtgvRMMSkunfS9rUGtcipDqADxXM5QJDv1kW1o1MwUTAdBgNVHQ4E
+FgQU+rsaFvnc3BhAlOSPsyvLNRogvt8wHwYDVR0jBBgwFoAU+rsaFvnc3BhAlOSP
+syvLNRogvt8wDwYDVR0TAQH/BAUwAwEB/zAKBggqhkjOPQQDAgNIADBFAiEA32Q0
+wQlarJjrSZg3Y2xOdpaQ5Yb/KxS7piF7y0TL0vQCIC1zh6/eoUVTsiZvwQxLQDNt
+7ifB95v8erZS6kAnammu
+-----END CERTIFICATE-----`
+	testTLSSubject = "CN=git.example.com"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	client certificates.CertificateServiceClient
+	cr     *v1alpha1.RepositoryCertificate
+}
+
+type mockModifier func(*mockclient.MockCertificateServiceClient)
+
+func withMockClient(t *testing.T, mod mockModifier) *mockclient.MockCertificateServiceClient {
+	ctrl := gomock.NewController(t)
+	mock := mockclient.NewMockCertificateServiceClient(ctrl)
+	mod(mock)
+	return mock
+}
+
+func RepositoryCertificate(m ...RepositoryCertificateModifier) *v1alpha1.RepositoryCertificate {
+	cr := &v1alpha1.RepositoryCertificate{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+type RepositoryCertificateModifier func(*v1alpha1.RepositoryCertificate)
+
+func withExternalName(v string) RepositoryCertificateModifier {
+	return func(s *v1alpha1.RepositoryCertificate) {
+		meta.SetExternalName(s, v)
+	}
+}
+
+func withSpec(p v1alpha1.RepositoryCertificateParameters) RepositoryCertificateModifier {
+	return func(r *v1alpha1.RepositoryCertificate) { r.Spec.ForProvider = p }
+}
+
+func withObservation(p v1alpha1.RepositoryCertificateObservation) RepositoryCertificateModifier {
+	return func(r *v1alpha1.RepositoryCertificate) { r.Status.AtProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) RepositoryCertificateModifier {
+	return func(r *v1alpha1.RepositoryCertificate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func sshParameters(key string) v1alpha1.RepositoryCertificateParameters {
+	return v1alpha1.RepositoryCertificateParameters{
+		ServerName:  testServerName,
+		CertType:    certTypeSSH,
+		CertSubType: &testSSHSubType,
+		CertData:    &key,
+	}
+}
+
+func sshQuery() *certificate.RepositoryCertificateQuery {
+	return &certificate.RepositoryCertificateQuery{
+		HostNamePattern: testServerName,
+		CertType:        certTypeSSH,
+		CertSubType:     testSSHSubType,
+	}
+}
+
+func sshCertificateList() *argocdv1alpha1.RepositoryCertificateList {
+	return &argocdv1alpha1.RepositoryCertificateList{
+		Items: []argocdv1alpha1.RepositoryCertificate{{
+			ServerName:  testServerName,
+			CertType:    certTypeSSH,
+			CertSubType: testSSHSubType,
+			CertInfo:    testSSHFingerprint,
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.RepositoryCertificate
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {
+					mcs.EXPECT().ListCertificates(context.Background(), sshQuery()).Return(sshCertificateList(), nil)
+				}),
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+				),
+			},
+			want: want{
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.RepositoryCertificateObservation{
+						Certificates: []v1alpha1.CertificateInfo{{CertSubType: testSSHSubType, CertInfo: testSSHFingerprint}},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {
+					mcs.EXPECT().ListCertificates(context.Background(), sshQuery()).Return(sshCertificateList(), nil)
+				}),
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testOtherSSHKey)),
+				),
+			},
+			want: want{
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testOtherSSHKey)),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.RepositoryCertificateObservation{
+						Certificates: []v1alpha1.CertificateInfo{{CertSubType: testSSHSubType, CertInfo: testSSHFingerprint}},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {}),
+				cr:     RepositoryCertificate(withSpec(sshParameters(testSSHKey))),
+			},
+			want: want{
+				cr: RepositoryCertificate(withSpec(sshParameters(testSSHKey))),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {
+					mcs.EXPECT().ListCertificates(context.Background(), sshQuery()).Return(&argocdv1alpha1.RepositoryCertificateList{
+						Items: []argocdv1alpha1.RepositoryCertificate{{
+							ServerName:  "other." + testServerName,
+							CertType:    certTypeSSH,
+							CertSubType: testSSHSubType,
+							CertInfo:    testSSHFingerprint,
+						}},
+					}, nil)
+				}),
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+				),
+			},
+			want: want{
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"ListFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {
+					mcs.EXPECT().ListCertificates(context.Background(), sshQuery()).Return(nil, errBoom)
+				}),
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+				),
+			},
+			want: want{
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+				),
+				err: errors.Wrap(errBoom, errListFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.RepositoryCertificate
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {
+					mcs.EXPECT().CreateCertificate(
+						context.Background(),
+						&certificate.RepositoryCertificateCreateRequest{
+							Certificates: &argocdv1alpha1.RepositoryCertificateList{
+								Items: []argocdv1alpha1.RepositoryCertificate{{
+									ServerName:  testServerName,
+									CertType:    certTypeSSH,
+									CertSubType: testSSHSubType,
+									CertData:    []byte(testSSHKey),
+								}},
+							},
+							Upsert: true,
+						},
+					).Return(sshCertificateList(), nil)
+				}),
+				cr: RepositoryCertificate(withSpec(sshParameters(testSSHKey))),
+			},
+			want: want{
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+				),
+			},
+		},
+		"MissingSubType": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {}),
+				cr: RepositoryCertificate(withSpec(v1alpha1.RepositoryCertificateParameters{
+					ServerName: testServerName,
+					CertType:   certTypeSSH,
+					CertData:   &testSSHKey,
+				})),
+			},
+			want: want{
+				cr: RepositoryCertificate(withSpec(v1alpha1.RepositoryCertificateParameters{
+					ServerName: testServerName,
+					CertType:   certTypeSSH,
+					CertData:   &testSSHKey,
+				})),
+				err: errors.Wrap(errors.New(errNoCertSubType), errCreateFailed),
+			},
+		},
+		"GlobServerName": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {}),
+				cr: RepositoryCertificate(withSpec(v1alpha1.RepositoryCertificateParameters{
+					ServerName:  "git-[0-9].example.com",
+					CertType:    certTypeSSH,
+					CertSubType: &testSSHSubType,
+					CertData:    &testSSHKey,
+				})),
+			},
+			want: want{
+				cr: RepositoryCertificate(withSpec(v1alpha1.RepositoryCertificateParameters{
+					ServerName:  "git-[0-9].example.com",
+					CertType:    certTypeSSH,
+					CertSubType: &testSSHSubType,
+					CertData:    &testSSHKey,
+				})),
+				err: errors.Wrap(errors.Errorf(errFmtServerNameGlob, "git-[0-9].example.com", globChars), errCreateFailed),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {
+					mcs.EXPECT().CreateCertificate(context.Background(), gomock.Any()).Return(nil, errBoom)
+				}),
+				cr: RepositoryCertificate(withSpec(sshParameters(testSSHKey))),
+			},
+			want: want{
+				cr:  RepositoryCertificate(withSpec(sshParameters(testSSHKey))),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {
+					mcs.EXPECT().DeleteCertificate(context.Background(), sshQuery()).Return(sshCertificateList(), nil)
+				}),
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+					withObservation(v1alpha1.RepositoryCertificateObservation{
+						Certificates: []v1alpha1.CertificateInfo{{CertSubType: testSSHSubType, CertInfo: testSSHFingerprint}},
+					}),
+				),
+			},
+		},
+		"DeleteEachObservedSubType": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {
+					q := sshQuery()
+					mcs.EXPECT().DeleteCertificate(context.Background(), q).Return(sshCertificateList(), nil)
+					q2 := sshQuery()
+					q2.CertSubType = "ssh-rsa"
+					mcs.EXPECT().DeleteCertificate(context.Background(), q2).Return(&argocdv1alpha1.RepositoryCertificateList{}, nil)
+				}),
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(v1alpha1.RepositoryCertificateParameters{
+						ServerName: testServerName,
+						CertType:   certTypeSSH,
+						CertData:   &testSSHKey,
+					}),
+					withObservation(v1alpha1.RepositoryCertificateObservation{Certificates: []v1alpha1.CertificateInfo{
+						{CertSubType: testSSHSubType, CertInfo: testSSHFingerprint},
+						{CertSubType: "ssh-rsa", CertInfo: "SHA256:other"},
+						{CertSubType: testSSHSubType, CertInfo: "SHA256:duplicate"},
+					}}),
+				),
+			},
+		},
+		"NothingObserved": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {}),
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+				),
+			},
+		},
+		"GlobServerName": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {}),
+				cr: RepositoryCertificate(
+					withExternalName("*.example.com"),
+					withSpec(v1alpha1.RepositoryCertificateParameters{
+						ServerName:  "*.example.com",
+						CertType:    certTypeSSH,
+						CertSubType: &testSSHSubType,
+						CertData:    &testSSHKey,
+					}),
+					withObservation(v1alpha1.RepositoryCertificateObservation{
+						Certificates: []v1alpha1.CertificateInfo{{CertSubType: testSSHSubType, CertInfo: testSSHFingerprint}},
+					}),
+				),
+			},
+			want: want{
+				err: errors.Errorf(errFmtServerNameGlob, "*.example.com", globChars),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockCertificateServiceClient) {
+					mcs.EXPECT().DeleteCertificate(context.Background(), sshQuery()).Return(nil, errBoom)
+				}),
+				cr: RepositoryCertificate(
+					withExternalName(testServerName),
+					withSpec(sshParameters(testSSHKey)),
+					withObservation(v1alpha1.RepositoryCertificateObservation{
+						Certificates: []v1alpha1.CertificateInfo{{CertSubType: testSSHSubType, CertInfo: testSSHFingerprint}},
+					}),
+				),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCertificateInfo(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.RepositoryCertificateParameters
+		want []v1alpha1.CertificateInfo
+	}{
+		"SSH": {
+			p:    sshParameters(testSSHKey),
+			want: []v1alpha1.CertificateInfo{{CertSubType: testSSHSubType, CertInfo: testSSHFingerprint}},
+		},
+		"HTTPS": {
+			p: v1alpha1.RepositoryCertificateParameters{
+				ServerName: testServerName,
+				CertType:   certTypeHTTPS,
+				CertData:   &testTLSCert,
+			},
+			want: []v1alpha1.CertificateInfo{{CertSubType: "ecdsa", CertInfo: testTLSSubject}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := generateCertificateInfo(&tc.p, *tc.p.CertData)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("generateCertificateInfo: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
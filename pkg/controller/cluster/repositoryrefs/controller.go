@@ -0,0 +1,229 @@
+package repositoryrefs
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/repositories"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotRepositoryRefs   = "managed resource is not a ArgoCD RepositoryRefs custom resource"
+	errRepoNotResolved     = "repo of ArgoCD RepositoryRefs is not set"
+	errListRefsFailed      = "cannot list refs of ArgoCD repository"
+	errGetHelmChartsFailed = "cannot list helm charts of ArgoCD repository"
+	errGetAppDetailsFailed = "cannot get application details of ArgoCD repository"
+
+	repoTypeHelm = "helm"
+)
+
+// Setup adds a controller that observes repository refs.
+func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.RepositoryRefsKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: repositories.NewRepositoryServiceClient,
+		}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.RepositoryRefsList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.RepositoryRefs{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RepositoryRefsGroupVersionKind),
+			opts...))
+}
+
+type connector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, repository.RepositoryServiceClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryRefs)
+	if !ok {
+		return nil, errors.New(errNotRepositoryRefs)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &external{client: argocdClient, conn: conn}, nil
+}
+
+type external struct {
+	client repositories.RepositoryServiceClient
+	conn   io.Closer
+}
+
+// Observe queries the repository. RepositoryRefs is observe-only, so the
+// resource always exists and is up to date until it is deleted.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryRefs)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRepositoryRefs)
+	}
+
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	if cr.Spec.ForProvider.Repo == nil {
+		return managed.ExternalObservation{}, errors.New(errRepoNotResolved)
+	}
+
+	if !needsRefresh(&cr.Spec.ForProvider, &cr.Status.AtProvider, time.Now()) {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	obs, err := e.observeRepository(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	now := metav1.Now()
+	obs.LastRefreshTime = &now
+	obs.ObservedQuery = generateQuery(&cr.Spec.ForProvider)
+
+	cr.Status.AtProvider = *obs
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+func (e *external) observeRepository(ctx context.Context, p *v1alpha1.RepositoryRefsParameters) (*v1alpha1.RepositoryRefsObservation, error) {
+	query := &repository.RepoQuery{
+		Repo:       clients.StringValue(p.Repo),
+		AppProject: clients.StringValue(p.Project),
+	}
+	obs := &v1alpha1.RepositoryRefsObservation{}
+
+	if clients.StringValue(p.Type) == repoTypeHelm {
+		charts, err := e.client.GetHelmCharts(ctx, query)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetHelmChartsFailed)
+		}
+		for _, c := range charts.Items {
+			obs.HelmCharts = append(obs.HelmCharts, v1alpha1.HelmChartVersions{
+				Name:     c.Name,
+				Versions: c.Versions,
+			})
+		}
+	} else {
+		refs, err := e.client.ListRefs(ctx, query)
+		if err != nil {
+			return nil, errors.Wrap(err, errListRefsFailed)
+		}
+		obs.Branches = refs.Branches
+		obs.Tags = refs.Tags
+	}
+
+	if p.Path != nil {
+		details, err := e.client.GetAppDetails(ctx, &repository.RepoAppDetailsQuery{
+			Source:     generateApplicationSource(p),
+			AppProject: clients.StringValue(p.Project),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, errGetAppDetailsFailed)
+		}
+		obs.SourceType = clients.StringToPtr(details.Type)
+	}
+
+	return obs, nil
+}
+
+func generateApplicationSource(p *v1alpha1.RepositoryRefsParameters) *argocdv1alpha1.ApplicationSource {
+	source := &argocdv1alpha1.ApplicationSource{
+		RepoURL:        clients.StringValue(p.Repo),
+		TargetRevision: clients.StringValue(p.TargetRevision),
+	}
+	if clients.StringValue(p.Type) == repoTypeHelm {
+		source.Chart = clients.StringValue(p.Path)
+	} else {
+		source.Path = clients.StringValue(p.Path)
+	}
+	return source
+}
+
+func generateQuery(p *v1alpha1.RepositoryRefsParameters) *v1alpha1.RepositoryRefsQuery {
+	return &v1alpha1.RepositoryRefsQuery{
+		Repo:           p.Repo,
+		Type:           p.Type,
+		Project:        p.Project,
+		Path:           p.Path,
+		TargetRevision: p.TargetRevision,
+	}
+}
+
+// needsRefresh reports whether the repository has to be queried again. The
+// repository is queried on every poll unless a refresh interval is set, and
+// whenever the parameters differ from the last query.
+func needsRefresh(p *v1alpha1.RepositoryRefsParameters, o *v1alpha1.RepositoryRefsObservation, now time.Time) bool {
+	if p.RefreshInterval == nil || o.LastRefreshTime == nil {
+		return true
+	}
+	if !cmp.Equal(generateQuery(p), o.ObservedQuery) {
+		return true
+	}
+	return now.Sub(o.LastRefreshTime.Time) >= p.RefreshInterval.Duration
+}
@@ -0,0 +1,357 @@
+package repositoryrefs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	reposerver "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/repositories"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/repositories"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	testRepo  = "https://github.com/example-org/example-repo.git"
+	testHelm  = "https://charts.example.com"
+	testChart = "example-chart"
+	testPath  = "deploy"
+)
+
+type args struct {
+	client repositories.RepositoryServiceClient
+	cr     *v1alpha1.RepositoryRefs
+}
+
+type mockModifier func(client *mockclient.MockRepositoryServiceClient)
+
+func withMockClient(t *testing.T, mod mockModifier) *mockclient.MockRepositoryServiceClient {
+	ctrl := gomock.NewController(t)
+	mock := mockclient.NewMockRepositoryServiceClient(ctrl)
+	mod(mock)
+	return mock
+}
+
+type repositoryRefsModifier func(*v1alpha1.RepositoryRefs)
+
+func repositoryRefs(m ...repositoryRefsModifier) *v1alpha1.RepositoryRefs {
+	cr := &v1alpha1.RepositoryRefs{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withSpec(p v1alpha1.RepositoryRefsParameters) repositoryRefsModifier {
+	return func(r *v1alpha1.RepositoryRefs) { r.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.RepositoryRefsObservation) repositoryRefsModifier {
+	return func(r *v1alpha1.RepositoryRefs) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) repositoryRefsModifier {
+	return func(r *v1alpha1.RepositoryRefs) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDeletionTimestamp() repositoryRefsModifier {
+	return func(r *v1alpha1.RepositoryRefs) { r.SetDeletionTimestamp(&metav1.Time{Time: time.Now()}) }
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.RepositoryRefs
+		result managed.ExternalObservation
+		err    error
+	}
+
+	recently := metav1.Now()
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"GitRefs": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().ListRefs(
+						context.Background(),
+						&repository.RepoQuery{Repo: testRepo},
+					).Return(&reposerver.Refs{
+						Branches: []string{"main"},
+						Tags:     []string{"v1.0.0"},
+					}, nil)
+				}),
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{Repo: &testRepo}),
+				),
+			},
+			want: want{
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{Repo: &testRepo}),
+					withObservation(v1alpha1.RepositoryRefsObservation{
+						Branches:      []string{"main"},
+						Tags:          []string{"v1.0.0"},
+						ObservedQuery: &v1alpha1.RepositoryRefsQuery{Repo: &testRepo},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"GitRefsWithSourceType": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().ListRefs(
+						context.Background(),
+						&repository.RepoQuery{Repo: testRepo, AppProject: "default"},
+					).Return(&reposerver.Refs{Branches: []string{"main"}}, nil)
+					mcs.EXPECT().GetAppDetails(
+						context.Background(),
+						&repository.RepoAppDetailsQuery{
+							Source: &argocdv1alpha1.ApplicationSource{
+								RepoURL:        testRepo,
+								Path:           testPath,
+								TargetRevision: "main",
+							},
+							AppProject: "default",
+						},
+					).Return(&reposerver.RepoAppDetailsResponse{Type: "Kustomize"}, nil)
+				}),
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{
+						Repo:           &testRepo,
+						Project:        ptr.To("default"),
+						Path:           &testPath,
+						TargetRevision: ptr.To("main"),
+					}),
+				),
+			},
+			want: want{
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{
+						Repo:           &testRepo,
+						Project:        ptr.To("default"),
+						Path:           &testPath,
+						TargetRevision: ptr.To("main"),
+					}),
+					withObservation(v1alpha1.RepositoryRefsObservation{
+						Branches:   []string{"main"},
+						SourceType: ptr.To("Kustomize"),
+						ObservedQuery: &v1alpha1.RepositoryRefsQuery{
+							Repo:           &testRepo,
+							Project:        ptr.To("default"),
+							Path:           &testPath,
+							TargetRevision: ptr.To("main"),
+						},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"HelmCharts": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().GetHelmCharts(
+						context.Background(),
+						&repository.RepoQuery{Repo: testHelm},
+					).Return(&reposerver.HelmChartsResponse{
+						Items: []*reposerver.HelmChart{{Name: testChart, Versions: []string{"1.1.0", "1.0.0"}}},
+					}, nil)
+					mcs.EXPECT().GetAppDetails(
+						context.Background(),
+						&repository.RepoAppDetailsQuery{
+							Source: &argocdv1alpha1.ApplicationSource{
+								RepoURL: testHelm,
+								Chart:   testChart,
+							},
+						},
+					).Return(&reposerver.RepoAppDetailsResponse{Type: "Helm"}, nil)
+				}),
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{
+						Repo: &testHelm,
+						Type: ptr.To("helm"),
+						Path: &testChart,
+					}),
+				),
+			},
+			want: want{
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{
+						Repo: &testHelm,
+						Type: ptr.To("helm"),
+						Path: &testChart,
+					}),
+					withObservation(v1alpha1.RepositoryRefsObservation{
+						HelmCharts: []v1alpha1.HelmChartVersions{{Name: testChart, Versions: []string{"1.1.0", "1.0.0"}}},
+						SourceType: ptr.To("Helm"),
+						ObservedQuery: &v1alpha1.RepositoryRefsQuery{
+							Repo: &testHelm,
+							Type: ptr.To("helm"),
+							Path: &testChart,
+						},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"WithinRefreshInterval": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {}),
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{
+						Repo:            &testRepo,
+						RefreshInterval: &metav1.Duration{Duration: time.Hour},
+					}),
+					withObservation(v1alpha1.RepositoryRefsObservation{
+						Branches:        []string{"main"},
+						LastRefreshTime: &recently,
+						ObservedQuery:   &v1alpha1.RepositoryRefsQuery{Repo: &testRepo},
+					}),
+				),
+			},
+			want: want{
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{
+						Repo:            &testRepo,
+						RefreshInterval: &metav1.Duration{Duration: time.Hour},
+					}),
+					withObservation(v1alpha1.RepositoryRefsObservation{
+						Branches:        []string{"main"},
+						LastRefreshTime: &recently,
+						ObservedQuery:   &v1alpha1.RepositoryRefsQuery{Repo: &testRepo},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ParametersChangedWithinRefreshInterval": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().ListRefs(
+						context.Background(),
+						&repository.RepoQuery{Repo: testRepo, AppProject: "default"},
+					).Return(&reposerver.Refs{Branches: []string{"main", "develop"}}, nil)
+				}),
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{
+						Repo:            &testRepo,
+						Project:         ptr.To("default"),
+						RefreshInterval: &metav1.Duration{Duration: time.Hour},
+					}),
+					withObservation(v1alpha1.RepositoryRefsObservation{
+						Branches:        []string{"main"},
+						LastRefreshTime: &recently,
+						ObservedQuery:   &v1alpha1.RepositoryRefsQuery{Repo: &testRepo},
+					}),
+				),
+			},
+			want: want{
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{
+						Repo:            &testRepo,
+						Project:         ptr.To("default"),
+						RefreshInterval: &metav1.Duration{Duration: time.Hour},
+					}),
+					withObservation(v1alpha1.RepositoryRefsObservation{
+						Branches:      []string{"main", "develop"},
+						ObservedQuery: &v1alpha1.RepositoryRefsQuery{Repo: &testRepo, Project: ptr.To("default")},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {}),
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{Repo: &testRepo}),
+					withDeletionTimestamp(),
+				),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RepoNotResolved": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {}),
+				cr:     repositoryRefs(),
+			},
+			want: want{
+				cr:  repositoryRefs(),
+				err: errors.New(errRepoNotResolved),
+			},
+		},
+		"ListRefsFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().ListRefs(
+						context.Background(),
+						&repository.RepoQuery{Repo: testRepo},
+					).Return(nil, errBoom)
+				}),
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{Repo: &testRepo}),
+				),
+			},
+			want: want{
+				cr: repositoryRefs(
+					withSpec(v1alpha1.RepositoryRefsParameters{Repo: &testRepo}),
+				),
+				err: errors.Wrap(errBoom, errListRefsFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.cr != nil {
+				if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.RepositoryRefsObservation{}, "LastRefreshTime")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
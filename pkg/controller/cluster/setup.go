@@ -20,13 +20,22 @@ import (
 	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/applicationoperations"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/applications"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/applicationsetdiscovery"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/applicationsets"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/cluster"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/config"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/gpgkeys"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/projectroles"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/projects"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/repositories"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/repositorycertificates"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/repositoryrefs"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/syncwindows"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/tokens"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/writerepositories"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/writerepositorycredentials"
 )
 
 // Setup creates all argocd API controllers with the supplied logger and adds
@@ -35,10 +44,21 @@ func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 	for _, setup := range []func(ctrl.Manager, xpcontroller.Options) error{
 		config.Setup,
 		repositories.Setup,
+		writerepositories.Setup,
+		writerepositorycredentials.Setup,
+		gpgkeys.Setup,
+		repositorycertificates.Setup,
+		repositoryrefs.Setup,
 		projects.Setup,
+		projectroles.Setup,
+		syncwindows.Setup,
 		cluster.Setup,
 		applications.Setup,
+		applicationoperations.SetupApplicationSync,
+		applicationoperations.SetupApplicationRollback,
+		applicationoperations.SetupResourceAction,
 		applicationsets.Setup,
+		applicationsetdiscovery.Setup,
 		tokens.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
@@ -0,0 +1,387 @@
+package syncwindows
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/syncwindows/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/projects"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotSyncWindow      = "resource is not an ArgoCD Project Sync Window"
+	errGetProjectFailed   = "failed to get ArgoCD Project, check if project exists and permissions are correct"
+	errInvalidSyncWindow  = "invalid ArgoCD Project Sync Window"
+	errUpdateSyncWindow   = "failed to update ArgoCD Project Sync Window"
+	errDeleteSyncWindow   = "failed to delete ArgoCD Project Sync Window"
+	errProjectNotResolved = "project of ArgoCD Project Sync Window is not set"
+	errListSyncWindows    = "cannot list Sync Windows"
+	errFmtDuplicate       = "an identical sync window of project %s is already managed by SyncWindow %s"
+
+	defaultTimeZone = "UTC"
+)
+
+// Setup adds a controller that reconciles project sync windows.
+func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.SyncWindowKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: projects.NewProjectServiceClient,
+		}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.SyncWindowList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.SyncWindow{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SyncWindowGroupVersionKind),
+			opts...))
+}
+
+type connector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, project.ProjectServiceClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SyncWindow)
+	if !ok {
+		return nil, errors.New(errNotSyncWindow)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &external{kube: c.kube, client: argocdClient, conn: conn}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ProjectServiceClient
+	conn   io.Closer
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SyncWindow)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSyncWindow)
+	}
+	if cr.Spec.ForProvider.Project == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectNotResolved)
+	}
+
+	proj, err := e.client.Get(ctx, &project.ProjectQuery{Name: *cr.Spec.ForProvider.Project})
+	if projects.IsErrorProjectNotFound(err) {
+		return managed.ExternalObservation{}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetProjectFailed)
+	}
+
+	// Sync windows have no identity in ArgoCD, so a window can only be
+	// managed by a single resource. Resources being deleted are not rejected
+	// so that their finalizer can be removed.
+	if !meta.WasDeleted(cr) {
+		dup, err := e.findDuplicate(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if dup != nil && isOlder(dup, cr) {
+			return managed.ExternalObservation{}, errors.Errorf(errFmtDuplicate, *cr.Spec.ForProvider.Project, dup.GetName())
+		}
+	}
+
+	// Sync windows have no identity in ArgoCD. A window matching the spec is
+	// up to date, otherwise the window last observed is looked up so that
+	// changes to the spec update the window in place.
+	i := findSyncWindow(proj.Spec.SyncWindows, generateSyncWindow(&cr.Spec.ForProvider))
+	upToDate := i >= 0
+	if !upToDate {
+		i = findSyncWindow(proj.Spec.SyncWindows, generateObservedSyncWindow(&cr.Status.AtProvider))
+	}
+	if i < 0 {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = generateSyncWindowObservation(proj.Spec.SyncWindows[i], time.Now())
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SyncWindow)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSyncWindow)
+	}
+
+	return managed.ExternalCreation{}, e.upsertSyncWindow(ctx, cr, nil)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SyncWindow)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSyncWindow)
+	}
+
+	return managed.ExternalUpdate{}, e.upsertSyncWindow(ctx, cr, generateObservedSyncWindow(&cr.Status.AtProvider))
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.SyncWindow)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotSyncWindow)
+	}
+	if cr.Spec.ForProvider.Project == nil {
+		return managed.ExternalDelete{}, nil
+	}
+
+	// The window stays in place while another resource still manages an
+	// identical window.
+	dup, err := e.findDuplicate(ctx, cr)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if dup != nil {
+		return managed.ExternalDelete{}, nil
+	}
+
+	desired := generateSyncWindow(&cr.Spec.ForProvider)
+	observed := generateObservedSyncWindow(&cr.Status.AtProvider)
+	err = retry.OnError(retry.DefaultRetry, projects.IsErrorConflict, func() error {
+		proj, err := e.client.Get(ctx, &project.ProjectQuery{Name: *cr.Spec.ForProvider.Project})
+		if err != nil {
+			return err
+		}
+		i := findSyncWindow(proj.Spec.SyncWindows, desired)
+		if i < 0 {
+			i = findSyncWindow(proj.Spec.SyncWindows, observed)
+		}
+		if i < 0 {
+			// window is already gone
+			return nil
+		}
+		proj.Spec.SyncWindows = append(proj.Spec.SyncWindows[:i], proj.Spec.SyncWindows[i+1:]...)
+		_, err = e.client.Update(ctx, &project.ProjectUpdateRequest{Project: proj})
+		return err
+	})
+	if projects.IsErrorProjectNotFound(err) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteSyncWindow)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+// upsertSyncWindow adds the window to the project or replaces the previous
+// window. The project is read and written using its resource version, so
+// concurrent changes by other resources are retried instead of being overwritten.
+func (e *external) upsertSyncWindow(ctx context.Context, cr *v1alpha1.SyncWindow, previous *argocdv1alpha1.SyncWindow) error {
+	if cr.Spec.ForProvider.Project == nil {
+		return errors.New(errProjectNotResolved)
+	}
+
+	desired := generateSyncWindow(&cr.Spec.ForProvider)
+	if err := desired.Validate(); err != nil {
+		return errors.Wrap(err, errInvalidSyncWindow)
+	}
+
+	err := retry.OnError(retry.DefaultRetry, projects.IsErrorConflict, func() error {
+		proj, err := e.client.Get(ctx, &project.ProjectQuery{Name: *cr.Spec.ForProvider.Project})
+		if err != nil {
+			return errors.Wrap(err, errGetProjectFailed)
+		}
+		if findSyncWindow(proj.Spec.SyncWindows, desired) >= 0 {
+			return nil
+		}
+		if i := findSyncWindow(proj.Spec.SyncWindows, previous); i >= 0 {
+			proj.Spec.SyncWindows[i] = desired
+		} else {
+			proj.Spec.SyncWindows = append(proj.Spec.SyncWindows, desired)
+		}
+		_, err = e.client.Update(ctx, &project.ProjectUpdateRequest{Project: proj})
+		return err
+	})
+	return errors.Wrap(err, errUpdateSyncWindow)
+}
+
+// findDuplicate returns another SyncWindow that is not being deleted and
+// manages an identical window of the same project through the same provider
+// config, or nil if there is none.
+func (e *external) findDuplicate(ctx context.Context, cr *v1alpha1.SyncWindow) (*v1alpha1.SyncWindow, error) {
+	l := &v1alpha1.SyncWindowList{}
+	if err := e.kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListSyncWindows)
+	}
+	desired := generateSyncWindow(&cr.Spec.ForProvider)
+	for i := range l.Items {
+		o := &l.Items[i]
+		if o.GetUID() == cr.GetUID() || meta.WasDeleted(o) {
+			continue
+		}
+		if clients.StringValue(o.Spec.ForProvider.Project) != *cr.Spec.ForProvider.Project ||
+			!cmp.Equal(o.GetProviderConfigReference(), cr.GetProviderConfigReference()) {
+			continue
+		}
+		if isEqualSyncWindow(desired, generateSyncWindow(&o.Spec.ForProvider)) {
+			return o, nil
+		}
+	}
+	return nil, nil
+}
+
+// isOlder reports whether a was created before b. Resources created at the
+// same time are ordered by namespace and name.
+func isOlder(a, b *v1alpha1.SyncWindow) bool {
+	at, bt := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !at.Equal(&bt) {
+		return at.Before(&bt)
+	}
+	if a.GetNamespace() != b.GetNamespace() {
+		return a.GetNamespace() < b.GetNamespace()
+	}
+	return a.GetName() < b.GetName()
+}
+
+func generateSyncWindow(p *v1alpha1.SyncWindowParameters) *argocdv1alpha1.SyncWindow {
+	w := &argocdv1alpha1.SyncWindow{
+		Kind:           p.Kind,
+		Schedule:       p.Schedule,
+		Duration:       p.Duration,
+		Applications:   p.Applications,
+		Namespaces:     p.Namespaces,
+		Clusters:       p.Clusters,
+		ManualSync:     clients.BoolValue(p.ManualSync),
+		TimeZone:       clients.StringValue(p.TimeZone),
+		UseAndOperator: clients.BoolValue(p.UseAndOperator),
+	}
+	if w.TimeZone == "" {
+		w.TimeZone = defaultTimeZone
+	}
+	return w
+}
+
+func generateObservedSyncWindow(o *v1alpha1.SyncWindowObservation) *argocdv1alpha1.SyncWindow {
+	if o.Schedule == nil {
+		return nil
+	}
+	return &argocdv1alpha1.SyncWindow{
+		Kind:           clients.StringValue(o.Kind),
+		Schedule:       clients.StringValue(o.Schedule),
+		Duration:       clients.StringValue(o.Duration),
+		Applications:   o.Applications,
+		Namespaces:     o.Namespaces,
+		Clusters:       o.Clusters,
+		ManualSync:     clients.BoolValue(o.ManualSync),
+		TimeZone:       clients.StringValue(o.TimeZone),
+		UseAndOperator: clients.BoolValue(o.UseAndOperator),
+	}
+}
+
+func generateSyncWindowObservation(w *argocdv1alpha1.SyncWindow, now time.Time) v1alpha1.SyncWindowObservation {
+	o := v1alpha1.SyncWindowObservation{
+		Kind:           ptr.To(w.Kind),
+		Schedule:       ptr.To(w.Schedule),
+		Duration:       ptr.To(w.Duration),
+		Applications:   w.Applications,
+		Namespaces:     w.Namespaces,
+		Clusters:       w.Clusters,
+		ManualSync:     ptr.To(w.ManualSync),
+		TimeZone:       ptr.To(w.TimeZone),
+		UseAndOperator: ptr.To(w.UseAndOperator),
+	}
+	if active, err := w.Active(); err == nil {
+		o.Active = ptr.To(active)
+	}
+	if next, err := nextOpen(w, now); err == nil {
+		o.NextOpen = &metav1.Time{Time: next}
+	}
+	return o
+}
+
+// nextOpen returns the next time after now at which the window opens.
+func nextOpen(w *argocdv1alpha1.SyncWindow, now time.Time) (time.Time, error) {
+	schedule, err := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow).Parse(w.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc := time.UTC
+	if w.TimeZone != "" {
+		if loc, err = time.LoadLocation(w.TimeZone); err != nil {
+			return time.Time{}, err
+		}
+	}
+	return schedule.Next(now.In(loc)).UTC(), nil
+}
+
+func findSyncWindow(windows argocdv1alpha1.SyncWindows, w *argocdv1alpha1.SyncWindow) int {
+	if w == nil {
+		return -1
+	}
+	for i, r := range windows {
+		if isEqualSyncWindow(w, r) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isEqualSyncWindow(a, b *argocdv1alpha1.SyncWindow) bool {
+	return cmp.Equal(normalizeSyncWindow(a), normalizeSyncWindow(b), cmpopts.EquateEmpty())
+}
+
+// normalizeSyncWindow applies the time zone default of ArgoCD so that windows
+// written without a time zone match the stored window.
+func normalizeSyncWindow(w *argocdv1alpha1.SyncWindow) argocdv1alpha1.SyncWindow {
+	n := *w
+	if n.TimeZone == "" {
+		n.TimeZone = defaultTimeZone
+	}
+	return n
+}
@@ -0,0 +1,634 @@
+package syncwindows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/syncwindows/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/projects"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/projects"
+)
+
+var (
+	testProjectName     = "test-project"
+	testKind            = "deny"
+	testSchedule        = "* * * * *"
+	testOtherSchedule   = "0 22 * * *"
+	testDuration        = "1h"
+	testNamespace       = "test-namespace"
+	testResourceVersion = "1"
+	testCreated         = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	errBoom             = errors.New("boom")
+	errProjectNotFound  = errors.New("code = NotFound desc = appprojects")
+
+	// NextOpen depends on the current time and is covered by TestNextOpen
+	ignoreNextOpen = cmpopts.IgnoreFields(v1alpha1.SyncWindowObservation{}, "NextOpen")
+)
+
+type args struct {
+	kube   client.Client
+	client projects.ProjectServiceClient
+	cr     *v1alpha1.SyncWindow
+}
+
+// withSyncWindows returns a kube client that lists the supplied resources.
+func withSyncWindows(items ...v1alpha1.SyncWindow) client.Client {
+	return &test.MockClient{
+		MockList: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+			list.(*v1alpha1.SyncWindowList).Items = items
+			return nil
+		},
+	}
+}
+
+type mockModifier func(*mockclient.MockProjectServiceClient)
+
+func withMockClient(t *testing.T, mod mockModifier) *mockclient.MockProjectServiceClient {
+	ctrl := gomock.NewController(t)
+	mock := mockclient.NewMockProjectServiceClient(ctrl)
+	mod(mock)
+	return mock
+}
+
+func SyncWindow(m ...SyncWindowModifier) *v1alpha1.SyncWindow {
+	cr := &v1alpha1.SyncWindow{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+type SyncWindowModifier func(*v1alpha1.SyncWindow)
+
+func withSpec(p v1alpha1.SyncWindowParameters) SyncWindowModifier {
+	return func(r *v1alpha1.SyncWindow) { r.Spec.ForProvider = p }
+}
+
+func withObservation(p v1alpha1.SyncWindowObservation) SyncWindowModifier {
+	return func(r *v1alpha1.SyncWindow) { r.Status.AtProvider = p }
+}
+
+func withMeta(name string, uid types.UID, created time.Time) SyncWindowModifier {
+	return func(r *v1alpha1.SyncWindow) {
+		r.SetName(name)
+		r.SetUID(uid)
+		r.SetCreationTimestamp(metav1.NewTime(created))
+	}
+}
+
+func withConditions(c ...xpv1.Condition) SyncWindowModifier {
+	return func(r *v1alpha1.SyncWindow) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func appProject(windows ...*argocdv1alpha1.SyncWindow) *argocdv1alpha1.AppProject {
+	return &argocdv1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            testProjectName,
+			ResourceVersion: testResourceVersion,
+		},
+		Spec: argocdv1alpha1.AppProjectSpec{
+			SyncWindows: windows,
+		},
+	}
+}
+
+func syncWindow(schedule string) *argocdv1alpha1.SyncWindow {
+	return &argocdv1alpha1.SyncWindow{
+		Kind:       testKind,
+		Schedule:   schedule,
+		Duration:   testDuration,
+		Namespaces: []string{testNamespace},
+		TimeZone:   defaultTimeZone,
+	}
+}
+
+func parameters(schedule string) v1alpha1.SyncWindowParameters {
+	return v1alpha1.SyncWindowParameters{
+		Project:    &testProjectName,
+		Kind:       testKind,
+		Schedule:   schedule,
+		Duration:   testDuration,
+		Namespaces: []string{testNamespace},
+	}
+}
+
+func observation(schedule string) v1alpha1.SyncWindowObservation {
+	return v1alpha1.SyncWindowObservation{
+		Kind:           &testKind,
+		Schedule:       &schedule,
+		Duration:       &testDuration,
+		Namespaces:     []string{testNamespace},
+		ManualSync:     ptr.To(false),
+		TimeZone:       ptr.To(defaultTimeZone),
+		UseAndOperator: ptr.To(false),
+		Active:         ptr.To(true),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SyncWindow
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testOtherSchedule), syncWindow(testSchedule)), nil)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+			want: want{
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+					withConditions(xpv1.Available()),
+					withObservation(observation(testSchedule)),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DuplicateOfOlderResource": {
+			args: args{
+				kube: withSyncWindows(*SyncWindow(
+					withMeta("older", "older-uid", testCreated),
+					withSpec(parameters(testSchedule)),
+				)),
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testSchedule)), nil)
+				}),
+				cr: SyncWindow(
+					withMeta("newer", "newer-uid", testCreated.Add(time.Minute)),
+					withSpec(parameters(testSchedule)),
+				),
+			},
+			want: want{
+				cr: SyncWindow(
+					withMeta("newer", "newer-uid", testCreated.Add(time.Minute)),
+					withSpec(parameters(testSchedule)),
+				),
+				err: errors.Errorf(errFmtDuplicate, testProjectName, "older"),
+			},
+		},
+		"DuplicateOfNewerResource": {
+			args: args{
+				kube: withSyncWindows(*SyncWindow(
+					withMeta("newer", "newer-uid", testCreated.Add(time.Minute)),
+					withSpec(parameters(testSchedule)),
+				)),
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testSchedule)), nil)
+				}),
+				cr: SyncWindow(
+					withMeta("older", "older-uid", testCreated),
+					withSpec(parameters(testSchedule)),
+				),
+			},
+			want: want{
+				cr: SyncWindow(
+					withMeta("older", "older-uid", testCreated),
+					withSpec(parameters(testSchedule)),
+					withConditions(xpv1.Available()),
+					withObservation(observation(testSchedule)),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testSchedule)), nil)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testOtherSchedule)),
+					withObservation(observation(testSchedule)),
+				),
+			},
+			want: want{
+				cr: SyncWindow(
+					withSpec(parameters(testOtherSchedule)),
+					withConditions(xpv1.Available()),
+					withObservation(observation(testSchedule)),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"WindowNotFound": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testOtherSchedule)), nil)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+			want: want{
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"ProjectNotFound": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(nil, errProjectNotFound)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+			want: want{
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"GetProjectFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(nil, errBoom)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+			want: want{
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+				err: errors.Wrap(errBoom, errGetProjectFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := tc.kube
+			if kube == nil {
+				kube = withSyncWindows()
+			}
+			e := &external{kube: kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), ignoreNextOpen); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreate": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testOtherSchedule)), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject(syncWindow(testOtherSchedule), syncWindow(testSchedule)),
+						},
+					).Return(&argocdv1alpha1.AppProject{}, nil)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+		},
+		"AlreadyExists": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testSchedule)), nil)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+		},
+		"InvalidSchedule": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {}),
+				cr: SyncWindow(
+					withSpec(parameters("not a schedule")),
+				),
+			},
+			want: want{
+				err: errors.Wrap(syncWindow("not a schedule").Validate(), errInvalidSyncWindow),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject(syncWindow(testSchedule)),
+						},
+					).Return(nil, errBoom)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateSyncWindow),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplacesObservedWindow": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testSchedule)), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject(syncWindow(testOtherSchedule)),
+						},
+					).Return(&argocdv1alpha1.AppProject{}, nil)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testOtherSchedule)),
+					withObservation(observation(testSchedule)),
+				),
+			},
+		},
+		"GetProjectFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(nil, errBoom)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testOtherSchedule)),
+					withObservation(observation(testSchedule)),
+				),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, errGetProjectFailed), errUpdateSyncWindow),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		res managed.ExternalDelete
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDelete": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testSchedule), syncWindow(testOtherSchedule)), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject(syncWindow(testOtherSchedule)),
+						},
+					).Return(&argocdv1alpha1.AppProject{}, nil)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+		},
+		"WindowManagedByOtherResource": {
+			args: args{
+				kube: withSyncWindows(*SyncWindow(
+					withMeta("other", "other-uid", testCreated),
+					withSpec(parameters(testSchedule)),
+				)),
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {}),
+				cr: SyncWindow(
+					withMeta("test", "test-uid", testCreated),
+					withSpec(parameters(testSchedule)),
+				),
+			},
+		},
+		"WindowAlreadyDeleted": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testOtherSchedule)), nil)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+		},
+		"ProjectNotFound": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(nil, errProjectNotFound)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{Name: testProjectName},
+					).Return(appProject(syncWindow(testSchedule)), nil)
+					mcs.EXPECT().Update(
+						context.Background(),
+						&project.ProjectUpdateRequest{
+							Project: appProject([]*argocdv1alpha1.SyncWindow{}...),
+						},
+					).Return(nil, errBoom)
+				}),
+				cr: SyncWindow(
+					withSpec(parameters(testSchedule)),
+				),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteSyncWindow),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := tc.kube
+			if kube == nil {
+				kube = withSyncWindows()
+			}
+			e := &external{kube: kube, client: tc.client}
+			got, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.res, got, test.EquateErrors()); diff != "" {
+				t.Errorf("res: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNextOpen(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		window *argocdv1alpha1.SyncWindow
+		want   time.Time
+	}{
+		"UTC": {
+			window: &argocdv1alpha1.SyncWindow{Schedule: testOtherSchedule},
+			want:   time.Date(2024, time.March, 1, 22, 0, 0, 0, time.UTC),
+		},
+		"TimeZone": {
+			window: &argocdv1alpha1.SyncWindow{Schedule: testOtherSchedule, TimeZone: "Europe/Berlin"},
+			want:   time.Date(2024, time.March, 1, 21, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := nextOpen(tc.window, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package writerepositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/repositories"
+	repositoriescontroller "github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/repositories"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotWriteRepository = "managed resource is not a Argocd write repository custom resource"
+	errGetWriteFailed     = "cannot get Argocd write repository"
+	errCreateWriteFailed  = "cannot create Argocd write repository"
+	errUpdateWriteFailed  = "cannot update Argocd write repository"
+	errDeleteWriteFailed  = "cannot delete Argocd write repository"
+)
+
+// Setup adds a controller that reconciles write repositories.
+func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.WriteRepositoryKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: repositories.NewRepositoryServiceClient,
+		}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.WriteRepositoryList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.WriteRepository{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WriteRepositoryGroupVersionKind),
+			opts...))
+}
+
+type connector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, repository.RepositoryServiceClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepository)
+	if !ok {
+		return nil, errors.New(errNotWriteRepository)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &external{kube: c.kube, client: argocdClient, conn: conn}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client repositories.RepositoryServiceClient
+	conn   io.Closer
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepository)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWriteRepository)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	repoQuery := repository.RepoQuery{
+		Repo:       meta.GetExternalName(cr),
+		AppProject: clients.StringValue(cr.Spec.ForProvider.Project),
+	}
+
+	observedRepository, err := e.client.GetWrite(ctx, &repoQuery)
+	if repositories.IsErrorPermissionDenied(err) || repositories.IsErrorWriteRepositoryNotFound(err) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetWriteFailed)
+	}
+
+	resourceVersions, err := repositoriescontroller.GetSecretResourceVersions(ctx, e.kube, repositoriescontroller.RepositorySecretRefs(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	repositoriescontroller.LateInitializeRepository(&cr.Spec.ForProvider, observedRepository)
+
+	currentStatusAtProvider := cr.Status.AtProvider.DeepCopy()
+	cr.Status.AtProvider = repositoriescontroller.GenerateRepositoryObservation(observedRepository, resourceVersions)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        repositoriescontroller.IsRepositoryUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider, currentStatusAtProvider, observedRepository),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepository)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWriteRepository)
+	}
+
+	repoCreateRequest := repositoriescontroller.GenerateCreateRepositoryOptions(&cr.Spec.ForProvider)
+
+	payloads, err := repositoriescontroller.GetSecretPayloads(ctx, e.kube, repositoriescontroller.RepositorySecretRefs(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	repositoriescontroller.SetRepositorySecrets(repoCreateRequest.Repo, payloads)
+
+	if _, err := e.client.CreateWriteRepository(ctx, repoCreateRequest); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateWriteFailed)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Repo)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepository)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWriteRepository)
+	}
+
+	repoUpdateRequest := repositoriescontroller.GenerateUpdateRepositoryOptions(&cr.Spec.ForProvider)
+
+	payloads, err := repositoriescontroller.GetSecretPayloads(ctx, e.kube, repositoriescontroller.RepositorySecretRefs(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	repositoriescontroller.SetRepositorySecrets(repoUpdateRequest.Repo, payloads)
+
+	_, err = e.client.UpdateWriteRepository(ctx, repoUpdateRequest)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateWriteFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepository)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotWriteRepository)
+	}
+
+	_, err := e.client.DeleteWriteRepository(ctx, &repository.RepoQuery{
+		Repo:       meta.GetExternalName(cr),
+		AppProject: clients.StringValue(cr.Spec.ForProvider.Project),
+	})
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteWriteFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
@@ -0,0 +1,346 @@
+package writerepositories
+
+import (
+	"context"
+	"testing"
+
+	argocdRepository "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/repositories"
+	repositoriescontroller "github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/repositories"
+)
+
+var (
+	errBoom              = errors.New("boom")
+	errWriteRepoNotFound = errors.New("code = NotFound desc = write repo 'testRepo' not found")
+)
+
+var (
+	testRepo                     = "https://gitlab.com/example-group/example-project.git"
+	testUsername                 = "testUser"
+	testInsecure                 = false
+	testEnableLFS                = false
+	testInheritedCreds           = false
+	testEnableOCI                = false
+	testForceHTTPBasicAuth       = false
+	testUseAzureWorkloadIdentity = false
+)
+
+type mockModifier func(client *mockclient.MockRepositoryServiceClient)
+
+func withMockClient(t *testing.T, mod mockModifier) *mockclient.MockRepositoryServiceClient {
+	ctrl := gomock.NewController(t)
+	mock := mockclient.NewMockRepositoryServiceClient(ctrl)
+	mod(mock)
+	return mock
+}
+
+type writeRepositoryModifier func(*v1alpha1.WriteRepository)
+
+func writeRepository(m ...writeRepositoryModifier) *v1alpha1.WriteRepository {
+	cr := &v1alpha1.WriteRepository{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withWriteExternalName(v string) writeRepositoryModifier {
+	return func(r *v1alpha1.WriteRepository) { meta.SetExternalName(r, v) }
+}
+
+func withWriteSpec(p v1alpha1.RepositoryParameters) writeRepositoryModifier {
+	return func(r *v1alpha1.WriteRepository) { r.Spec.ForProvider = p }
+}
+
+func withWriteObservation(o v1alpha1.RepositoryObservation) writeRepositoryModifier {
+	return func(r *v1alpha1.WriteRepository) { r.Status.AtProvider = o }
+}
+
+func withWriteConditions(c ...xpv1.Condition) writeRepositoryModifier {
+	return func(r *v1alpha1.WriteRepository) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		client *mockclient.MockRepositoryServiceClient
+		cr     *v1alpha1.WriteRepository
+	}
+	type want struct {
+		cr     *v1alpha1.WriteRepository
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {}),
+				cr: writeRepository(
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+			},
+			want: want{
+				cr: writeRepository(
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().GetWrite(
+						context.Background(),
+						&argocdRepository.RepoQuery{Repo: testRepo},
+					).Return(nil, errWriteRepoNotFound)
+				}),
+				cr: writeRepository(
+					withWriteExternalName(testRepo),
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+			},
+			want: want{
+				cr: writeRepository(
+					withWriteExternalName(testRepo),
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().GetWrite(
+						context.Background(),
+						&argocdRepository.RepoQuery{Repo: testRepo},
+					).Return(&argocdv1alpha1.Repository{
+						Repo:     testRepo,
+						Username: testUsername,
+					}, nil)
+				}),
+				cr: writeRepository(
+					withWriteExternalName(testRepo),
+					withWriteSpec(v1alpha1.RepositoryParameters{
+						Repo:                     testRepo,
+						Username:                 &testUsername,
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
+					}),
+				),
+			},
+			want: want{
+				cr: writeRepository(
+					withWriteExternalName(testRepo),
+					withWriteSpec(v1alpha1.RepositoryParameters{
+						Repo:                     testRepo,
+						Username:                 &testUsername,
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
+					}),
+					withWriteObservation(repositoriescontroller.GenerateRepositoryObservation(&argocdv1alpha1.Repository{
+						Repo:     testRepo,
+						Username: testUsername,
+					}, repositoriescontroller.SecretValues{})),
+					withWriteConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().GetWrite(
+						context.Background(),
+						&argocdRepository.RepoQuery{Repo: testRepo},
+					).Return(nil, errBoom)
+				}),
+				cr: writeRepository(
+					withWriteExternalName(testRepo),
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+			},
+			want: want{
+				cr: writeRepository(
+					withWriteExternalName(testRepo),
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+				err: errors.Wrap(errBoom, errGetWriteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		client *mockclient.MockRepositoryServiceClient
+		cr     *v1alpha1.WriteRepository
+	}
+	type want struct {
+		cr  *v1alpha1.WriteRepository
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().CreateWriteRepository(
+						context.Background(),
+						&argocdRepository.RepoCreateRequest{
+							Repo: &argocdv1alpha1.Repository{Repo: testRepo},
+						},
+					).Return(&argocdv1alpha1.Repository{Repo: testRepo}, nil)
+				}),
+				cr: writeRepository(
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+			},
+			want: want{
+				cr: writeRepository(
+					withWriteExternalName(testRepo),
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().CreateWriteRepository(
+						context.Background(),
+						&argocdRepository.RepoCreateRequest{
+							Repo: &argocdv1alpha1.Repository{Repo: testRepo},
+						},
+					).Return(nil, errBoom)
+				}),
+				cr: writeRepository(
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+			},
+			want: want{
+				cr: writeRepository(
+					withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+				),
+				err: errors.Wrap(errBoom, errCreateWriteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	client := withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+		mcs.EXPECT().UpdateWriteRepository(
+			context.Background(),
+			&argocdRepository.RepoUpdateRequest{
+				Repo: &argocdv1alpha1.Repository{Repo: testRepo, Username: testUsername},
+			},
+		).Return(&argocdv1alpha1.Repository{Repo: testRepo, Username: testUsername}, nil)
+	})
+	cr := writeRepository(
+		withWriteExternalName(testRepo),
+		withWriteSpec(v1alpha1.RepositoryParameters{
+			Repo:           testRepo,
+			Username:       &testUsername,
+			Insecure:       &testInsecure,
+			EnableLFS:      &testEnableLFS,
+			EnableOCI:      &testEnableOCI,
+			InheritedCreds: &testInheritedCreds,
+		}),
+	)
+
+	e := &external{client: client}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful": {},
+		"DeleteFailed": {
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errDeleteWriteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+				mcs.EXPECT().DeleteWriteRepository(
+					context.Background(),
+					&argocdRepository.RepoQuery{Repo: testRepo},
+				).Return(&argocdRepository.RepoResponse{}, tc.err)
+			})
+			cr := writeRepository(
+				withWriteExternalName(testRepo),
+				withWriteSpec(v1alpha1.RepositoryParameters{Repo: testRepo}),
+			)
+
+			e := &external{client: client}
+			_, err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
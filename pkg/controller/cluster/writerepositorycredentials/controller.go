@@ -0,0 +1,274 @@
+package writerepositorycredentials
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repocreds"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/cluster"
+	repocredsclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/repocreds"
+	repositoriescontroller "github.com/crossplane-contrib/provider-argocd/pkg/controller/cluster/repositories"
+	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+)
+
+const (
+	errNotWriteRepositoryCredentials = "managed resource is not a Argocd write repository credentials custom resource"
+	errListCredentialsFailed         = "cannot list Argocd repository credentials"
+	errCreateWriteCredentialsFailed  = "cannot create Argocd write repository credentials"
+	errUpdateWriteCredentialsFailed  = "cannot update Argocd write repository credentials"
+	errDeleteWriteCredentialsFailed  = "cannot delete Argocd write repository credentials"
+)
+
+// Setup adds a controller that reconciles write repository credentials.
+func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.WriteRepositoryCredentialsKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newArgocdClientFn: repocredsclient.NewRepoCredsServiceClient,
+		}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(5 * time.Minute),
+		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
+	}
+
+	opts = append(opts, (features.Opts(o))...)
+
+	if err := features.AddMRMetrics(mgr, o, &v1alpha1.WriteRepositoryCredentialsList{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.WriteRepositoryCredentials{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WriteRepositoryCredentialsGroupVersionKind),
+			opts...))
+}
+
+type connector struct {
+	kube              client.Client
+	newArgocdClientFn func(clientOpts *apiclient.ClientOptions) (io.Closer, repocreds.RepoCredsServiceClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepositoryCredentials)
+	if !ok {
+		return nil, errors.New(errNotWriteRepositoryCredentials)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	conn, argocdClient, err := c.newArgocdClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create argocd client")
+	}
+	return &external{kube: c.kube, client: argocdClient, conn: conn}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client repocredsclient.RepoCredsServiceClient
+	conn   io.Closer
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepositoryCredentials)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWriteRepositoryCredentials)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	// ArgoCD has no endpoint to get a single credential set, so the list is
+	// searched for the URL. ListWriteRepositoryCredentials only reports
+	// credential sets with a password, but ArgoCD stores write and read
+	// credential sets in the same secret, so the read listing is used to
+	// observe every type of credentials.
+	list, err := e.client.ListRepositoryCredentials(ctx, &repocreds.RepoCredsQuery{Url: meta.GetExternalName(cr)})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListCredentialsFailed)
+	}
+	var observed *argocdv1alpha1.RepoCreds
+	for i := range list.Items {
+		if list.Items[i].URL == meta.GetExternalName(cr) {
+			observed = &list.Items[i]
+			break
+		}
+	}
+	if observed == nil {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	resourceVersions, err := repositoriescontroller.GetSecretResourceVersions(ctx, e.kube, credentialsSecretRefs(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	cr.Spec.ForProvider.Username = clients.LateInitializeStringPtr(cr.Spec.ForProvider.Username, observed.Username)
+
+	currentStatusAtProvider := cr.Status.AtProvider.DeepCopy()
+	cr.Status.AtProvider = generateRepositoryCredentialsObservation(observed, resourceVersions)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isRepositoryCredentialsUpToDate(&cr.Spec.ForProvider, &cr.Status.AtProvider, currentStatusAtProvider),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepositoryCredentials)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWriteRepositoryCredentials)
+	}
+
+	creds, err := e.generateRepoCreds(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// Upsert makes creating credentials idempotent in case they were
+	// created, but not observed before.
+	_, err = e.client.CreateWriteRepositoryCredentials(ctx, &repocreds.RepoCredsCreateRequest{Creds: creds, Upsert: true})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateWriteCredentialsFailed)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.URL)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepositoryCredentials)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWriteRepositoryCredentials)
+	}
+
+	creds, err := e.generateRepoCreds(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, err = e.client.UpdateWriteRepositoryCredentials(ctx, &repocreds.RepoCredsUpdateRequest{Creds: creds})
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateWriteCredentialsFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.WriteRepositoryCredentials)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotWriteRepositoryCredentials)
+	}
+
+	_, err := e.client.DeleteWriteRepositoryCredentials(ctx, &repocreds.RepoCredsDeleteRequest{Url: meta.GetExternalName(cr)})
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteWriteCredentialsFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.conn.Close()
+}
+
+func (e *external) generateRepoCreds(ctx context.Context, p *v1alpha1.RepositoryCredentialsParameters) (*argocdv1alpha1.RepoCreds, error) {
+	creds := &argocdv1alpha1.RepoCreds{
+		URL:                        p.URL,
+		Username:                   clients.StringValue(p.Username),
+		GithubAppId:                clients.Int64Value(p.GithubAppID),
+		GithubAppInstallationId:    clients.Int64Value(p.GithubAppInstallationID),
+		GitHubAppEnterpriseBaseURL: clients.StringValue(p.GitHubAppEnterpriseBaseURL),
+		EnableOCI:                  clients.BoolValue(p.EnableOCI),
+		Type:                       clients.StringValue(p.Type),
+		Proxy:                      clients.StringValue(p.Proxy),
+		NoProxy:                    clients.StringValue(p.NoProxy),
+		ForceHttpBasicAuth:         clients.BoolValue(p.ForceHTTPBasicAuth),
+		UseAzureWorkloadIdentity:   clients.BoolValue(p.UseAzureWorkloadIdentity),
+	}
+	payloads, err := repositoriescontroller.GetSecretPayloads(ctx, e.kube, credentialsSecretRefs(p))
+	if err != nil {
+		return nil, err
+	}
+	setRepoCredsSecrets(creds, payloads)
+	return creds, nil
+}
+
+func credentialsSecretRefs(p *v1alpha1.RepositoryCredentialsParameters) repositoriescontroller.SecretRefs {
+	return repositoriescontroller.SecretRefs{
+		Password:             p.PasswordRef,
+		SSHPrivateKey:        p.SSHPrivateKeyRef,
+		TLSClientCertData:    p.TLSClientCertDataRef,
+		TLSClientCertKey:     p.TLSClientCertKeyRef,
+		GithubAppPrivateKey:  p.GithubAppPrivateKeyRef,
+		GCPServiceAccountKey: p.GCPServiceAccountKeyRef,
+		BearerToken:          p.BearerTokenRef,
+	}
+}
+
+// setRepoCredsSecrets copies the secret payloads into the credential set
+func setRepoCredsSecrets(r *argocdv1alpha1.RepoCreds, v repositoriescontroller.SecretValues) {
+	r.Password = v.Password
+	r.SSHPrivateKey = v.SSHPrivateKey
+	r.TLSClientCertData = v.TLSClientCertData
+	r.TLSClientCertKey = v.TLSClientCertKey
+	r.GithubAppPrivateKey = v.GithubAppPrivateKey
+	r.GCPServiceAccountKey = v.GCPServiceAccountKey
+	r.BearerToken = v.BearerToken
+}
+
+func generateRepositoryCredentialsObservation(r *argocdv1alpha1.RepoCreds, secretResourceVersion repositoriescontroller.SecretValues) v1alpha1.RepositoryCredentialsObservation {
+	return v1alpha1.RepositoryCredentialsObservation{
+		Username:             clients.StringToPtr(r.Username),
+		Password:             repositoriescontroller.PasswordObservation(secretResourceVersion.Password),
+		SSHPrivateKey:        repositoriescontroller.PasswordObservation(secretResourceVersion.SSHPrivateKey),
+		TLSClientCertData:    repositoriescontroller.PasswordObservation(secretResourceVersion.TLSClientCertData),
+		TLSClientCertKey:     repositoriescontroller.PasswordObservation(secretResourceVersion.TLSClientCertKey),
+		GithubAppPrivateKey:  repositoriescontroller.PasswordObservation(secretResourceVersion.GithubAppPrivateKey),
+		GCPServiceAccountKey: repositoriescontroller.PasswordObservation(secretResourceVersion.GCPServiceAccountKey),
+		BearerToken:          repositoriescontroller.PasswordObservation(secretResourceVersion.BearerToken),
+	}
+}
+
+// isRepositoryCredentialsUpToDate compares the parameters with the observed
+// credential set. ArgoCD only reports the username of a credential set, all
+// other changes are detected through the referenced secrets.
+func isRepositoryCredentialsUpToDate(p *v1alpha1.RepositoryCredentialsParameters, ao, o *v1alpha1.RepositoryCredentialsObservation) bool {
+	switch {
+	case !cmp.Equal(p.Username, ao.Username),
+		!cmp.Equal(ao.Password, o.Password),
+		!cmp.Equal(ao.SSHPrivateKey, o.SSHPrivateKey),
+		!cmp.Equal(ao.TLSClientCertData, o.TLSClientCertData),
+		!cmp.Equal(ao.TLSClientCertKey, o.TLSClientCertKey),
+		!cmp.Equal(ao.GithubAppPrivateKey, o.GithubAppPrivateKey),
+		!cmp.Equal(ao.GCPServiceAccountKey, o.GCPServiceAccountKey),
+		!cmp.Equal(ao.BearerToken, o.BearerToken):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,268 @@
+package writerepositorycredentials
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repocreds"
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-argocd/apis/cluster/repositories/v1alpha1"
+	mockrepocreds "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/repocreds"
+)
+
+var errBoom = errors.New("boom")
+
+var (
+	testCredentialsURL   = "https://github.com/example-org"
+	testOtherCredentials = "https://github.com/other-org"
+	testUsername         = "testUser"
+)
+
+type writeRepositoryCredentialsModifier func(*v1alpha1.WriteRepositoryCredentials)
+
+func writeRepositoryCredentials(m ...writeRepositoryCredentialsModifier) *v1alpha1.WriteRepositoryCredentials {
+	cr := &v1alpha1.WriteRepositoryCredentials{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withCredentialsExternalName(v string) writeRepositoryCredentialsModifier {
+	return func(r *v1alpha1.WriteRepositoryCredentials) { meta.SetExternalName(r, v) }
+}
+
+func withCredentialsSpec(p v1alpha1.RepositoryCredentialsParameters) writeRepositoryCredentialsModifier {
+	return func(r *v1alpha1.WriteRepositoryCredentials) { r.Spec.ForProvider = p }
+}
+
+func withCredentialsObservation(o v1alpha1.RepositoryCredentialsObservation) writeRepositoryCredentialsModifier {
+	return func(r *v1alpha1.WriteRepositoryCredentials) { r.Status.AtProvider = o }
+}
+
+func withCredentialsConditions(c ...xpv1.Condition) writeRepositoryCredentialsModifier {
+	return func(r *v1alpha1.WriteRepositoryCredentials) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRepoCredsClient(t *testing.T, mod func(*mockrepocreds.MockRepoCredsServiceClient)) *mockrepocreds.MockRepoCredsServiceClient {
+	ctrl := gomock.NewController(t)
+	mock := mockrepocreds.NewMockRepoCredsServiceClient(ctrl)
+	mod(mock)
+	return mock
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		client *mockrepocreds.MockRepoCredsServiceClient
+		cr     *v1alpha1.WriteRepositoryCredentials
+	}
+	type want struct {
+		cr     *v1alpha1.WriteRepositoryCredentials
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: withRepoCredsClient(t, func(mcs *mockrepocreds.MockRepoCredsServiceClient) {
+					mcs.EXPECT().ListRepositoryCredentials(
+						context.Background(),
+						&repocreds.RepoCredsQuery{Url: testCredentialsURL},
+					).Return(&argocdv1alpha1.RepoCredsList{
+						Items: []argocdv1alpha1.RepoCreds{{URL: testOtherCredentials}},
+					}, nil)
+				}),
+				cr: writeRepositoryCredentials(
+					withCredentialsExternalName(testCredentialsURL),
+					withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{URL: testCredentialsURL}),
+				),
+			},
+			want: want{
+				cr: writeRepositoryCredentials(
+					withCredentialsExternalName(testCredentialsURL),
+					withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{URL: testCredentialsURL}),
+				),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: withRepoCredsClient(t, func(mcs *mockrepocreds.MockRepoCredsServiceClient) {
+					mcs.EXPECT().ListRepositoryCredentials(
+						context.Background(),
+						&repocreds.RepoCredsQuery{Url: testCredentialsURL},
+					).Return(&argocdv1alpha1.RepoCredsList{
+						Items: []argocdv1alpha1.RepoCreds{{URL: testCredentialsURL, Username: testUsername}},
+					}, nil)
+				}),
+				cr: writeRepositoryCredentials(
+					withCredentialsExternalName(testCredentialsURL),
+					withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{URL: testCredentialsURL}),
+				),
+			},
+			want: want{
+				cr: writeRepositoryCredentials(
+					withCredentialsExternalName(testCredentialsURL),
+					withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{
+						URL:      testCredentialsURL,
+						Username: &testUsername,
+					}),
+					withCredentialsObservation(v1alpha1.RepositoryCredentialsObservation{Username: &testUsername}),
+					withCredentialsConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				client: withRepoCredsClient(t, func(mcs *mockrepocreds.MockRepoCredsServiceClient) {
+					mcs.EXPECT().ListRepositoryCredentials(
+						context.Background(),
+						&repocreds.RepoCredsQuery{Url: testCredentialsURL},
+					).Return(&argocdv1alpha1.RepoCredsList{
+						Items: []argocdv1alpha1.RepoCreds{{URL: testCredentialsURL, Username: "other"}},
+					}, nil)
+				}),
+				cr: writeRepositoryCredentials(
+					withCredentialsExternalName(testCredentialsURL),
+					withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{
+						URL:      testCredentialsURL,
+						Username: &testUsername,
+					}),
+				),
+			},
+			want: want{
+				cr: writeRepositoryCredentials(
+					withCredentialsExternalName(testCredentialsURL),
+					withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{
+						URL:      testCredentialsURL,
+						Username: &testUsername,
+					}),
+					withCredentialsObservation(v1alpha1.RepositoryCredentialsObservation{Username: ptr.To("other")}),
+					withCredentialsConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ListFailed": {
+			args: args{
+				client: withRepoCredsClient(t, func(mcs *mockrepocreds.MockRepoCredsServiceClient) {
+					mcs.EXPECT().ListRepositoryCredentials(
+						context.Background(),
+						&repocreds.RepoCredsQuery{Url: testCredentialsURL},
+					).Return(nil, errBoom)
+				}),
+				cr: writeRepositoryCredentials(
+					withCredentialsExternalName(testCredentialsURL),
+					withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{URL: testCredentialsURL}),
+				),
+			},
+			want: want{
+				cr: writeRepositoryCredentials(
+					withCredentialsExternalName(testCredentialsURL),
+					withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{URL: testCredentialsURL}),
+				),
+				err: errors.Wrap(errBoom, errListCredentialsFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	client := withRepoCredsClient(t, func(mcs *mockrepocreds.MockRepoCredsServiceClient) {
+		mcs.EXPECT().CreateWriteRepositoryCredentials(
+			context.Background(),
+			&repocreds.RepoCredsCreateRequest{
+				Creds:  &argocdv1alpha1.RepoCreds{URL: testCredentialsURL, Username: testUsername},
+				Upsert: true,
+			},
+		).Return(&argocdv1alpha1.RepoCreds{URL: testCredentialsURL}, nil)
+	})
+	cr := writeRepositoryCredentials(
+		withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{URL: testCredentialsURL, Username: &testUsername}),
+	)
+
+	e := &external{client: client}
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(testCredentialsURL, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	client := withRepoCredsClient(t, func(mcs *mockrepocreds.MockRepoCredsServiceClient) {
+		mcs.EXPECT().UpdateWriteRepositoryCredentials(
+			context.Background(),
+			&repocreds.RepoCredsUpdateRequest{
+				Creds: &argocdv1alpha1.RepoCreds{URL: testCredentialsURL, Username: testUsername},
+			},
+		).Return(nil, errBoom)
+	})
+	cr := writeRepositoryCredentials(
+		withCredentialsExternalName(testCredentialsURL),
+		withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{URL: testCredentialsURL, Username: &testUsername}),
+	)
+
+	e := &external{client: client}
+	_, err := e.Update(context.Background(), cr)
+	if diff := cmp.Diff(errors.Wrap(errBoom, errUpdateWriteCredentialsFailed), err, test.EquateErrors()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	client := withRepoCredsClient(t, func(mcs *mockrepocreds.MockRepoCredsServiceClient) {
+		mcs.EXPECT().DeleteWriteRepositoryCredentials(
+			context.Background(),
+			&repocreds.RepoCredsDeleteRequest{Url: testCredentialsURL},
+		).Return(&repocreds.RepoCredsResponse{}, nil)
+	})
+	cr := writeRepositoryCredentials(
+		withCredentialsExternalName(testCredentialsURL),
+		withCredentialsSpec(v1alpha1.RepositoryCredentialsParameters{URL: testCredentialsURL}),
+	)
+
+	e := &external{client: client}
+	if _, err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/crossplane-contrib/provider-argocd/apis/namespace/applicationsets/v1alpha1"
 	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/namespace"
 	appsetsconverter "github.com/crossplane-contrib/provider-argocd/pkg/clients/namespace/converter/applicationsets"
+	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applications"
 	appsets "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/applicationsets"
 	"github.com/crossplane-contrib/provider-argocd/pkg/features"
 )
@@ -106,22 +107,39 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	return NewExternal(func() (io.Closer, appsets.ServiceClient, error) {
+	return NewExternal(c.kube, func() (io.Closer, appsets.ServiceClient, error) {
 		return appsets.NewApplicationSetServiceClient(cfg)
+	}, func() (io.Closer, applications.ServiceClient, error) {
+		return applications.NewApplicationServiceClient(cfg)
+	}, func(ctx context.Context) (client.Client, string, error) {
+		return clients.GetArgoCDKubeClient(ctx, c.kube, cr)
 	})
 }
 
-func NewExternal(newArgocdClientFn func() (io.Closer, appsets.ServiceClient, error)) (managed.ExternalClient, error) {
+// NewExternal creates the external client of an ApplicationSet. The
+// applications client is only created when the generated applications of an
+// application set have to be listed, the client of the cluster ArgoCD is
+// running in only when generator secrets are mirrored.
+func NewExternal(kube client.Client, newArgocdClientFn func() (io.Closer, appsets.ServiceClient, error), newApplicationsClientFn func() (io.Closer, applications.ServiceClient, error), newArgoCDKubeFn func(ctx context.Context) (client.Client, string, error)) (managed.ExternalClient, error) {
 	conn, argocdClient, err := newArgocdClientFn()
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create argocd client")
 	}
-	return &external{client: argocdClient, conn: conn}, nil
+	return &external{
+		kube:                    kube,
+		client:                  argocdClient,
+		conn:                    conn,
+		newApplicationsClientFn: newApplicationsClientFn,
+		newArgoCDKubeFn:         newArgoCDKubeFn,
+	}, nil
 }
 
 type external struct {
-	client appsets.ServiceClient
-	conn   io.Closer
+	kube                    client.Client
+	client                  appsets.ServiceClient
+	conn                    io.Closer
+	newApplicationsClientFn func() (io.Closer, applications.ServiceClient, error)
+	newArgoCDKubeFn         func(ctx context.Context) (client.Client, string, error)
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -130,6 +148,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotApplicationSet)
 	}
 
+	if ptr.Deref(cr.Spec.ForProvider.Preview, false) {
+		return e.preview(ctx, cr)
+	}
+
 	var name = meta.GetExternalName(cr)
 
 	if name == "" {
@@ -153,12 +175,24 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	current := cr.Spec.ForProvider.DeepCopy()
 
+	// the mirrored generator secrets are not part of the ArgoCD status, but
+	// are needed to clean them up once they are no longer referenced
+	generatorSecrets := cr.Status.AtProvider.GeneratorSecrets
 	cr.Status.AtProvider = generateApplicationObservation(appset)
+	cr.Status.AtProvider.GeneratorSecrets = generatorSecrets
 	cr.Status.SetConditions(xpv1.Available())
 
+	if err := e.observeApplications(ctx, cr, appset); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	secretsUpToDate, err := e.observeGeneratorSecrets(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        IsApplicationSetUpToDate(&cr.Spec.ForProvider, appset),
+		ResourceUpToDate:        secretsUpToDate && IsApplicationSetUpToDate(&cr.Spec.ForProvider, appset),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -174,6 +208,10 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotApplicationSet)
 	}
 
+	if err := e.syncGeneratorSecrets(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	req := e.generateCreateApplicationSetRequest(cr)
 
 	_, err := e.client.Create(ctx, req)
@@ -204,6 +242,10 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotApplicationSet)
 	}
 
+	if err := e.syncGeneratorSecrets(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	req := e.generateCreateApplicationSetRequest(cr)
 	req.Upsert = true
 
@@ -218,6 +260,12 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNotApplicationSet)
 	}
 
+	// delete the mirrored generator secrets first, they would leak if the
+	// application set was gone before they are deleted
+	if err := e.deleteGeneratorSecrets(ctx, cr); err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
 	query := &applicationset.ApplicationSetDeleteRequest{
 		Name:            meta.GetExternalName(cr),
 		AppsetNamespace: ptr.Deref(cr.Spec.ForProvider.AppsetNamespace, ""),
@@ -35,6 +35,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -46,6 +47,7 @@ import (
 	clients "github.com/crossplane-contrib/provider-argocd/pkg/clients/namespace"
 	"github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/cluster"
 	"github.com/crossplane-contrib/provider-argocd/pkg/features"
+	"github.com/crossplane-contrib/provider-argocd/pkg/metrics"
 )
 
 const (
@@ -54,6 +56,7 @@ const (
 	errCreateFailed    = "cannot create Argocd Cluster"
 	errUpdateFailed    = "cannot update Argocd Cluster"
 	errDeleteFailed    = "cannot delete Argocd Cluster"
+	errInvalidateCache = "cannot invalidate cache of Argocd Cluster"
 	errGetSecretFailed = "cannot get Kubernetes secret"
 	errFmtKeyNotFound  = "key %s is not found in referenced Kubernetes secret"
 	errParseKubeconfig = "unable to parse kubeconfig"
@@ -69,8 +72,18 @@ func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 func SetupWithExternalConnector(mgr ctrl.Manager, o xpcontroller.Options, ec managed.ExternalConnecter) error {
 	name := managed.ControllerName(v1alpha1.ClusterKind)
 
+	// deleted managed resources that are orphaned or only observed are not
+	// observed again, so their metrics are removed with the finalizer
+	finalizer := resource.NewAPIFinalizer(mgr.GetClient(), managed.FinalizerName)
 	opts := []managed.ReconcilerOption{
 		managed.WithExternalConnecter(ec),
+		managed.WithFinalizer(resource.FinalizerFns{
+			AddFinalizerFn: finalizer.AddFinalizer,
+			RemoveFinalizerFn: func(ctx context.Context, obj resource.Object) error {
+				metrics.DeleteCluster(obj)
+				return finalizer.RemoveFinalizer(ctx, obj)
+			},
+		}),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
@@ -118,13 +131,14 @@ func NewExternal(kube client.Client, newArgocdClientFn func() (io.Closer, cluste
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create argocd client")
 	}
-	return &external{kube: kube, client: argocdClient, conn: conn}, nil
+	return &external{kube: kube, client: argocdClient, conn: conn, newKubeClientFn: newKubeClient}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client cluster.ServiceClient
-	conn   io.Closer
+	kube            client.Client
+	client          cluster.ServiceClient
+	conn            io.Closer
+	newKubeClientFn func(cfg *rest.Config) (client.Client, error)
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -134,6 +148,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	if meta.GetExternalName(cr) == "" {
+		metrics.DeleteCluster(cr)
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
@@ -146,6 +161,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	observedCluster, err := e.client.Get(ctx, &clusterQuery)
 	if err != nil {
+		if cluster.IsErrorClusterNotFound(err) || cluster.IsErrorPermissionDenied(err) {
+			metrics.DeleteCluster(cr)
+		}
 		switch {
 		case cluster.IsErrorClusterNotFound(err):
 			// Case: Cluster not found
@@ -170,23 +188,34 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	if meta.WasDeleted(cr) && meta.GetExternalName(cr) != observedCluster.Name {
 		// ArgoCD Cluster resource ignores the name field. This detects the deletion of the default cluster resource.
+		metrics.DeleteCluster(cr)
 		return managed.ExternalObservation{}, nil
 	}
 
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	lateInitializeCluster(&cr.Spec.ForProvider, observedCluster)
 
-	kubeconfigSecretResourceVersion, err := e.getSecretResourceVersion(ctx, cr.Spec.ForProvider.Config.KubeconfigSecretRef)
+	kubeconfigSecretResourceVersion, err := e.kubeconfigResourceVersion(ctx, cr)
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 	currentStatusAtProvider := cr.Status.AtProvider.DeepCopy()
 	cr.Status.AtProvider = generateClusterObservation(observedCluster, kubeconfigSecretResourceVersion)
+	if meta.WasDeleted(cr) {
+		metrics.DeleteCluster(cr)
+	} else {
+		metrics.RecordCluster(cr, &observedCluster.Info)
+	}
 	cr.Status.SetConditions(xpv1.Available())
 
+	managerUpToDate, err := e.observeManagerServiceAccount(ctx, cr, currentStatusAtProvider.ManagerServiceAccount)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isClusterUpToDate(cr, currentStatusAtProvider, observedCluster),
+		ResourceUpToDate:        managerUpToDate && isClusterUpToDate(cr, currentStatusAtProvider, observedCluster),
 		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -218,14 +247,32 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotCluster)
 	}
 
+	if err := e.rotateManagerToken(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	clusterUpdateRequest, err := e.generateUpdateClusterOptions(ctx, cr)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
 
-	_, err = e.client.Update(ctx, clusterUpdateRequest)
+	if _, err := e.client.Update(ctx, clusterUpdateRequest); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	if err := e.pruneManagerTokens(ctx, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if isRefreshRequested(&cr.Spec.ForProvider, cr.Status.AtProvider.RefreshRequestedAt) {
+		clusterQuery := argocdcluster.ClusterQuery{
+			Name:   meta.GetExternalName(cr),
+			Server: ptr.Deref(cr.Spec.ForProvider.Server, ""),
+		}
+		if _, err := e.client.InvalidateCache(ctx, &clusterQuery); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidateCache)
+		}
+	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -234,14 +281,22 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNotCluster)
 	}
 
+	// the manager service account is removed first, so a failed cleanup is
+	// retried while the cluster still exists in ArgoCD
+	if err := e.removeManagerServiceAccount(ctx, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
 	clusterQuery := argocdcluster.ClusterQuery{
 		Server: *cr.Spec.ForProvider.Server,
 		Name:   meta.GetExternalName(cr),
 	}
 
-	_, err := e.client.Delete(ctx, &clusterQuery)
+	if _, err := e.client.Delete(ctx, &clusterQuery); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	}
 
-	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+	return managed.ExternalDelete{}, nil
 }
 
 func lateInitializeCluster(p *v1alpha1.ClusterParameters, r *argocdv1alpha1.Cluster) {
@@ -265,6 +320,17 @@ func lateInitializeCluster(p *v1alpha1.ClusterParameters, r *argocdv1alpha1.Clus
 		p.Name = &r.Name
 	}
 
+	if p.ClusterResources == nil && r.ClusterResources {
+		p.ClusterResources = &r.ClusterResources
+	}
+
+	if p.Config.DisableCompression == nil && r.Config.DisableCompression {
+		p.Config.DisableCompression = &r.Config.DisableCompression
+	}
+
+	if p.Config.ProxyURL == nil && r.Config.ProxyUrl != "" {
+		p.Config.ProxyURL = &r.Config.ProxyUrl
+	}
 }
 
 func generateClusterObservation(r *argocdv1alpha1.Cluster, kubeconfigSecretResourceVersion string) v1alpha1.ClusterObservation {
@@ -283,6 +349,7 @@ func generateClusterObservation(r *argocdv1alpha1.Cluster, kubeconfigSecretResou
 			},
 			ApplicationsCount: r.Info.ApplicationsCount,
 		},
+		RefreshRequestedAt: r.RefreshRequestedAt,
 	}
 
 	if kubeconfigSecretResourceVersion != "" {
@@ -295,7 +362,7 @@ func generateClusterObservation(r *argocdv1alpha1.Cluster, kubeconfigSecretResou
 }
 
 func (e *external) generateCreateClusterOptions(ctx context.Context, p *v1alpha1.Cluster) (*argocdcluster.ClusterCreateRequest, error) {
-	argoCluster, err := e.convertClusterTypes(ctx, &p.Spec.ForProvider)
+	argoCluster, err := e.convertClusterTypes(ctx, p.GetNamespace(), &p.Spec.ForProvider)
 	clusterCreateRequest := &argocdcluster.ClusterCreateRequest{
 		Cluster: &argoCluster,
 		Upsert:  false,
@@ -304,7 +371,7 @@ func (e *external) generateCreateClusterOptions(ctx context.Context, p *v1alpha1
 	return clusterCreateRequest, err
 }
 
-func (e *external) convertClusterTypes(ctx context.Context, p *v1alpha1.ClusterParameters) (argocdv1alpha1.Cluster, error) { //nolint:gocyclo // checking all parameters can't be reduced
+func (e *external) convertClusterTypes(ctx context.Context, namespace string, p *v1alpha1.ClusterParameters) (argocdv1alpha1.Cluster, error) { //nolint:gocyclo // checking all parameters can't be reduced
 	argoCluster := argocdv1alpha1.Cluster{
 		Config: argocdv1alpha1.ClusterConfig{},
 	}
@@ -328,6 +395,14 @@ func (e *external) convertClusterTypes(ctx context.Context, p *v1alpha1.ClusterP
 		argoCluster.Config.TLSClientConfig.Insecure = p.Config.TLSClientConfig.Insecure
 	}
 
+	if p.Config.DisableCompression != nil {
+		argoCluster.Config.DisableCompression = *p.Config.DisableCompression
+	}
+
+	if p.Config.ProxyURL != nil {
+		argoCluster.Config.ProxyUrl = *p.Config.ProxyURL
+	}
+
 	if p.Config.AWSAuthConfig != nil {
 		argoCluster.Config.AWSAuthConfig = &argocdv1alpha1.AWSAuthConfig{}
 
@@ -372,6 +447,10 @@ func (e *external) convertClusterTypes(ctx context.Context, p *v1alpha1.ClusterP
 		argoCluster.Shard = p.Shard
 	}
 
+	if p.ClusterResources != nil {
+		argoCluster.ClusterResources = *p.ClusterResources
+	}
+
 	if p.Project != nil {
 		argoCluster.Project = *p.Project
 	}
@@ -384,13 +463,15 @@ func (e *external) convertClusterTypes(ctx context.Context, p *v1alpha1.ClusterP
 		argoCluster.Annotations = p.Annotations
 	}
 
-	err := e.resolveReferences(ctx, p, &argoCluster)
+	err := e.resolveReferences(ctx, namespace, p, &argoCluster)
 
 	return argoCluster, err
 }
 
 func (e *external) generateUpdateClusterOptions(ctx context.Context, p *v1alpha1.Cluster) (*argocdcluster.ClusterUpdateRequest, error) {
-	clusterSpec, err := e.convertClusterTypes(ctx, &p.Spec.ForProvider)
+	clusterSpec, err := e.convertClusterTypes(ctx, p.GetNamespace(), &p.Spec.ForProvider)
+	// keep the last refresh, ArgoCD removes it otherwise
+	clusterSpec.RefreshRequestedAt = p.Status.AtProvider.RefreshRequestedAt
 
 	o := &argocdcluster.ClusterUpdateRequest{
 		Cluster: &clusterSpec,
@@ -407,6 +488,8 @@ func isClusterUpToDate(cr *v1alpha1.Cluster, o *v1alpha1.ClusterObservation, r *
 	case !isEqualConfig(&p.Config, &r.Config),
 		!cmp.Equal(p.Namespaces, r.Namespaces),
 		!cmp.Equal(p.Shard, r.Shard),
+		p.ClusterResources != nil && *p.ClusterResources != r.ClusterResources,
+		isRefreshRequested(&p, r.RefreshRequestedAt),
 		!cmp.Equal(p.Labels, r.Labels),
 		!cmp.Equal(p.Annotations, r.Annotations),
 		!cmp.Equal(cr.Status.AtProvider.Kubeconfig, o.Kubeconfig):
@@ -416,6 +499,12 @@ func isClusterUpToDate(cr *v1alpha1.Cluster, o *v1alpha1.ClusterObservation, r *
 	return true
 }
 
+// isRefreshRequested reports whether a refresh of the cluster cache was
+// requested after the last refresh.
+func isRefreshRequested(p *v1alpha1.ClusterParameters, refreshedAt *metav1.Time) bool {
+	return p.RefreshRequestedAt != nil && (refreshedAt == nil || refreshedAt.Before(p.RefreshRequestedAt))
+}
+
 func isEqualConfig(p *v1alpha1.ClusterConfig, r *argocdv1alpha1.ClusterConfig) bool {
 	if p == nil && r == nil {
 		return true
@@ -425,6 +514,8 @@ func isEqualConfig(p *v1alpha1.ClusterConfig, r *argocdv1alpha1.ClusterConfig) b
 	}
 	switch {
 	case p.Username != nil && *p.Username != r.Username,
+		p.DisableCompression != nil && *p.DisableCompression != r.DisableCompression,
+		p.ProxyURL != nil && *p.ProxyURL != r.ProxyUrl,
 		!isEqualTLSConfig(p.TLSClientConfig, &r.TLSClientConfig),
 		!isEqualAWSAuthConfig(p.AWSAuthConfig, r.AWSAuthConfig),
 		!isEqualExecProviderConfig(p.ExecProviderConfig, r.ExecProviderConfig):
@@ -486,7 +577,7 @@ func isEqualExecProviderConfig(p *v1alpha1.ExecProviderConfig, r *argocdv1alpha1
 	return true
 }
 
-func (e *external) resolveReferences(ctx context.Context, cr *v1alpha1.ClusterParameters, r *argocdv1alpha1.Cluster) error { //nolint:gocyclo // checking all parameters can't be reduced
+func (e *external) resolveReferences(ctx context.Context, namespace string, cr *v1alpha1.ClusterParameters, r *argocdv1alpha1.Cluster) error { //nolint:gocyclo // checking all parameters can't be reduced
 	if cr.Config.PasswordSecretRef != nil {
 		payload, err := e.getPayload(ctx, cr.Config.PasswordSecretRef)
 		if err != nil {
@@ -534,7 +625,11 @@ func (e *external) resolveReferences(ctx context.Context, cr *v1alpha1.ClusterPa
 
 		}
 	}
-	if cr.Config.KubeconfigSecretRef != nil {
+	if cr.Config.KubeconfigFrom != nil {
+		if err := e.extractKubeconfigFromSource(ctx, namespace, cr, r); err != nil {
+			return err
+		}
+	} else if cr.Config.KubeconfigSecretRef != nil {
 		err := e.extractKubeconfigFromSecretRef(ctx, cr, r)
 
 		if err != nil {
@@ -542,6 +637,12 @@ func (e *external) resolveReferences(ctx context.Context, cr *v1alpha1.ClusterPa
 		}
 	}
 
+	if cr.ManagerServiceAccount != nil {
+		if err := e.bootstrapManagerServiceAccount(ctx, cr, r); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -594,7 +695,12 @@ func (e *external) extractKubeconfigFromSecretRef(ctx context.Context, p *v1alph
 	if err != nil {
 		return errors.Wrap(err, errParseKubeconfig)
 	}
+	applyKubeconfig(restConfig, p, r)
+	return nil
+}
 
+// applyKubeconfig sets the login information of a kubeconfig
+func applyKubeconfig(restConfig *rest.Config, p *v1alpha1.ClusterParameters, r *argocdv1alpha1.Cluster) {
 	if restConfig.Host != "" {
 		if p.Name == nil {
 			p.Name = &restConfig.Host
@@ -620,7 +726,6 @@ func (e *external) extractKubeconfigFromSecretRef(ctx context.Context, p *v1alph
 		KeyData:    restConfig.TLSClientConfig.KeyData,
 		ServerName: restConfig.TLSClientConfig.ServerName,
 	}
-	return nil
 }
 
 // newRESTConfigForKubeconfig returns a REST Config for the given KubeConfigValue.
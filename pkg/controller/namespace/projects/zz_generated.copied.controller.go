@@ -230,29 +230,33 @@ func lateInitializeProject(p *v1alpha1.ProjectParameters, r *argocdv1alpha1.AppP
 		}
 	}
 
+	if p.DestinationServiceAccounts == nil && r.DestinationServiceAccounts != nil {
+		p.DestinationServiceAccounts = make([]v1alpha1.ApplicationDestinationServiceAccount, len(r.DestinationServiceAccounts))
+		for i, res := range r.DestinationServiceAccounts {
+			p.DestinationServiceAccounts[i] = v1alpha1.ApplicationDestinationServiceAccount{
+				Server:                res.Server,
+				Namespace:             ptr.To(res.Namespace),
+				DefaultServiceAccount: res.DefaultServiceAccount,
+			}
+		}
+	}
+
+	if p.PermitOnlyProjectScopedClusters == nil && r.PermitOnlyProjectScopedClusters {
+		p.PermitOnlyProjectScopedClusters = ptr.To(r.PermitOnlyProjectScopedClusters)
+	}
+
 	if p.Description == nil {
 		p.Description = &r.Description
 	}
 
-	if p.Roles == nil && r.Roles != nil {
+	if p.Roles == nil && r.Roles != nil && !clients.BoolValue(p.IgnoreUnmanagedRoles) {
 		p.Roles = make([]v1alpha1.ProjectRole, len(r.Roles))
 		for i, res := range r.Roles {
 			res := res // FIX go linter exportloopref
-			jwtTokens := make([]v1alpha1.JWTToken, len(res.JWTTokens))
-			for j, t := range res.JWTTokens {
-				t := t // FIX go linter exportloopref
-				jwtTokens[j] = v1alpha1.JWTToken{
-					IssuedAt:  t.IssuedAt,
-					ExpiresAt: &t.ExpiresAt,
-					ID:        &t.ID,
-				}
-			}
-
 			p.Roles[i] = v1alpha1.ProjectRole{
 				Name:        res.Name,
 				Description: &res.Description,
 				Policies:    res.Policies,
-				JWTTokens:   jwtTokens,
 				Groups:      res.Groups,
 			}
 		}
@@ -284,7 +288,7 @@ func lateInitializeProject(p *v1alpha1.ProjectParameters, r *argocdv1alpha1.AppP
 		}
 	}
 
-	if p.SyncWindows == nil && r.SyncWindows != nil {
+	if p.SyncWindows == nil && r.SyncWindows != nil && !clients.BoolValue(p.IgnoreUnmanagedSyncWindows) {
 		p.SyncWindows = make([]v1alpha1.SyncWindow, len(r.SyncWindows))
 
 		for i, res := range r.SyncWindows {
@@ -385,21 +389,10 @@ func generateProjectSpec(p *v1alpha1.ProjectParameters) argocdv1alpha1.AppProjec
 	if p.Roles != nil {
 		projSpec.Roles = make([]argocdv1alpha1.ProjectRole, len(p.Roles))
 		for i, r := range p.Roles {
-
-			jwtTokens := make([]argocdv1alpha1.JWTToken, len(r.JWTTokens))
-			for j, t := range r.JWTTokens {
-				jwtTokens[j] = argocdv1alpha1.JWTToken{
-					IssuedAt:  t.IssuedAt,
-					ExpiresAt: clients.Int64Value(t.ExpiresAt),
-					ID:        clients.StringValue(t.ID),
-				}
-			}
-
 			projSpec.Roles[i] = argocdv1alpha1.ProjectRole{
 				Name:        r.Name,
 				Description: clients.StringValue(r.Description),
 				Policies:    r.Policies,
-				JWTTokens:   jwtTokens,
 				Groups:      r.Groups,
 			}
 		}
@@ -458,6 +451,17 @@ func generateProjectSpec(p *v1alpha1.ProjectParameters) argocdv1alpha1.AppProjec
 	if p.SourceNamespaces != nil {
 		projSpec.SourceNamespaces = p.SourceNamespaces
 	}
+	if p.DestinationServiceAccounts != nil {
+		projSpec.DestinationServiceAccounts = make([]argocdv1alpha1.ApplicationDestinationServiceAccount, len(p.DestinationServiceAccounts))
+		for i, r := range p.DestinationServiceAccounts {
+			projSpec.DestinationServiceAccounts[i] = argocdv1alpha1.ApplicationDestinationServiceAccount{
+				Server:                r.Server,
+				Namespace:             clients.StringValue(r.Namespace),
+				DefaultServiceAccount: r.DefaultServiceAccount,
+			}
+		}
+	}
+	projSpec.PermitOnlyProjectScopedClusters = clients.BoolValue(p.PermitOnlyProjectScopedClusters)
 
 	return projSpec
 }
@@ -465,6 +469,14 @@ func generateProjectSpec(p *v1alpha1.ProjectParameters) argocdv1alpha1.AppProjec
 func generateUpdateProjectOptions(p *v1alpha1.Project, current *argocdv1alpha1.AppProject) *project.ProjectUpdateRequest {
 	projSpec := generateProjectSpec(&p.Spec.ForProvider)
 
+	mergeJWTTokens(projSpec.Roles, current.Spec.Roles)
+	if clients.BoolValue(p.Spec.ForProvider.IgnoreUnmanagedRoles) {
+		projSpec.Roles = append(projSpec.Roles, unmanagedRoles(p.Spec.ForProvider.Roles, current.Spec.Roles)...)
+	}
+	if clients.BoolValue(p.Spec.ForProvider.IgnoreUnmanagedSyncWindows) {
+		projSpec.SyncWindows = append(projSpec.SyncWindows, unmanagedSyncWindows(p.Spec.ForProvider.SyncWindows, current.Spec.SyncWindows)...)
+	}
+
 	o := &project.ProjectUpdateRequest{
 		Project: &argocdv1alpha1.AppProject{
 			TypeMeta: p.TypeMeta,
@@ -483,19 +495,68 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, r *argocdv1alpha1.AppProje
 	case !cmp.Equal(p.SourceRepos, r.Spec.SourceRepos),
 		!isEqualDestinations(p.Destinations, r.Spec.Destinations),
 		clients.StringValue(p.Description) != r.Spec.Description,
-		!isEqualRoles(p.Roles, r.Spec.Roles),
+		!isEqualRoles(p.Roles, managedRoles(p, r.Spec.Roles)),
 		!cmp.Equal(p.ClusterResourceWhitelist, r.Spec.ClusterResourceWhitelist),
 		!cmp.Equal(p.NamespaceResourceBlacklist, r.Spec.NamespaceResourceBlacklist),
 		!isEqualOrphanedResources(p.OrphanedResources, r.Spec.OrphanedResources),
-		!isEqualSyncWindows(p.SyncWindows, r.Spec.SyncWindows),
+		!isEqualSyncWindows(p.SyncWindows, managedSyncWindows(p, r.Spec.SyncWindows)),
 		!cmp.Equal(p.NamespaceResourceWhitelist, r.Spec.NamespaceResourceWhitelist),
 		!isEqualSignatureKeys(p.SignatureKeys, r.Spec.SignatureKeys),
-		!cmp.Equal(p.ClusterResourceBlacklist, r.Spec.ClusterResourceBlacklist):
+		!cmp.Equal(p.ClusterResourceBlacklist, r.Spec.ClusterResourceBlacklist),
+		!isEqualDestinationServiceAccounts(p.DestinationServiceAccounts, r.Spec.DestinationServiceAccounts),
+		!clients.IsBoolEqualToBoolPtr(p.PermitOnlyProjectScopedClusters, r.Spec.PermitOnlyProjectScopedClusters):
 		return false
 	}
 	return true
 }
 
+// mergeJWTTokens copies the tokens of the observed roles into the desired
+// roles. Tokens are issued by ArgoCD, e.g. through Token resources or the CLI,
+// and would be revoked if they were dropped on update.
+func mergeJWTTokens(p []argocdv1alpha1.ProjectRole, r []argocdv1alpha1.ProjectRole) {
+	tokens := make(map[string][]argocdv1alpha1.JWTToken, len(r))
+	for _, role := range r {
+		tokens[role.Name] = role.JWTTokens
+	}
+	for i := range p {
+		p[i].JWTTokens = tokens[p[i].Name]
+	}
+}
+
+// managedRoles returns the observed roles the Project is responsible for. If
+// unmanaged roles are ignored only the roles listed in the spec are returned.
+func managedRoles(p *v1alpha1.ProjectParameters, r []argocdv1alpha1.ProjectRole) []argocdv1alpha1.ProjectRole {
+	if !clients.BoolValue(p.IgnoreUnmanagedRoles) {
+		return r
+	}
+	names := make(map[string]bool, len(p.Roles))
+	for _, role := range p.Roles {
+		names[role.Name] = true
+	}
+	var roles []argocdv1alpha1.ProjectRole
+	for _, role := range r {
+		if names[role.Name] {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// unmanagedRoles returns the observed roles that are not listed in p.
+func unmanagedRoles(p []v1alpha1.ProjectRole, r []argocdv1alpha1.ProjectRole) []argocdv1alpha1.ProjectRole {
+	names := make(map[string]bool, len(p))
+	for _, role := range p {
+		names[role.Name] = true
+	}
+	var roles []argocdv1alpha1.ProjectRole
+	for _, role := range r {
+		if !names[role.Name] {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
 func isEqualRoles(p []v1alpha1.ProjectRole, r []argocdv1alpha1.ProjectRole) bool {
 	if p == nil && r == nil {
 		return true
@@ -508,44 +569,40 @@ func isEqualRoles(p []v1alpha1.ProjectRole, r []argocdv1alpha1.ProjectRole) bool
 		case role.Name != r[i].Name,
 			role.Description != nil && *role.Description != r[i].Description,
 			!cmp.Equal(role.Policies, r[i].Policies),
-			!cmp.Equal(role.Groups, r[i].Groups),
-			!isEqualJWTTokens(role.JWTTokens, r[i].JWTTokens):
+			!cmp.Equal(role.Groups, r[i].Groups):
 			return false
 		}
 	}
 	return true
 }
 
-func isEqualJWTTokens(p []v1alpha1.JWTToken, r []argocdv1alpha1.JWTToken) bool {
+func isEqualDestinations(p []v1alpha1.ApplicationDestination, r []argocdv1alpha1.ApplicationDestination) bool { //nolint:gocyclo // checking all parameters can't be reduced
 	if p == nil && r == nil {
 		return true
 	}
 	if p == nil || r == nil || len(p) != len(r) {
 		return false
 	}
-	for i, jwtToken := range p {
+	for i, destination := range p {
 		switch {
-		case jwtToken.IssuedAt != r[i].IssuedAt,
-			jwtToken.ExpiresAt != nil && *jwtToken.ExpiresAt != r[i].ExpiresAt,
-			jwtToken.ID != nil && *jwtToken.ID != r[i].ID:
+		case destination.Name != nil && *destination.Name != r[i].Name,
+			destination.Namespace != nil && *destination.Namespace != r[i].Namespace,
+			destination.Server != nil && *destination.Server != r[i].Server:
 			return false
 		}
 	}
 	return true
 }
 
-func isEqualDestinations(p []v1alpha1.ApplicationDestination, r []argocdv1alpha1.ApplicationDestination) bool { //nolint:gocyclo // checking all parameters can't be reduced
-	if p == nil && r == nil {
-		return true
-	}
-	if p == nil || r == nil || len(p) != len(r) {
+func isEqualDestinationServiceAccounts(p []v1alpha1.ApplicationDestinationServiceAccount, r []argocdv1alpha1.ApplicationDestinationServiceAccount) bool {
+	if len(p) != len(r) {
 		return false
 	}
-	for i, destination := range p {
+	for i, sa := range p {
 		switch {
-		case destination.Name != nil && *destination.Name != r[i].Name,
-			destination.Namespace != nil && *destination.Namespace != r[i].Namespace,
-			destination.Server != nil && *destination.Server != r[i].Server:
+		case sa.Server != r[i].Server,
+			clients.StringValue(sa.Namespace) != r[i].Namespace,
+			sa.DefaultServiceAccount != r[i].DefaultServiceAccount:
 			return false
 		}
 	}
@@ -600,7 +657,44 @@ func isEqualSignatureKeys(p []v1alpha1.SignatureKey, r []argocdv1alpha1.Signatur
 	return true
 }
 
-func isEqualSyncWindows(p v1alpha1.SyncWindows, r argocdv1alpha1.SyncWindows) bool { //nolint:gocyclo // checking all parameters can't be reduced
+// managedSyncWindows returns the observed sync windows the Project is
+// responsible for. If unmanaged sync windows are ignored only the windows
+// matching one of the spec windows are returned.
+func managedSyncWindows(p *v1alpha1.ProjectParameters, r argocdv1alpha1.SyncWindows) argocdv1alpha1.SyncWindows {
+	if !clients.BoolValue(p.IgnoreUnmanagedSyncWindows) {
+		return r
+	}
+	var windows argocdv1alpha1.SyncWindows
+	for _, w := range r {
+		if containsSyncWindow(p.SyncWindows, w) {
+			windows = append(windows, w)
+		}
+	}
+	return windows
+}
+
+// unmanagedSyncWindows returns the observed sync windows that do not match
+// any window in p.
+func unmanagedSyncWindows(p v1alpha1.SyncWindows, r argocdv1alpha1.SyncWindows) argocdv1alpha1.SyncWindows {
+	var windows argocdv1alpha1.SyncWindows
+	for _, w := range r {
+		if !containsSyncWindow(p, w) {
+			windows = append(windows, w)
+		}
+	}
+	return windows
+}
+
+func containsSyncWindow(p v1alpha1.SyncWindows, r *argocdv1alpha1.SyncWindow) bool {
+	for _, w := range p {
+		if isEqualSyncWindow(w, r) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEqualSyncWindows(p v1alpha1.SyncWindows, r argocdv1alpha1.SyncWindows) bool {
 	if len(p) == 0 && r == nil {
 		return true
 	}
@@ -608,20 +702,27 @@ func isEqualSyncWindows(p v1alpha1.SyncWindows, r argocdv1alpha1.SyncWindows) bo
 		return false
 	}
 	for i, syncWindow := range p {
-		switch {
-		case syncWindow.Kind != nil && *syncWindow.Kind != r[i].Kind,
-			syncWindow.Schedule != nil && *syncWindow.Schedule != r[i].Schedule,
-			syncWindow.Duration != nil && *syncWindow.Duration != r[i].Duration,
-			syncWindow.Applications != nil && !cmp.Equal(syncWindow.Applications, r[i].Applications),
-			syncWindow.Namespaces != nil && !cmp.Equal(syncWindow.Namespaces, r[i].Namespaces),
-			syncWindow.Clusters != nil && !cmp.Equal(syncWindow.Clusters, r[i].Clusters),
-			syncWindow.ManualSync != nil && *syncWindow.ManualSync != r[i].ManualSync:
+		if !isEqualSyncWindow(syncWindow, r[i]) {
 			return false
 		}
 	}
 	return true
 }
 
+func isEqualSyncWindow(p v1alpha1.SyncWindow, r *argocdv1alpha1.SyncWindow) bool { //nolint:gocyclo // checking all parameters can't be reduced
+	switch {
+	case p.Kind != nil && *p.Kind != r.Kind,
+		p.Schedule != nil && *p.Schedule != r.Schedule,
+		p.Duration != nil && *p.Duration != r.Duration,
+		p.Applications != nil && !cmp.Equal(p.Applications, r.Applications),
+		p.Namespaces != nil && !cmp.Equal(p.Namespaces, r.Namespaces),
+		p.Clusters != nil && !cmp.Equal(p.Clusters, r.Clusters),
+		p.ManualSync != nil && *p.ManualSync != r.ManualSync:
+		return false
+	}
+	return true
+}
+
 func (e *external) Disconnect(ctx context.Context) error {
 	return e.conn.Close()
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-argocd/apis/namespace/projects/v1alpha1"
 	mockclient "github.com/crossplane-contrib/provider-argocd/pkg/clients/interface/mock/projects"
@@ -153,6 +154,195 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"LateInitializeDestinationServiceAccounts": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{
+							Name: testProjectExternalName,
+						},
+					).Return(
+						&argocdv1alpha1.AppProject{
+							TypeMeta: metav1.TypeMeta{},
+							ObjectMeta: metav1.ObjectMeta{
+								Name: testProjectExternalName,
+							},
+							Spec: argocdv1alpha1.AppProjectSpec{
+								Description: testDescription,
+								DestinationServiceAccounts: []argocdv1alpha1.ApplicationDestinationServiceAccount{
+									{
+										Server:                "https://kubernetes.default.svc",
+										Namespace:             "default",
+										DefaultServiceAccount: "deployer",
+									},
+								},
+								PermitOnlyProjectScopedClusters: true,
+							},
+							Status: argocdv1alpha1.AppProjectStatus{},
+						}, nil)
+				}),
+				cr: Project(
+					withExternalName(testProjectExternalName),
+					withSpec(v1alpha1.ProjectParameters{
+						Description: &testDescription,
+					}),
+				),
+			},
+			want: want{
+				cr: Project(
+					withExternalName(testProjectExternalName),
+					withSpec(v1alpha1.ProjectParameters{
+						Description: &testDescription,
+						DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{
+							{
+								Server:                "https://kubernetes.default.svc",
+								Namespace:             ptr.To("default"),
+								DefaultServiceAccount: "deployer",
+							},
+						},
+						PermitOnlyProjectScopedClusters: ptr.To(true),
+					}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.ProjectObservation{
+						JWTTokensByRole: map[string]v1alpha1.JWTTokens{},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				err: nil,
+			},
+		},
+		"IgnoreUnmanagedRoles": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{
+							Name: testProjectExternalName,
+						},
+					).Return(
+						&argocdv1alpha1.AppProject{
+							TypeMeta: metav1.TypeMeta{},
+							ObjectMeta: metav1.ObjectMeta{
+								Name: testProjectExternalName,
+							},
+							Spec: argocdv1alpha1.AppProjectSpec{
+								Description: testDescription,
+								Roles: []argocdv1alpha1.ProjectRole{
+									{
+										Name: "unmanaged",
+									},
+								},
+							},
+							Status: argocdv1alpha1.AppProjectStatus{},
+						}, nil)
+				}),
+				cr: Project(
+					withExternalName(testProjectExternalName),
+					withSpec(v1alpha1.ProjectParameters{
+						Description:          &testDescription,
+						IgnoreUnmanagedRoles: ptr.To(true),
+					}),
+				),
+			},
+			want: want{
+				cr: Project(
+					withExternalName(testProjectExternalName),
+					withSpec(v1alpha1.ProjectParameters{
+						Description:          &testDescription,
+						IgnoreUnmanagedRoles: ptr.To(true),
+					}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.ProjectObservation{
+						JWTTokensByRole: map[string]v1alpha1.JWTTokens{},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+				},
+				err: nil,
+			},
+		},
+		"IgnoreUnmanagedSyncWindows": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&project.ProjectQuery{
+							Name: testProjectExternalName,
+						},
+					).Return(
+						&argocdv1alpha1.AppProject{
+							TypeMeta: metav1.TypeMeta{},
+							ObjectMeta: metav1.ObjectMeta{
+								Name: testProjectExternalName,
+							},
+							Spec: argocdv1alpha1.AppProjectSpec{
+								Description: testDescription,
+								SyncWindows: argocdv1alpha1.SyncWindows{
+									{
+										Kind:     "deny",
+										Schedule: "0 22 * * *",
+										Duration: "1h",
+									},
+									{
+										Kind:     "allow",
+										Schedule: "* * * * *",
+										Duration: "1h",
+									},
+								},
+							},
+							Status: argocdv1alpha1.AppProjectStatus{},
+						}, nil)
+				}),
+				cr: Project(
+					withExternalName(testProjectExternalName),
+					withSpec(v1alpha1.ProjectParameters{
+						Description: &testDescription,
+						SyncWindows: v1alpha1.SyncWindows{
+							{
+								Kind:     ptr.To("allow"),
+								Schedule: ptr.To("* * * * *"),
+								Duration: ptr.To("1h"),
+							},
+						},
+						IgnoreUnmanagedSyncWindows: ptr.To(true),
+					}),
+				),
+			},
+			want: want{
+				cr: Project(
+					withExternalName(testProjectExternalName),
+					withSpec(v1alpha1.ProjectParameters{
+						Description: &testDescription,
+						SyncWindows: v1alpha1.SyncWindows{
+							{
+								Kind:     ptr.To("allow"),
+								Schedule: ptr.To("* * * * *"),
+								Duration: ptr.To("1h"),
+							},
+						},
+						IgnoreUnmanagedSyncWindows: ptr.To(true),
+					}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.ProjectObservation{
+						JWTTokensByRole: map[string]v1alpha1.JWTTokens{},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+				},
+				err: nil,
+			},
+		},
 		"SuccessfulLateInitialize": {
 			args: args{
 				client: withMockClient(t, func(mcs *mockclient.MockProjectServiceClient) {
@@ -713,3 +903,66 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateUpdateProjectOptionsPreservesJWTTokens(t *testing.T) {
+	tokens := []argocdv1alpha1.JWTToken{
+		{
+			IssuedAt:  1,
+			ExpiresAt: 2,
+			ID:        "test-token",
+		},
+	}
+	current := &argocdv1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            testProjectExternalName,
+			ResourceVersion: "1",
+		},
+		Spec: argocdv1alpha1.AppProjectSpec{
+			Roles: []argocdv1alpha1.ProjectRole{
+				{
+					Name:      "test-role",
+					JWTTokens: tokens,
+				},
+			},
+		},
+	}
+	cr := Project(
+		withExternalName(testProjectExternalName),
+		withSpec(v1alpha1.ProjectParameters{
+			Description: &testDescription,
+			Roles: []v1alpha1.ProjectRole{
+				{
+					Name:        "test-role",
+					Description: &testDescription,
+				},
+			},
+		}),
+	)
+
+	if !isProjectUpToDate(&cr.Spec.ForProvider, &argocdv1alpha1.AppProject{
+		Spec: argocdv1alpha1.AppProjectSpec{
+			Description: testDescription,
+			Roles: []argocdv1alpha1.ProjectRole{
+				{
+					Name:        "test-role",
+					Description: testDescription,
+					JWTTokens:   tokens,
+				},
+			},
+		},
+	}) {
+		t.Errorf("issued tokens must not cause the project to be out of date")
+	}
+
+	got := generateUpdateProjectOptions(cr, current).Project.Spec.Roles
+	want := []argocdv1alpha1.ProjectRole{
+		{
+			Name:        "test-role",
+			Description: testDescription,
+			JWTTokens:   tokens,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -42,14 +42,18 @@ var (
 	errBoom = errors.New("boom")
 	// Unused until issue https://github.com/argoproj/argo-cd/issues/20005 in Argo CD project is resolved
 	// errNotFound                = errors.New("code = NotFound desc = repo")
-	errPermissionDenied        = errors.New("code = PermissionDenied desc = permission denied")
-	testRepositoryExternalName = "testRepo"
-	testRepo                   = "https://gitlab.com/example-group/example-project.git"
-	testUsername               = "testUser"
-	testInsecure               = false
-	testEnableLFS              = false
-	testInheritedCreds         = false
-	testEnableOCI              = false
+	errPermissionDenied          = errors.New("code = PermissionDenied desc = permission denied")
+	testRepositoryExternalName   = "testRepo"
+	testRepo                     = "https://gitlab.com/example-group/example-project.git"
+	testUsername                 = "testUser"
+	testInsecure                 = false
+	testEnableLFS                = false
+	testInheritedCreds           = false
+	testEnableOCI                = false
+	testForceHTTPBasicAuth       = false
+	testUseAzureWorkloadIdentity = false
+	testProxy                    = "http://proxy.example.com:3128"
+	testNoProxy                  = "localhost,.svc"
 )
 
 type args struct {
@@ -122,12 +126,14 @@ func TestObserve(t *testing.T) {
 				cr: Repository(
 					withExternalName(testRepositoryExternalName),
 					withSpec(v1alpha1.RepositoryParameters{
-						Name:           ptr.To(testRepositoryExternalName),
-						Repo:           testRepo,
-						Insecure:       &testInsecure,
-						EnableLFS:      &testEnableLFS,
-						InheritedCreds: &testInheritedCreds,
-						EnableOCI:      &testEnableOCI,
+						Name:                     ptr.To(testRepositoryExternalName),
+						Repo:                     testRepo,
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
 					}),
 				),
 			},
@@ -135,12 +141,14 @@ func TestObserve(t *testing.T) {
 				cr: Repository(
 					withExternalName(testRepositoryExternalName),
 					withSpec(v1alpha1.RepositoryParameters{
-						Name:           ptr.To(testRepositoryExternalName),
-						Repo:           testRepo,
-						Insecure:       &testInsecure,
-						EnableLFS:      &testEnableLFS,
-						InheritedCreds: &testInheritedCreds,
-						EnableOCI:      &testEnableOCI,
+						Name:                     ptr.To(testRepositoryExternalName),
+						Repo:                     testRepo,
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
 					}),
 					withConditions(xpv1.Available()),
 					withObservation(v1alpha1.RepositoryObservation{
@@ -181,12 +189,14 @@ func TestObserve(t *testing.T) {
 				cr: Repository(
 					withExternalName(testRepositoryExternalName),
 					withSpec(v1alpha1.RepositoryParameters{
-						Name:           ptr.To(testRepositoryExternalName),
-						Repo:           testRepo,
-						Insecure:       &testInsecure,
-						EnableLFS:      &testEnableLFS,
-						InheritedCreds: &testInheritedCreds,
-						EnableOCI:      &testEnableOCI,
+						Name:                     ptr.To(testRepositoryExternalName),
+						Repo:                     testRepo,
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
 					}),
 					withConditions(xpv1.Available()),
 					withObservation(v1alpha1.RepositoryObservation{}),
@@ -199,6 +209,60 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"NeedsUpdateProxy": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().Get(
+						context.Background(),
+						&argocdRepository.RepoQuery{
+							Repo: testRepositoryExternalName,
+						},
+					).Return(
+						&argocdv1alpha1.Repository{
+							Name: testRepositoryExternalName,
+							Repo: testRepo,
+						}, nil)
+				}),
+				cr: Repository(
+					withExternalName(testRepositoryExternalName),
+					withSpec(v1alpha1.RepositoryParameters{
+						Name:                     ptr.To(testRepositoryExternalName),
+						Repo:                     testRepo,
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
+						Proxy:                    &testProxy,
+					}),
+				),
+			},
+			want: want{
+				cr: Repository(
+					withExternalName(testRepositoryExternalName),
+					withSpec(v1alpha1.RepositoryParameters{
+						Name:                     ptr.To(testRepositoryExternalName),
+						Repo:                     testRepo,
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
+						Proxy:                    &testProxy,
+					}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.RepositoryObservation{}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+				err: nil,
+			},
+		},
 		"NeedsCreation": {
 			args: args{
 				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
@@ -276,13 +340,15 @@ func TestObserve(t *testing.T) {
 				cr: Repository(
 					withExternalName(testRepositoryExternalName),
 					withSpec(v1alpha1.RepositoryParameters{
-						Name:           ptr.To(testRepositoryExternalName),
-						Repo:           testRepo,
-						Project:        ptr.To("test-project"),
-						Insecure:       &testInsecure,
-						EnableLFS:      &testEnableLFS,
-						InheritedCreds: &testInheritedCreds,
-						EnableOCI:      &testEnableOCI,
+						Name:                     ptr.To(testRepositoryExternalName),
+						Repo:                     testRepo,
+						Project:                  ptr.To("test-project"),
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
 					}),
 				),
 			},
@@ -290,13 +356,15 @@ func TestObserve(t *testing.T) {
 				cr: Repository(
 					withExternalName(testRepositoryExternalName),
 					withSpec(v1alpha1.RepositoryParameters{
-						Name:           ptr.To(testRepositoryExternalName),
-						Repo:           testRepo,
-						Project:        ptr.To("test-project"),
-						Insecure:       &testInsecure,
-						EnableLFS:      &testEnableLFS,
-						InheritedCreds: &testInheritedCreds,
-						EnableOCI:      &testEnableOCI,
+						Name:                     ptr.To(testRepositoryExternalName),
+						Repo:                     testRepo,
+						Project:                  ptr.To("test-project"),
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
 					}),
 					withConditions(xpv1.Available()),
 					withObservation(v1alpha1.RepositoryObservation{
@@ -328,13 +396,15 @@ func TestObserve(t *testing.T) {
 				cr: Repository(
 					withExternalName(testRepositoryExternalName),
 					withSpec(v1alpha1.RepositoryParameters{
-						Name:           ptr.To(testRepositoryExternalName),
-						Repo:           testRepo,
-						Project:        nil, // Explicitly nil
-						Insecure:       &testInsecure,
-						EnableLFS:      &testEnableLFS,
-						InheritedCreds: &testInheritedCreds,
-						EnableOCI:      &testEnableOCI,
+						Name:                     ptr.To(testRepositoryExternalName),
+						Repo:                     testRepo,
+						Project:                  nil, // Explicitly nil
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
 					}),
 				),
 			},
@@ -342,13 +412,15 @@ func TestObserve(t *testing.T) {
 				cr: Repository(
 					withExternalName(testRepositoryExternalName),
 					withSpec(v1alpha1.RepositoryParameters{
-						Name:           ptr.To(testRepositoryExternalName),
-						Repo:           testRepo,
-						Project:        nil,
-						Insecure:       &testInsecure,
-						EnableLFS:      &testEnableLFS,
-						InheritedCreds: &testInheritedCreds,
-						EnableOCI:      &testEnableOCI,
+						Name:                     ptr.To(testRepositoryExternalName),
+						Repo:                     testRepo,
+						Project:                  nil,
+						Insecure:                 &testInsecure,
+						EnableLFS:                &testEnableLFS,
+						InheritedCreds:           &testInheritedCreds,
+						EnableOCI:                &testEnableOCI,
+						ForceHTTPBasicAuth:       &testForceHTTPBasicAuth,
+						UseAzureWorkloadIdentity: &testUseAzureWorkloadIdentity,
 					}),
 					withConditions(xpv1.Available()),
 					withObservation(v1alpha1.RepositoryObservation{
@@ -426,6 +498,47 @@ func TestCreate(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"SuccessfulWithProxy": {
+			args: args{
+				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
+					mcs.EXPECT().CreateRepository(
+						context.Background(),
+						&argocdRepository.RepoCreateRequest{
+							Repo: &argocdv1alpha1.Repository{
+								Repo:               testRepositoryExternalName,
+								Proxy:              testProxy,
+								NoProxy:            testNoProxy,
+								ForceHttpBasicAuth: true,
+							},
+						},
+					).Return(
+						&argocdv1alpha1.Repository{
+							Repo: testRepositoryExternalName,
+						}, nil)
+				}),
+				cr: Repository(
+					withSpec(v1alpha1.RepositoryParameters{
+						Repo:               testRepositoryExternalName,
+						Proxy:              &testProxy,
+						NoProxy:            &testNoProxy,
+						ForceHTTPBasicAuth: ptr.To(true),
+					}),
+				),
+			},
+			want: want{
+				cr: Repository(
+					withExternalName(testRepositoryExternalName),
+					withSpec(v1alpha1.RepositoryParameters{
+						Repo:               testRepositoryExternalName,
+						Proxy:              &testProxy,
+						NoProxy:            &testNoProxy,
+						ForceHTTPBasicAuth: ptr.To(true),
+					}),
+				),
+				result: managed.ExternalCreation{},
+				err:    nil,
+			},
+		},
 		"CreateSystemFailed": {
 			args: args{
 				client: withMockClient(t, func(mcs *mockclient.MockRepositoryServiceClient) {
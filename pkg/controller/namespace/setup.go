@@ -20,13 +20,22 @@ import (
 	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/applicationoperations"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/applications"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/applicationsetdiscovery"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/applicationsets"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/cluster"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/config"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/gpgkeys"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/projectroles"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/projects"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/repositories"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/repositorycertificates"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/repositoryrefs"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/syncwindows"
 	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/tokens"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/writerepositories"
+	"github.com/crossplane-contrib/provider-argocd/pkg/controller/namespace/writerepositorycredentials"
 )
 
 // Setup creates all argocd API controllers with the supplied logger and adds
@@ -35,10 +44,21 @@ func Setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 	for _, setup := range []func(ctrl.Manager, xpcontroller.Options) error{
 		config.Setup,
 		repositories.Setup,
+		writerepositories.Setup,
+		writerepositorycredentials.Setup,
+		gpgkeys.Setup,
+		repositorycertificates.Setup,
+		repositoryrefs.Setup,
 		projects.Setup,
+		projectroles.Setup,
+		syncwindows.Setup,
 		cluster.Setup,
 		applications.Setup,
+		applicationoperations.SetupApplicationSync,
+		applicationoperations.SetupApplicationRollback,
+		applicationoperations.SetupResourceAction,
 		applicationsets.Setup,
+		applicationsetdiscovery.Setup,
 		tokens.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
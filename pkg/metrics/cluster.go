@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics contains the Prometheus metrics exported by the provider
+// in addition to the metrics of managed resources.
+package metrics
+
+import (
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace        = "provider_argocd"
+	subsystemCluster = "cluster"
+)
+
+// clusterLabels identify the Cluster managed resource of a series. The
+// namespace is empty for cluster scoped managed resources.
+var clusterLabels = []string{"namespace", "name"}
+
+var (
+	clusterConnectionStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystemCluster,
+		Name:      "connection_status",
+		Help:      "Whether ArgoCD is connected to the cluster, 1 if the connection is successful and 0 otherwise.",
+	}, clusterLabels)
+
+	clusterCacheResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystemCluster,
+		Name:      "cache_resources",
+		Help:      "Number of Kubernetes resources in the ArgoCD cache of the cluster.",
+	}, clusterLabels)
+
+	clusterLastCacheSync = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystemCluster,
+		Name:      "last_cache_sync_timestamp_seconds",
+		Help:      "Unix time of the last sync of the ArgoCD cache of the cluster.",
+	}, clusterLabels)
+
+	clusterApplications = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystemCluster,
+		Name:      "applications",
+		Help:      "Number of applications ArgoCD manages in the cluster.",
+	}, clusterLabels)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		clusterConnectionStatus,
+		clusterCacheResources,
+		clusterLastCacheSync,
+		clusterApplications,
+	)
+}
+
+// RecordCluster records the info ArgoCD observed for the cluster of the
+// managed resource.
+func RecordCluster(mg client.Object, info *argocdv1alpha1.ClusterInfo) {
+	labels := prometheus.Labels{"namespace": mg.GetNamespace(), "name": mg.GetName()}
+
+	connected := 0.0
+	if info.ConnectionState.Status == argocdv1alpha1.ConnectionStatusSuccessful {
+		connected = 1
+	}
+	clusterConnectionStatus.With(labels).Set(connected)
+	clusterCacheResources.With(labels).Set(float64(info.CacheInfo.ResourcesCount))
+	clusterApplications.With(labels).Set(float64(info.ApplicationsCount))
+
+	if info.CacheInfo.LastCacheSyncTime != nil {
+		clusterLastCacheSync.With(labels).Set(float64(info.CacheInfo.LastCacheSyncTime.UnixNano()) / 1e9)
+	} else {
+		clusterLastCacheSync.Delete(labels)
+	}
+}
+
+// DeleteCluster removes the series of the managed resource once its
+// cluster no longer exists or the managed resource is deleted.
+func DeleteCluster(mg client.Object) {
+	labels := prometheus.Labels{"namespace": mg.GetNamespace(), "name": mg.GetName()}
+
+	clusterConnectionStatus.Delete(labels)
+	clusterCacheResources.Delete(labels)
+	clusterLastCacheSync.Delete(labels)
+	clusterApplications.Delete(labels)
+}
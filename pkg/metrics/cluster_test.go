@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	argocdv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRecordCluster(t *testing.T) {
+	lastCacheSync := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		connected    float64
+		resources    float64
+		applications float64
+		cacheSeries  int
+	}
+
+	cases := map[string]struct {
+		info argocdv1alpha1.ClusterInfo
+		want want
+	}{
+		"Connected": {
+			info: argocdv1alpha1.ClusterInfo{
+				ConnectionState: argocdv1alpha1.ConnectionState{Status: argocdv1alpha1.ConnectionStatusSuccessful},
+				CacheInfo: argocdv1alpha1.ClusterCacheInfo{
+					ResourcesCount:    42,
+					LastCacheSyncTime: &metav1.Time{Time: lastCacheSync},
+				},
+				ApplicationsCount: 3,
+			},
+			want: want{connected: 1, resources: 42, applications: 3, cacheSeries: 1},
+		},
+		"Failed": {
+			info: argocdv1alpha1.ClusterInfo{
+				ConnectionState: argocdv1alpha1.ConnectionState{Status: argocdv1alpha1.ConnectionStatusFailed},
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			labels := prometheus.Labels{"namespace": "", "name": name}
+			RecordCluster(mg, &tc.info)
+			defer DeleteCluster(mg)
+
+			got := want{
+				connected:    testutil.ToFloat64(clusterConnectionStatus.With(labels)),
+				resources:    testutil.ToFloat64(clusterCacheResources.With(labels)),
+				applications: testutil.ToFloat64(clusterApplications.With(labels)),
+				cacheSeries:  testutil.CollectAndCount(clusterLastCacheSync),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.cacheSeries > 0 {
+				if diff := cmp.Diff(float64(lastCacheSync.Unix()), testutil.ToFloat64(clusterLastCacheSync.With(labels))); diff != "" {
+					t.Errorf("last cache sync: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}